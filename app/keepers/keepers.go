@@ -299,6 +299,7 @@ func NewAppKeeper(
 		appKeepers.AuthzKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.MintKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	appKeepers.RewardKeeper = *rewardskeeper.NewKeeper(
 		appCodec, 
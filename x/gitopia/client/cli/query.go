@@ -30,6 +30,19 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListBranch())
 	cmd.AddCommand(CmdListRepositoryBranch())
 	cmd.AddCommand(CmdShowRepositoryBranch())
+	cmd.AddCommand(CmdListRepositoryBranchProtectionRule())
+	cmd.AddCommand(CmdShowRepositoryBranchProtection())
+	cmd.AddCommand(CmdListRepositoryMilestone())
+	cmd.AddCommand(CmdShowRepositoryMilestone())
+	cmd.AddCommand(CmdListRepositoryIssueTemplate())
+	cmd.AddCommand(CmdShowRepositoryIssueTemplate())
+	cmd.AddCommand(CmdShowRepositoryMergeQueue())
+	cmd.AddCommand(CmdListRepositoryCommitStatus())
+	cmd.AddCommand(CmdShowRepositoryCombinedCommitStatus())
+	cmd.AddCommand(CmdListRepositoryStargazer())
+	cmd.AddCommand(CmdListUserStarredRepository())
+	cmd.AddCommand(CmdListRepositoryWatcher())
+	cmd.AddCommand(CmdListUserWatchedRepository())
 
 	cmd.AddCommand(CmdListTag())
 	cmd.AddCommand(CmdListRepositoryTag())
@@ -41,6 +54,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListBounty())
 	cmd.AddCommand(CmdShowBounty())
+	cmd.AddCommand(CmdListBountyFunder())
 	// this line is used by starport scaffolding # 1
 
 	cmd.AddCommand(CmdListRelease())
@@ -49,6 +63,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListPullRequest())
 	cmd.AddCommand(CmdListRepositoryPullRequest())
 	cmd.AddCommand(CmdShowRepositoryPullRequest())
+	cmd.AddCommand(CmdListPullRequestReview())
+	cmd.AddCommand(CmdShowPullRequestAutoMerge())
 
 	cmd.AddCommand(CmdListDao())
 	cmd.AddCommand(CmdShowDao())
@@ -56,6 +72,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListComment())
 	cmd.AddCommand(CmdListIssueComment())
 	cmd.AddCommand(CmdListPullRequestComment())
+	cmd.AddCommand(CmdListPullRequestUnresolvedCommentThread())
+	cmd.AddCommand(CmdShowCommentHistory())
 	cmd.AddCommand(CmdShowIssueComment())
 	cmd.AddCommand(CmdShowPullRequestComment())
 
@@ -68,6 +86,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListUser())
 	cmd.AddCommand(CmdShowUser())
+	cmd.AddCommand(CmdListUserFollowers())
+	cmd.AddCommand(CmdListUserFollowing())
+	cmd.AddCommand(CmdListNotification())
 
 	cmd.AddCommand(CmdListWhois())
 	cmd.AddCommand(CmdShowWhois())
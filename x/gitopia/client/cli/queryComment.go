@@ -133,6 +133,106 @@ func CmdListPullRequestComment() *cobra.Command {
 	return cmd
 }
 
+func CmdShowCommentHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-comment-history [repository-id] [parent-iid] [parent] [comment-iid]",
+		Short: "shows the edit history of a comment, or of the issue/pullrequest description when comment-iid is 0",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			repositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			parentIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			parent, err := strconv.ParseInt(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			commentIid, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCommentHistoryRequest{
+				RepositoryId: repositoryId,
+				Parent:       types.CommentParent(parent),
+				ParentIid:    parentIid,
+				CommentIid:   commentIid,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.CommentHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListPullRequestUnresolvedCommentThread() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pullrequest-unresolved-comment-thread [repository-id] [pullrequest-iid]",
+		Short: "list all unresolved review comment threads of a pullrequest",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			repositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pullRequestIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllPullRequestUnresolvedCommentThreadRequest{
+				RepositoryId:   repositoryId,
+				PullRequestIid: pullRequestIid,
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.PullRequestUnresolvedCommentThreadAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdShowIssueComment() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show-issue-comment [repository-id] [issue-iid] [comment-iid]",
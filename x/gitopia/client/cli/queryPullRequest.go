@@ -131,3 +131,86 @@ func CmdShowRepositoryPullRequest() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListPullRequestReview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pullrequest-review [repository-id] [pullrequest-iid]",
+		Short: "list all pullrequest review",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			repositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pullRequestIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllPullRequestReviewRequest{
+				RepositoryId:   repositoryId,
+				PullRequestIid: pullRequestIid,
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.PullRequestReviewAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowPullRequestAutoMerge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-pullrequest-auto-merge [repository-id] [pullrequest-iid]",
+		Short: "shows the auto-merge of a pullrequest",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			repositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pullRequestIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetPullRequestAutoMergeRequest{
+				RepositoryId:   repositoryId,
+				PullRequestIid: pullRequestIid,
+			}
+
+			res, err := queryClient.PullRequestAutoMerge(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
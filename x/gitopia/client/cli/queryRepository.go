@@ -12,8 +12,9 @@ import (
 
 func CmdListRepository() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "list-repository",
-		Short: "list all repository",
+		Use:   "list-repository [archived]",
+		Short: "list all repository, optionally only the archived (true) or active (false) ones",
+		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
@@ -27,6 +28,9 @@ func CmdListRepository() *cobra.Command {
 			params := &types.QueryAllRepositoryRequest{
 				Pagination: pageReq,
 			}
+			if len(args) > 0 {
+				params.Option = &types.RepositoryOptions{Archived: args[0]}
+			}
 
 			res, err := queryClient.RepositoryAll(context.Background(), params)
 			if err != nil {
@@ -75,3 +75,43 @@ func CmdShowBounty() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListBountyFunder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-bounty-funder [id]",
+		Short: "list the funders of a Bounty",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllBountyFunderRequest{
+				Id:         id,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BountyFunderAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
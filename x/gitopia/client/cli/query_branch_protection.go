@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListRepositoryBranchProtectionRule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-branch-protection-rule [id] [repository-name]",
+		Short: "list all repository branch protection rules",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllRepositoryBranchProtectionRuleRequest{
+				Id:             args[0],
+				RepositoryName: args[1],
+			}
+
+			res, err := queryClient.RepositoryBranchProtectionRuleAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRepositoryBranchProtection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-branch-protection [id] [repository-name] [branch-name]",
+		Short: "shows the branch protection rules matching a branch",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetRepositoryBranchProtectionRequest{
+				Id:             args[0],
+				RepositoryName: args[1],
+				BranchName:     args[2],
+			}
+
+			res, err := queryClient.RepositoryBranchProtection(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListRepositoryCommitStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-commit-status [id] [repository-name] [sha]",
+		Short: "list all the statuses of a repository commit",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllRepositoryCommitStatusRequest{
+				Id:             args[0],
+				RepositoryName: args[1],
+				Sha:            args[2],
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.RepositoryCommitStatusAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRepositoryCombinedCommitStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-combined-commit-status [id] [repository-name] [ref]",
+		Short: "shows the combined status of a repository branch, tag or commit",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetRepositoryCombinedCommitStatusRequest{
+				Id:             args[0],
+				RepositoryName: args[1],
+				Ref:            args[2],
+			}
+
+			res, err := queryClient.RepositoryCombinedCommitStatus(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListUserFollowers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-user-followers [id]",
+		Short: "list all the followers of a user or dao",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryUserFollowersRequest{
+				Id:         args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.UserFollowers(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListUserFollowing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-user-following [id]",
+		Short: "list all the users and daos followed by a user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryUserFollowingRequest{
+				Id:         args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.UserFollowing(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListRepositoryIssueTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-repository-issue-template [id] [repository-name]",
+		Short: "list all repository issue templates",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllRepositoryIssueTemplateRequest{
+				Id:             args[0],
+				RepositoryName: args[1],
+			}
+
+			res, err := queryClient.RepositoryIssueTemplateAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRepositoryIssueTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-repository-issue-template [id] [repository-name] [template-id]",
+		Short: "shows a repository issue template",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			templateId, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetRepositoryIssueTemplateRequest{
+				Id:             args[0],
+				RepositoryName: args[1],
+				TemplateId:     templateId,
+			}
+
+			res, err := queryClient.RepositoryIssueTemplate(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowRepositoryMergeQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-merge-queue [id] [repository-name] [branch-name]",
+		Short: "shows the merge queue of a branch",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetRepositoryMergeQueueRequest{
+				Id:             args[0],
+				RepositoryName: args[1],
+				BranchName:     args[2],
+			}
+
+			res, err := queryClient.RepositoryMergeQueue(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
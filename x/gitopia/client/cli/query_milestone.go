@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListRepositoryMilestone() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-repository-milestone [id] [repository-name]",
+		Short: "list all repository milestones",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllRepositoryMilestoneRequest{
+				Id:             args[0],
+				RepositoryName: args[1],
+			}
+
+			res, err := queryClient.RepositoryMilestoneAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRepositoryMilestone() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-repository-milestone [id] [repository-name] [milestone-id]",
+		Short: "shows a repository milestone with its progress",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			milestoneId, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetRepositoryMilestoneRequest{
+				Id:             args[0],
+				RepositoryName: args[1],
+				MilestoneId:    milestoneId,
+			}
+
+			res, err := queryClient.RepositoryMilestone(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -56,7 +56,16 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdateBountyExpiry())
 	cmd.AddCommand(CmdCloseBounty())
 	cmd.AddCommand(CmdDeleteBounty())
+	cmd.AddCommand(CmdSetBountySplits())
+	cmd.AddCommand(CmdFundBounty())
+	cmd.AddCommand(CmdDisputeBounty())
+	cmd.AddCommand(CmdResolveBountyDispute())
+	cmd.AddCommand(CmdAwardBounty())
 	cmd.AddCommand(CmdToggleForcePush())
+	cmd.AddCommand(CmdCreateBranchProtectionRule())
+	cmd.AddCommand(CmdUpdateBranchProtectionRule())
+	cmd.AddCommand(CmdDeleteBranchProtectionRule())
+	cmd.AddCommand(CmdSetCommitStatus())
 	cmd.AddCommand(CmdExercise())
 // this line is used by starport scaffolding # 1
 
@@ -77,7 +86,17 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdRemovePullRequestReviewers())
 	cmd.AddCommand(CmdAddPullRequestLabels())
 	cmd.AddCommand(CmdRemovePullRequestLabels())
+	cmd.AddCommand(CmdSetPullRequestMilestone())
 	cmd.AddCommand(CmdDeletePullRequest())
+	cmd.AddCommand(CmdSubmitPullRequestReview())
+	cmd.AddCommand(CmdMarkPullRequestReadyForReview())
+	cmd.AddCommand(CmdConvertPullRequestToDraft())
+	cmd.AddCommand(CmdEnablePullRequestAutoMerge())
+	cmd.AddCommand(CmdDisablePullRequestAutoMerge())
+	cmd.AddCommand(CmdAddPullRequestToMergeQueue())
+	cmd.AddCommand(CmdRemovePullRequestFromMergeQueue())
+	cmd.AddCommand(CmdSetPullRequestChangedPaths())
+	cmd.AddCommand(CmdSetPullRequestCommitMessages())
 
 	cmd.AddCommand(CmdCreateDao())
 	cmd.AddCommand(CmdRenameDao())
@@ -90,6 +109,13 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateComment())
 	cmd.AddCommand(CmdUpdateComment())
 	cmd.AddCommand(CmdDeleteComment())
+	cmd.AddCommand(CmdToggleReaction())
+	cmd.AddCommand(CmdResolveCommentThread())
+	cmd.AddCommand(CmdUnresolveCommentThread())
+	cmd.AddCommand(CmdHideComment())
+	cmd.AddCommand(CmdUnhideComment())
+	cmd.AddCommand(CmdLockConversation())
+	cmd.AddCommand(CmdUnlockConversation())
 
 	cmd.AddCommand(CmdCreateIssue())
 	cmd.AddCommand(CmdUpdateIssueTitle())
@@ -99,7 +125,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdRemoveIssueAssignees())
 	cmd.AddCommand(CmdAddIssueLabels())
 	cmd.AddCommand(CmdRemoveIssueLabels())
+	cmd.AddCommand(CmdSetIssueMilestone())
 	cmd.AddCommand(CmdDeleteIssue())
+	cmd.AddCommand(CmdTransferIssue())
 
 	cmd.AddCommand(CmdCreateRepository())
 	cmd.AddCommand(CmdInvokeForkRepository())
@@ -112,7 +140,22 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateRepositoryLabel())
 	cmd.AddCommand(CmdUpdateRepositoryLabel())
 	cmd.AddCommand(CmdDeleteRepositoryLabel())
+	cmd.AddCommand(CmdCreateRepositoryMilestone())
+	cmd.AddCommand(CmdUpdateRepositoryMilestone())
+	cmd.AddCommand(CmdToggleRepositoryMilestoneState())
+	cmd.AddCommand(CmdDeleteRepositoryMilestone())
+	cmd.AddCommand(CmdCreateRepositoryIssueTemplate())
+	cmd.AddCommand(CmdUpdateRepositoryIssueTemplate())
+	cmd.AddCommand(CmdDeleteRepositoryIssueTemplate())
 	cmd.AddCommand(CmdToggleRepositoryForking())
+	cmd.AddCommand(CmdUpdateRepositoryAllowedMergeMethods())
+	cmd.AddCommand(CmdUpdateRepositoryCodeOwners())
+	cmd.AddCommand(CmdArchiveRepository())
+	cmd.AddCommand(CmdUnarchiveRepository())
+	cmd.AddCommand(CmdStarRepository())
+	cmd.AddCommand(CmdUnstarRepository())
+	cmd.AddCommand(CmdWatchRepository())
+	cmd.AddCommand(CmdUnwatchRepository())
 	cmd.AddCommand(CmdDeleteRepository())
 
 	cmd.AddCommand(CmdCreateUser())
@@ -121,6 +164,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdateUserBio())
 	cmd.AddCommand(CmdUpdateUserAvatar())
 	cmd.AddCommand(CmdDeleteUser())
+	cmd.AddCommand(CmdFollow())
+	cmd.AddCommand(CmdUnfollow())
+	cmd.AddCommand(CmdMarkNotificationsRead())
 	// cmd.AddCommand(CmdTransferUser())
 
 	return cmd
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"encoding/json"
+	"errors"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -16,9 +17,9 @@ import (
 
 func CmdCreateComment() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-comment [repository-id] [parent-iid] [parent] [body] [attachments] [diffhunk] [path] [position]",
+		Use:   "create-comment [repository-id] [parent-iid] [parent] [body] [attachments] [diffhunk] [path] [position] [in-reply-to]",
 		Short: "Create a new comment",
-		Args:  cobra.ExactArgs(8),
+		Args:  cobra.ExactArgs(9),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
@@ -58,6 +59,10 @@ func CmdCreateComment() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			argsInReplyTo, err := strconv.ParseUint(args[8], 10, 64)
+			if err != nil {
+				return err
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -72,7 +77,8 @@ func CmdCreateComment() *cobra.Command {
 				attachments,
 				string(argsDiffHunk),
 				string(argsPath),
-				argsPosition)
+				argsPosition,
+				argsInReplyTo)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -179,3 +185,282 @@ func CmdDeleteComment() *cobra.Command {
 
 	return cmd
 }
+
+func CmdToggleReaction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "toggle-reaction [repository-id] [parent-iid] [parent] [comment-iid] [emoji]",
+		Short: "Toggle a reaction on a comment, or on the issue/pull request itself when comment-iid is 0",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParentIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParent, err := strconv.ParseInt(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			argsCommentIid, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsEmoji, exists := types.Emoji_value[args[4]]
+			if !exists {
+				return errors.New("invalid emoji")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgToggleReaction(clientCtx.GetFromAddress().String(), argsRepositoryId, argsParentIid, types.CommentParent(argsParent), argsCommentIid, types.Emoji(argsEmoji))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdResolveCommentThread() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-comment-thread [repository-id] [pull-request-iid] [comment-iid]",
+		Short: "Resolve a review comment thread of a pull request",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsPullRequestIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsCommentIid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResolveCommentThread(clientCtx.GetFromAddress().String(), argsRepositoryId, argsPullRequestIid, argsCommentIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnresolveCommentThread() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unresolve-comment-thread [repository-id] [pull-request-iid] [comment-iid]",
+		Short: "Unresolve a review comment thread of a pull request",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsPullRequestIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsCommentIid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnresolveCommentThread(clientCtx.GetFromAddress().String(), argsRepositoryId, argsPullRequestIid, argsCommentIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdHideComment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hide-comment [repository-id] [parent-iid] [parent] [comment-iid] [reason]",
+		Short: "Hide a comment with a reason",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParentIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParent, err := strconv.ParseInt(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			argsCommentIid, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsReason, exists := types.CommentHiddenReason_value[args[4]]
+			if !exists {
+				return errors.New("invalid reason")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgHideComment(clientCtx.GetFromAddress().String(), argsRepositoryId, argsParentIid, types.CommentParent(argsParent), argsCommentIid, types.CommentHiddenReason(argsReason))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnhideComment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unhide-comment [repository-id] [parent-iid] [parent] [comment-iid]",
+		Short: "Unhide a hidden comment",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParentIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParent, err := strconv.ParseInt(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			argsCommentIid, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnhideComment(clientCtx.GetFromAddress().String(), argsRepositoryId, argsParentIid, types.CommentParent(argsParent), argsCommentIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdLockConversation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-conversation [repository-id] [parent-iid] [parent]",
+		Short: "Lock the conversation of an issue or pull request to collaborators",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParentIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParent, err := strconv.ParseInt(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLockConversation(clientCtx.GetFromAddress().String(), argsRepositoryId, argsParentIid, types.CommentParent(argsParent))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnlockConversation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-conversation [repository-id] [parent-iid] [parent]",
+		Short: "Unlock the conversation of an issue or pull request",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParentIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParent, err := strconv.ParseInt(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnlockConversation(clientCtx.GetFromAddress().String(), argsRepositoryId, argsParentIid, types.CommentParent(argsParent))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
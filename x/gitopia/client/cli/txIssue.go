@@ -16,6 +16,8 @@ import (
 	"github.com/gitopia/gitopia/x/gitopia/utils"
 )
 
+const flagTemplateId = "template-id"
+
 func CmdCreateIssue() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-issue [id] [repository-name] [title] [description] [labels] [weight] [assignees] [bounty-amount] [bounty-expiry]",
@@ -65,6 +67,11 @@ func CmdCreateIssue() *cobra.Command {
 				return err
 			}
 
+			argTemplateId, err := cmd.Flags().GetUint64(flagTemplateId)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -80,6 +87,7 @@ func CmdCreateIssue() *cobra.Command {
 				argAssignees,
 				argAmount,
 				argExpiry,
+				argTemplateId,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -88,6 +96,7 @@ func CmdCreateIssue() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagTemplateId, 0, "id of the issue template to create the issue from")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -382,3 +391,41 @@ func CmdDeleteIssue() *cobra.Command {
 
 	return cmd
 }
+
+func CmdTransferIssue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-issue [repository-id] [iid] [target-id] [target-repository-name]",
+		Short: "Transfer an issue to another repository",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferIssue(
+				clientCtx.GetFromAddress().String(),
+				argsRepositoryId,
+				argsIid,
+				types.RepositoryId{Id: args[2], Name: args[3]},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 
@@ -15,9 +16,9 @@ import (
 
 func CmdCreatePullRequest() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-pullRequest [title] [description] [headBranch] [headRepoId] [baseBranch] [baseRepoId] [reviewers] [assignees] [labelIds] [issueIids]",
+		Use:   "create-pullRequest [title] [description] [headBranch] [headRepoId] [baseBranch] [baseRepoId] [reviewers] [assignees] [labelIds] [issueIids] [draft]",
 		Short: "Create a new pullRequest",
-		Args:  cobra.ExactArgs(10),
+		Args:  cobra.ExactArgs(11),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			argTitle := args[0]
 			argDescription := args[1]
@@ -45,6 +46,10 @@ func CmdCreatePullRequest() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			argDraft, err := strconv.ParseBool(args[10])
+			if err != nil {
+				return err
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -63,6 +68,7 @@ func CmdCreatePullRequest() *cobra.Command {
 				argAssignees,
 				labelIds,
 				issueIids,
+				argDraft,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -152,9 +158,9 @@ func CmdUpdatePullRequestDescription() *cobra.Command {
 
 func CmdInvokeMergePullRequest() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "invoke-merge-pullrequest [repository-id] [iid] [provider]",
+		Use:   "invoke-merge-pullrequest [repository-id] [iid] [provider] [merge-method]",
 		Short: "Emits an event for git-server to merge a Pull Request",
-		Args:  cobra.ExactArgs(3),
+		Args:  cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
@@ -168,13 +174,17 @@ func CmdInvokeMergePullRequest() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			argsMergeMethod, exists := types.MergeMethod_value[args[3]]
+			if !exists {
+				return errors.New("invalid merge method")
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgInvokeMergePullRequest(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, string(argsProvider))
+			msg := types.NewMsgInvokeMergePullRequest(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, string(argsProvider), types.MergeMethod(argsMergeMethod))
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -189,9 +199,9 @@ func CmdInvokeMergePullRequest() *cobra.Command {
 
 func CmdSetPullRequestState() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "set-pullrequest-state [repository-id] [iid] [state] [merge-commit-sha] [comment-body] [task-id]",
+		Use:   "set-pullrequest-state [repository-id] [iid] [state] [merge-commit-sha] [comment-body] [task-id] [merge-method]",
 		Short: "Set pullrequest state",
-		Args:  cobra.ExactArgs(4),
+		Args:  cobra.ExactArgs(7),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
@@ -217,6 +227,10 @@ func CmdSetPullRequestState() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			argsMergeMethod, exists := types.MergeMethod_value[args[6]]
+			if !exists {
+				return errors.New("invalid merge method")
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -230,6 +244,7 @@ func CmdSetPullRequestState() *cobra.Command {
 				string(argsMergeCommitSha),
 				string(argsCommentBody),
 				argsTaskId,
+				types.MergeMethod(argsMergeMethod),
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -572,3 +587,251 @@ func CmdDeletePullRequest() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSubmitPullRequestReview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-pullrequest-review [repository-id] [iid] [state] [body] [commit-sha]",
+		Short: "Submit a pullRequest review (APPROVE, REQUEST_CHANGES or COMMENT)",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			state, ok := types.PullRequestReview_State_value[args[2]]
+			if !ok {
+				return errors.New("invalid review state")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitPullRequestReview(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, types.PullRequestReview_State(state), args[3], args[4])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdMarkPullRequestReadyForReview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mark-pullrequest-ready-for-review [repository-id] [iid]",
+		Short: "Mark a draft pullRequest as ready for review",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMarkPullRequestReadyForReview(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConvertPullRequestToDraft() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-pullrequest-to-draft [repository-id] [iid]",
+		Short: "Convert a pullRequest to draft",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConvertPullRequestToDraft(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEnablePullRequestAutoMerge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-pullrequest-auto-merge [repository-id] [iid] [provider] [merge-method]",
+		Short: "Merge a pullRequest automatically once all its merge requirements are met",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsProvider, err := cast.ToStringE(args[2])
+			if err != nil {
+				return err
+			}
+			argsMergeMethod, exists := types.MergeMethod_value[args[3]]
+			if !exists {
+				return errors.New("invalid merge method")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEnablePullRequestAutoMerge(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, argsProvider, types.MergeMethod(argsMergeMethod))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDisablePullRequestAutoMerge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable-pullrequest-auto-merge [repository-id] [iid]",
+		Short: "Cancel the auto-merge of a pullRequest",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDisablePullRequestAutoMerge(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetPullRequestChangedPaths() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pullrequest-changed-paths [repository-id] [iid] [changed-paths]",
+		Short: "Set the paths changed by a pullRequest and request the review of their code owners",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsChangedPaths := strings.Split(args[2], ",")
+			if len(argsChangedPaths) == 1 && argsChangedPaths[0] == "" {
+				argsChangedPaths = nil
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPullRequestChangedPaths(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, argsChangedPaths)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetPullRequestCommitMessages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pullrequest-commit-messages [repository-id] [iid] [commit-message]...",
+		Short: "Report the commit messages of a pullRequest and cross-reference the issues they mention",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsCommitMessages := args[2:]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPullRequestCommitMessages(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, argsCommitMessages)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"errors"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -438,6 +440,267 @@ func CmdToggleRepositoryForking() *cobra.Command {
 	return cmd
 }
 
+func CmdUpdateRepositoryAllowedMergeMethods() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-repository-allowed-merge-methods [id] [repository-name] [merge-methods]",
+		Short: "Update the merge methods allowed in a repository",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+			var argAllowedMergeMethods []types.MergeMethod
+			for _, mergeMethod := range strings.Split(args[2], ",") {
+				value, exists := types.MergeMethod_value[mergeMethod]
+				if !exists {
+					return errors.New("invalid merge method")
+				}
+				argAllowedMergeMethods = append(argAllowedMergeMethods, types.MergeMethod(value))
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRepositoryAllowedMergeMethods(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+				argAllowedMergeMethods,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateRepositoryCodeOwners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-repository-code-owners [id] [repository-name] [pattern=owners]...",
+		Short: "Update the code owners of a repository, the last matching pattern takes precedence",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+			var argCodeOwners []*types.CodeOwnerRule
+			for _, rule := range args[2:] {
+				pattern, owners, found := strings.Cut(rule, "=")
+				if !found {
+					return errors.New("invalid code owner rule")
+				}
+				argCodeOwners = append(argCodeOwners, &types.CodeOwnerRule{
+					Pattern: pattern,
+					Owners:  strings.Split(owners, ","),
+				})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRepositoryCodeOwners(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+				argCodeOwners,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdArchiveRepository() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive-repository [id] [repository-name]",
+		Short: "Archive a repository, making it read-only",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgArchiveRepository(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnarchiveRepository() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unarchive-repository [id] [repository-name]",
+		Short: "Unarchive a repository",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnarchiveRepository(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdStarRepository() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "star-repository [id] [repository-name]",
+		Short: "Star a repository",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStarRepository(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnstarRepository() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unstar-repository [id] [repository-name]",
+		Short: "Remove the star from a repository",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnstarRepository(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWatchRepository() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch-repository [id] [repository-name]",
+		Short: "Watch a repository",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWatchRepository(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnwatchRepository() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unwatch-repository [id] [repository-name]",
+		Short: "Stop watching a repository",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnwatchRepository(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdToggleArweaveBackup() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "toggle-arweave-backup [id] [repository-name]",
@@ -180,6 +180,58 @@ func CmdDeleteUser() *cobra.Command {
 	return cmd
 }
 
+func CmdFollow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "follow [id]",
+		Short: "Follow a user or dao",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFollow(clientCtx.GetFromAddress().String(), argId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnfollow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfollow [id]",
+		Short: "Unfollow a user or dao",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfollow(clientCtx.GetFromAddress().String(), argId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // func CmdTransferUser() *cobra.Command {
 // 	cmd := &cobra.Command{
 // 		Use:   "transfer-user [address]",
@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -149,3 +150,176 @@ func CmdDeleteBounty() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSetBountySplits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bounty-splits [id] [address=percentage%|address=amount]...",
+		Short: "Set the payout splits of a Bounty, the rest of the bounty is paid to the pull request creator",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var splits []*types.BountySplit
+			for _, arg := range args[1:] {
+				address, share, found := strings.Cut(arg, "=")
+				if !found {
+					return errors.New("invalid bounty split")
+				}
+				split := &types.BountySplit{Address: address}
+				if strings.HasSuffix(share, "%") {
+					split.Percentage, err = strconv.ParseUint(strings.TrimSuffix(share, "%"), 10, 64)
+				} else {
+					split.Amount, err = cosmosTypes.ParseCoinsNormalized(share)
+				}
+				if err != nil {
+					return err
+				}
+				splits = append(splits, split)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBountySplits(clientCtx.GetFromAddress().String(), id, splits)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFundBounty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-bounty [id] [amount]",
+		Short: "Add coins to a Bounty",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argAmount, err := cosmosTypes.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundBounty(clientCtx.GetFromAddress().String(), id, argAmount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDisputeBounty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute-bounty [id] [reason]",
+		Short: "Dispute an awarded Bounty",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDisputeBounty(clientCtx.GetFromAddress().String(), id, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdResolveBountyDispute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-bounty-dispute [id] [recipient]",
+		Short: "Resolve a Bounty dispute by paying it to recipient, or refunding it when recipient is omitted",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var recipient string
+			if len(args) > 1 {
+				recipient = args[1]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResolveBountyDispute(clientCtx.GetFromAddress().String(), id, recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAwardBounty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "award-bounty [id] [recipient]",
+		Short: "Award a Bounty to a user",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAwardBounty(clientCtx.GetFromAddress().String(), id, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,182 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdCreateBranchProtectionRule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-branch-protection-rule [id] [repository-name] [pattern] [required-approvals] [required-status-checks] [allowed-pushers] [require-linear-history] [prevent-deletion] [require-code-owner-review] [require-conversation-resolution]",
+		Short: "Create a branch protection rule",
+		Args:  cobra.ExactArgs(10),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId := args[0]
+			argRepositoryName := args[1]
+			argPattern := args[2]
+			argRequiredApprovals, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			argRequiredStatusChecks := splitOptionalList(args[4])
+			argAllowedPushers := splitOptionalList(args[5])
+			argRequireLinearHistory, err := strconv.ParseBool(args[6])
+			if err != nil {
+				return err
+			}
+			argPreventDeletion, err := strconv.ParseBool(args[7])
+			if err != nil {
+				return err
+			}
+			argRequireCodeOwnerReview, err := strconv.ParseBool(args[8])
+			if err != nil {
+				return err
+			}
+			argRequireConversationResolution, err := strconv.ParseBool(args[9])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateBranchProtectionRule(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+				argPattern,
+				argRequiredApprovals,
+				argRequiredStatusChecks,
+				argAllowedPushers,
+				argRequireLinearHistory,
+				argPreventDeletion,
+				argRequireCodeOwnerReview,
+				argRequireConversationResolution,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateBranchProtectionRule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-branch-protection-rule [id] [repository-name] [rule-id] [pattern] [required-approvals] [required-status-checks] [allowed-pushers] [require-linear-history] [prevent-deletion] [require-code-owner-review] [require-conversation-resolution]",
+		Short: "Update a branch protection rule",
+		Args:  cobra.ExactArgs(11),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId := args[0]
+			argRepositoryName := args[1]
+			argRuleId, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argPattern := args[3]
+			argRequiredApprovals, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+			argRequiredStatusChecks := splitOptionalList(args[5])
+			argAllowedPushers := splitOptionalList(args[6])
+			argRequireLinearHistory, err := strconv.ParseBool(args[7])
+			if err != nil {
+				return err
+			}
+			argPreventDeletion, err := strconv.ParseBool(args[8])
+			if err != nil {
+				return err
+			}
+			argRequireCodeOwnerReview, err := strconv.ParseBool(args[9])
+			if err != nil {
+				return err
+			}
+			argRequireConversationResolution, err := strconv.ParseBool(args[10])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateBranchProtectionRule(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+				argRuleId,
+				argPattern,
+				argRequiredApprovals,
+				argRequiredStatusChecks,
+				argAllowedPushers,
+				argRequireLinearHistory,
+				argPreventDeletion,
+				argRequireCodeOwnerReview,
+				argRequireConversationResolution,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDeleteBranchProtectionRule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-branch-protection-rule [id] [repository-name] [rule-id]",
+		Short: "Delete a branch protection rule",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId := args[0]
+			argRepositoryName := args[1]
+			argRuleId, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteBranchProtectionRule(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+				argRuleId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// splitOptionalList splits a comma separated argument, an empty argument yields an empty list
+func splitOptionalList(arg string) []string {
+	if arg == "" {
+		return nil
+	}
+	return strings.Split(arg, ",")
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetCommitStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-commit-status [id] [repository-name] [sha] [context] [state] [target-url] [description]",
+		Short: "Set the status of a commit for a context (PENDING, SUCCESS, FAILURE or ERROR)",
+		Args:  cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId := args[0]
+			argRepositoryName := args[1]
+			argSha := args[2]
+			argContext := args[3]
+			state, ok := types.CommitStatus_State_value[args[4]]
+			if !ok {
+				return errors.New("invalid commit status state")
+			}
+			argTargetUrl := args[5]
+			argDescription := args[6]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCommitStatus(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+				argSha,
+				argContext,
+				types.CommitStatus_State(state),
+				argTargetUrl,
+				argDescription,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+	"github.com/spf13/cobra"
+)
+
+// parseIssueTemplateArgs parses the [labels] [assignees] [group=labels]... arguments of the issue template commands
+func parseIssueTemplateArgs(args []string) (labelIds []uint64, assignees []string, requiredLabelGroups []*types.IssueTemplateLabelGroup, err error) {
+	if args[0] != "" {
+		labelIds, err = utils.SliceAtoi(strings.Split(args[0], ","))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if args[1] != "" {
+		assignees = strings.Split(args[1], ",")
+	}
+	for _, group := range args[2:] {
+		name, labels, found := strings.Cut(group, "=")
+		if !found {
+			return nil, nil, nil, errors.New("invalid required label group")
+		}
+		groupLabelIds, err := utils.SliceAtoi(strings.Split(labels, ","))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		requiredLabelGroups = append(requiredLabelGroups, &types.IssueTemplateLabelGroup{
+			Name:   name,
+			Labels: groupLabelIds,
+		})
+	}
+	return labelIds, assignees, requiredLabelGroups, nil
+}
+
+func CmdCreateRepositoryIssueTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-repository-issue-template [id] [repository-name] [name] [body] [labels] [assignees] [group=labels]...",
+		Short: "Create a repository issue template, issues created from it need a label of each required group",
+		Args:  cobra.MinimumNArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId := args[0]
+			argRepositoryName := args[1]
+			argName := args[2]
+			argBody := args[3]
+			argLabelIds, argAssignees, argRequiredLabelGroups, err := parseIssueTemplateArgs(args[4:])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateRepositoryIssueTemplate(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+				argName,
+				argBody,
+				argLabelIds,
+				argAssignees,
+				argRequiredLabelGroups,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateRepositoryIssueTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-repository-issue-template [id] [repository-name] [template-id] [name] [body] [labels] [assignees] [group=labels]...",
+		Short: "Update a repository issue template",
+		Args:  cobra.MinimumNArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId := args[0]
+			argRepositoryName := args[1]
+			argTemplateId, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argName := args[3]
+			argBody := args[4]
+			argLabelIds, argAssignees, argRequiredLabelGroups, err := parseIssueTemplateArgs(args[5:])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRepositoryIssueTemplate(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+				argTemplateId,
+				argName,
+				argBody,
+				argLabelIds,
+				argAssignees,
+				argRequiredLabelGroups,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDeleteRepositoryIssueTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-repository-issue-template [id] [repository-name] [template-id]",
+		Short: "Delete a repository issue template",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId := args[0]
+			argRepositoryName := args[1]
+			argTemplateId, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteRepositoryIssueTemplate(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+				argTemplateId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdAddPullRequestToMergeQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-pullrequest-to-merge-queue [repository-id] [iid] [provider] [merge-method]",
+		Short: "Add a pullRequest to the merge queue of its base branch",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsProvider, err := cast.ToStringE(args[2])
+			if err != nil {
+				return err
+			}
+			argsMergeMethod, exists := types.MergeMethod_value[args[3]]
+			if !exists {
+				return errors.New("invalid merge method")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddPullRequestToMergeQueue(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, argsProvider, types.MergeMethod(argsMergeMethod))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemovePullRequestFromMergeQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-pullrequest-from-merge-queue [repository-id] [iid]",
+		Short: "Remove a pullRequest from the merge queue of its base branch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemovePullRequestFromMergeQueue(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
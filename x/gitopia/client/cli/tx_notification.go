@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+	"github.com/spf13/cobra"
+)
+
+func CmdMarkNotificationsRead() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mark-notifications-read [ids]",
+		Short: "Mark notifications as read, or all notifications when no id is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var ids []uint64
+			if len(args) > 0 {
+				var err error
+				ids, err = utils.SliceAtoi(strings.Split(args[0], ","))
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMarkNotificationsRead(clientCtx.GetFromAddress().String(), ids)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
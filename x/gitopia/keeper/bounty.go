@@ -47,6 +47,8 @@ func (k Keeper) AppendBounty(
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyKey))
 	appendedValue := k.cdc.MustMarshal(&bounty)
 	store.Set(GetBountyIDBytes(bounty.Id), appendedValue)
+	k.setBountyExpiry(ctx, bounty)
+	k.setBountyRelease(ctx, bounty)
 
 	// Update bounty count
 	k.SetBountyCount(ctx, count+1)
@@ -56,9 +58,16 @@ func (k Keeper) AppendBounty(
 
 // SetBounty set a specific bounty in the store
 func (k Keeper) SetBounty(ctx sdk.Context, bounty types.Bounty) {
+	if old, found := k.GetBounty(ctx, bounty.Id); found {
+		k.removeBountyExpiry(ctx, old)
+		k.removeBountyRelease(ctx, old)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyKey))
 	b := k.cdc.MustMarshal(&bounty)
 	store.Set(GetBountyIDBytes(bounty.Id), b)
+	k.setBountyExpiry(ctx, bounty)
+	k.setBountyRelease(ctx, bounty)
 }
 
 // GetBounty returns a bounty from its id
@@ -74,6 +83,11 @@ func (k Keeper) GetBounty(ctx sdk.Context, id uint64) (val types.Bounty, found b
 
 // RemoveBounty removes a bounty from the store
 func (k Keeper) RemoveBounty(ctx sdk.Context, id uint64) {
+	if old, found := k.GetBounty(ctx, id); found {
+		k.removeBountyExpiry(ctx, old)
+		k.removeBountyRelease(ctx, old)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyKey))
 	store.Delete(GetBountyIDBytes(id))
 }
@@ -94,6 +108,73 @@ func (k Keeper) GetAllBounty(ctx sdk.Context) (list []types.Bounty) {
 	return
 }
 
+// setBountyExpiry adds an open bounty to the expiry index
+func (k Keeper) setBountyExpiry(ctx sdk.Context, bounty types.Bounty) {
+	if bounty.State != types.BountyStateSRCDEBITTED || bounty.ExpireAt <= 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyExpiryKey))
+	store.Set(GetBountyExpiryKeyBytes(bounty.ExpireAt, bounty.Id), GetBountyIDBytes(bounty.Id))
+}
+
+// removeBountyExpiry removes a bounty from the expiry index
+func (k Keeper) removeBountyExpiry(ctx sdk.Context, bounty types.Bounty) {
+	if bounty.ExpireAt <= 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyExpiryKey))
+	store.Delete(GetBountyExpiryKeyBytes(bounty.ExpireAt, bounty.Id))
+}
+
+// GetExpiredBountyIds returns the ids of at most limit open bounties expired at blockTime,
+// in the order of their expiry
+func (k Keeper) GetExpiredBountyIds(ctx sdk.Context, blockTime int64, limit int) (ids []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyExpiryKey))
+	// bounties expiring at blockTime are expired too
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(blockTime)+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		ids = append(ids, GetBountyIDFromBytes(iterator.Value()))
+	}
+
+	return
+}
+
+// setBountyRelease adds an awarded bounty to the release index
+func (k Keeper) setBountyRelease(ctx sdk.Context, bounty types.Bounty) {
+	if bounty.State != types.BountyStateAWARDED {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyReleaseKey))
+	store.Set(GetBountyExpiryKeyBytes(bounty.ReleaseAt, bounty.Id), GetBountyIDBytes(bounty.Id))
+}
+
+// removeBountyRelease removes a bounty from the release index
+func (k Keeper) removeBountyRelease(ctx sdk.Context, bounty types.Bounty) {
+	if bounty.State != types.BountyStateAWARDED {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyReleaseKey))
+	store.Delete(GetBountyExpiryKeyBytes(bounty.ReleaseAt, bounty.Id))
+}
+
+// GetReleasableBountyIds returns the ids of at most limit awarded bounties whose dispute
+// window has ended at blockTime, in the order of their release time
+func (k Keeper) GetReleasableBountyIds(ctx sdk.Context, blockTime int64, limit int) (ids []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyReleaseKey))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(blockTime)+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		ids = append(ids, GetBountyIDFromBytes(iterator.Value()))
+	}
+
+	return
+}
+
 // GetBountyIDBytes returns the Module address for bounty id
 func GetBountyAddress(bountyId uint64) sdk.AccAddress {
 	key := append([]byte("bounty"), sdk.Uint64ToBigEndian(bountyId)...)
@@ -111,3 +192,11 @@ func GetBountyIDBytes(id uint64) []byte {
 func GetBountyIDFromBytes(bz []byte) uint64 {
 	return binary.BigEndian.Uint64(bz)
 }
+
+// GetBountyExpiryKeyBytes returns the key of a bounty in the expiry and release indexes
+func GetBountyExpiryKeyBytes(expireAt int64, id uint64) []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(expireAt))
+	binary.BigEndian.PutUint64(bz[8:], id)
+	return bz
+}
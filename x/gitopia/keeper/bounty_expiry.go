@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+// RefundExpiredBounties refunds the open bounties whose expiry has passed to their funders.
+// At most MaxBountyRefundsPerBlock bounties are processed in a block; the rest are refunded
+// in the following blocks. A bounty which can't be refunded is dropped from the expiry index
+// and left open, so that its creator can close it manually. A bounty with a pull request in
+// flight is dropped from the expiry index too, and is indexed again by ResumeBountyExpiry
+// once the pull request is resolved.
+func (k Keeper) RefundExpiredBounties(ctx sdk.Context) {
+	blockTime := ctx.BlockTime().Unix()
+
+	for _, id := range k.GetExpiredBountyIds(ctx, blockTime, types.MaxBountyRefundsPerBlock) {
+		bounty, found := k.GetBounty(ctx, id)
+		if !found {
+			continue
+		}
+
+		if k.hasPullRequestInFlight(ctx, bounty) {
+			k.removeBountyExpiry(ctx, bounty)
+			continue
+		}
+
+		// refund in a cached context so that a failed refund doesn't leave partial writes
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.refundExpiredBounty(cacheCtx, bounty); err != nil {
+			k.removeBountyExpiry(ctx, bounty)
+			k.Logger(ctx).Error("failed to refund expired bounty", "id", bounty.Id, "err", err)
+			continue
+		}
+		write()
+	}
+}
+
+// hasPullRequestInFlight returns true if the issue of a bounty has linked pull requests
+// or the pull request of a bounty is open. CloseBounty rejects these bounties as well.
+func (k Keeper) hasPullRequestInFlight(ctx sdk.Context, bounty types.Bounty) bool {
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		issue, found := k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
+		return found && len(issue.PullRequests) > 0
+	case types.BountyParentPullRequest:
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		return found && pullRequest.State == types.PullRequest_OPEN
+	}
+	return false
+}
+
+// ResumeBountyExpiry adds the open bounties back to the expiry index, so that the ones
+// skipped while a pull request was in flight are refunded once they expire.
+func (k Keeper) ResumeBountyExpiry(ctx sdk.Context, bountyIds []uint64) {
+	for _, id := range bountyIds {
+		if bounty, found := k.GetBounty(ctx, id); found {
+			k.setBountyExpiry(ctx, bounty)
+		}
+	}
+}
+
+func (k Keeper) refundExpiredBounty(ctx sdk.Context, bounty types.Bounty) error {
+	blockTime := ctx.BlockTime().Unix()
+
+	if err := k.RefundBounty(ctx, bounty); err != nil {
+		return err
+	}
+
+	bounty.State = types.BountyStateREVERTEDBACK
+	bounty.ExpireAt = time.Time{}.Unix()
+	bounty.UpdatedAt = blockTime
+
+	k.SetBounty(ctx, bounty)
+
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		issue, found := k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist", bounty.ParentIid))
+		}
+
+		issue.CommentsCount += 1
+		issue.UpdatedAt = blockTime
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentIssue,
+			CommentIid:   issue.CommentsCount,
+			Body:         utils.ExpireBountyCommentBody(bounty.Amount),
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeClosedBounty,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+		k.SetIssue(ctx, issue)
+	case types.BountyParentPullRequest:
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist", bounty.ParentIid))
+		}
+
+		pullRequest.CommentsCount += 1
+		pullRequest.UpdatedAt = blockTime
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         utils.ExpireBountyCommentBody(bounty.Amount),
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeClosedBounty,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+		k.SetPullRequest(ctx, pullRequest)
+	default:
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid bounty parent")
+	}
+
+	bountyAmountJson, _ := json.Marshal(bounty.Amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.RefundBountyEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, bounty.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyAmountKey, string(bountyAmountJson)),
+			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/gitopia/gitopia/app/params"
+	keepertest "github.com/gitopia/gitopia/testutil/keeper"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefundExpiredBounties(t *testing.T) {
+	srv, context, keepers := setupMsgServerWithKeepers(t)
+	blockTime := time.Unix(1000000, 0)
+	ctx := sdk.UnwrapSDKContext(context).WithBlockTime(blockTime)
+	user := sample.AccAddress()
+	userAddr, err := sdk.AccAddressFromBech32(user)
+	require.NoError(t, err)
+	_, err = srv.CreateUser(ctx, &types.MsgCreateUser{Creator: user, Username: user})
+	require.NoError(t, err)
+	testutil.FundAccount(keepers.BankKeeper, ctx, userAddr, sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(1000))))
+
+	rRes, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: user, Name: "repository", Owner: user})
+	require.NoError(t, err)
+	iRes, err := srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: user, RepositoryId: rRes.RepositoryId, Title: "issue"})
+	require.NoError(t, err)
+
+	amount := sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(100)))
+	expiries := []time.Duration{time.Hour, 2 * time.Hour, time.Hour}
+	for _, expiry := range expiries {
+		_, err := srv.CreateBounty(ctx, &types.MsgCreateBounty{Creator: user, Amount: amount, Expiry: blockTime.Add(expiry).Unix(), RepositoryId: 0, ParentIid: iRes.Iid, Parent: types.BountyParentIssue})
+		require.NoError(t, err)
+	}
+	_, err = srv.CloseBounty(ctx, &types.MsgCloseBounty{Creator: user, Id: 2})
+	require.NoError(t, err)
+
+	k := keepers.GitopiaKeeper
+	balance := func() math.Int {
+		return keepers.BankKeeper.GetBalance(ctx, userAddr, params.BaseCoinUnit).Amount
+	}
+	require.Equal(t, math.NewInt(800), balance())
+
+	t.Run("Not Expired", func(t *testing.T) {
+		k.RefundExpiredBounties(ctx)
+		require.Empty(t, k.GetExpiredBountyIds(ctx, ctx.BlockTime().Unix(), types.MaxBountyRefundsPerBlock))
+		require.Equal(t, math.NewInt(800), balance())
+	})
+	t.Run("Update Expiry", func(t *testing.T) {
+		_, err := srv.UpdateBountyExpiry(ctx, &types.MsgUpdateBountyExpiry{Creator: user, Id: 1, Expiry: blockTime.Add(3 * time.Hour).Unix()})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{0}, k.GetExpiredBountyIds(ctx, blockTime.Add(2*time.Hour).Unix(), types.MaxBountyRefundsPerBlock))
+	})
+	t.Run("Expired", func(t *testing.T) {
+		ctx := ctx.WithBlockTime(blockTime.Add(2 * time.Hour)).WithEventManager(sdk.NewEventManager())
+		k.RefundExpiredBounties(ctx)
+		require.Equal(t, math.NewInt(900), balance())
+
+		bounty, found := k.GetBounty(ctx, 0)
+		require.True(t, found)
+		require.Equal(t, types.BountyStateREVERTEDBACK, bounty.State)
+		require.Equal(t, time.Time{}.Unix(), bounty.ExpireAt)
+		require.True(t, keepers.BankKeeper.GetAllBalances(ctx, keeper.GetBountyAddress(0)).IsZero())
+
+		bounty, found = k.GetBounty(ctx, 1)
+		require.True(t, found)
+		require.Equal(t, types.BountyStateSRCDEBITTED, bounty.State)
+
+		comments := k.GetAllIssueComment(ctx, 0, iRes.Iid)
+		require.Equal(t, types.CommentTypeClosedBounty, comments[len(comments)-1].CommentType)
+		require.True(t, comments[len(comments)-1].System)
+
+		var refunded bool
+		for _, event := range ctx.EventManager().Events() {
+			for _, attribute := range event.Attributes {
+				if string(attribute.Key) == sdk.AttributeKeyAction && string(attribute.Value) == types.RefundBountyEventKey {
+					refunded = true
+				}
+			}
+		}
+		require.True(t, refunded)
+		require.Empty(t, k.GetExpiredBountyIds(ctx, ctx.BlockTime().Unix(), types.MaxBountyRefundsPerBlock))
+	})
+}
+
+func TestRefundExpiredBountiesLinkedPullRequest(t *testing.T) {
+	srv, context, keepers := setupMsgServerWithKeepers(t)
+	blockTime := time.Unix(1000000, 0)
+	ctx := sdk.UnwrapSDKContext(context).WithBlockTime(blockTime)
+	user := sample.AccAddress()
+	userAddr, err := sdk.AccAddressFromBech32(user)
+	require.NoError(t, err)
+	_, err = srv.CreateUser(ctx, &types.MsgCreateUser{Creator: user, Username: user})
+	require.NoError(t, err)
+	testutil.FundAccount(keepers.BankKeeper, ctx, userAddr, sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(1000))))
+
+	repositoryId := types.RepositoryId{Id: user, Name: "repository"}
+	rRes, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: user, Name: repositoryId.Name, Owner: user})
+	require.NoError(t, err)
+	for _, branch := range []string{"head", "base"} {
+		_, err = srv.SetBranch(ctx, &types.MsgSetBranch{Creator: user, RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: branch, Sha: strings.Repeat("a", 40)}})
+		require.NoError(t, err)
+	}
+	iRes, err := srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: user, RepositoryId: rRes.RepositoryId, Title: "issue"})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: user, Title: "title", HeadRepositoryId: repositoryId, HeadBranch: "head", BaseRepositoryId: repositoryId, BaseBranch: "base", IssueIids: []uint64{iRes.Iid}})
+	require.NoError(t, err)
+
+	amount := sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(100)))
+	_, err = srv.CreateBounty(ctx, &types.MsgCreateBounty{Creator: user, Amount: amount, Expiry: blockTime.Add(time.Hour).Unix(), RepositoryId: 0, ParentIid: iRes.Iid, Parent: types.BountyParentIssue})
+	require.NoError(t, err)
+
+	k := keepers.GitopiaKeeper
+	expiredCtx := ctx.WithBlockTime(blockTime.Add(time.Hour))
+
+	t.Run("Skipped While Linked", func(t *testing.T) {
+		k.RefundExpiredBounties(expiredCtx)
+		require.Equal(t, math.NewInt(900), keepers.BankKeeper.GetBalance(ctx, userAddr, params.BaseCoinUnit).Amount)
+		require.Empty(t, k.GetExpiredBountyIds(expiredCtx, expiredCtx.BlockTime().Unix(), types.MaxBountyRefundsPerBlock))
+
+		bounty, found := k.GetBounty(ctx, 0)
+		require.True(t, found)
+		require.Equal(t, types.BountyStateSRCDEBITTED, bounty.State)
+	})
+	t.Run("Refunded After Unlink", func(t *testing.T) {
+		_, err := srv.UnlinkPullRequestIssueByIid(expiredCtx, &types.MsgUnlinkPullRequestIssueByIid{Creator: user, RepositoryId: 0, PullRequestIid: 1, IssueIid: iRes.Iid})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{0}, k.GetExpiredBountyIds(expiredCtx, expiredCtx.BlockTime().Unix(), types.MaxBountyRefundsPerBlock))
+
+		k.RefundExpiredBounties(expiredCtx)
+		require.Equal(t, math.NewInt(1000), keepers.BankKeeper.GetBalance(ctx, userAddr, params.BaseCoinUnit).Amount)
+
+		bounty, found := k.GetBounty(ctx, 0)
+		require.True(t, found)
+		require.Equal(t, types.BountyStateREVERTEDBACK, bounty.State)
+	})
+}
+
+func TestRefundExpiredBountiesLimit(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	k := keepers.GitopiaKeeper
+	for i := 0; i < types.MaxBountyRefundsPerBlock+5; i++ {
+		k.AppendBounty(ctx, types.Bounty{State: types.BountyStateSRCDEBITTED, ExpireAt: int64(i + 1)})
+	}
+	k.AppendBounty(ctx, types.Bounty{State: types.BountyStateREVERTEDBACK, ExpireAt: 1})
+
+	ids := k.GetExpiredBountyIds(ctx, int64(types.MaxBountyRefundsPerBlock+5), types.MaxBountyRefundsPerBlock)
+	require.Len(t, ids, types.MaxBountyRefundsPerBlock)
+	require.Equal(t, uint64(0), ids[0])
+
+	// bounties that can't be refunded leave the index and don't stall the following blocks
+	k.RefundExpiredBounties(ctx.WithBlockTime(time.Unix(int64(types.MaxBountyRefundsPerBlock+5), 0)))
+	require.Len(t, k.GetExpiredBountyIds(ctx, int64(types.MaxBountyRefundsPerBlock+5), types.MaxBountyRefundsPerBlock), 5)
+}
+
+func TestMigrate3to4BountyExpiry(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	k := keepers.GitopiaKeeper
+	k.AppendBounty(ctx, types.Bounty{State: types.BountyStateSRCDEBITTED, ExpireAt: 10})
+	k.AppendBounty(ctx, types.Bounty{State: types.BountyStateSRCDEBITTED, ExpireAt: 20})
+	k.AppendBounty(ctx, types.Bounty{State: types.BountyStateDESTCREDITED, ExpireAt: 10})
+
+	// bounties created before the expiry index existed
+	store := ctx.KVStore(keepers.GetKey(types.StoreKey))
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefix(types.BountyExpiryKey))
+	var indexKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		indexKeys = append(indexKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range indexKeys {
+		store.Delete(key)
+	}
+	require.Empty(t, k.GetExpiredBountyIds(ctx, 20, types.MaxBountyRefundsPerBlock))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(ctx))
+	require.Equal(t, []uint64{0}, k.GetExpiredBountyIds(ctx, 10, types.MaxBountyRefundsPerBlock))
+	require.Equal(t, []uint64{0, 1}, k.GetExpiredBountyIds(ctx, 20, types.MaxBountyRefundsPerBlock))
+}
+
+func TestMigrate3to4Params(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	k := keepers.GitopiaKeeper
+
+	// params stored before the bounty dispute window existed
+	params := types.DefaultParams()
+	params.BountyDisputeWindow = 0
+	k.SetParams(ctx, params)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(ctx))
+	require.Equal(t, types.DefaultParams().BountyDisputeWindow, k.GetParams(ctx).BountyDisputeWindow)
+	require.Equal(t, params.GitServer, k.GetParams(ctx).GitServer)
+}
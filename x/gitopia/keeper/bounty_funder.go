@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// SetBountyFunder set a specific bountyFunder in the store
+func (k Keeper) SetBountyFunder(ctx sdk.Context, funder types.BountyFunder) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetBountyFunderKeyForBountyId(funder.BountyId)),
+	)
+	b := k.cdc.MustMarshal(&funder)
+	store.Set([]byte(funder.Address), b)
+}
+
+// GetBountyFunder returns the contribution of an address to a bounty
+func (k Keeper) GetBountyFunder(ctx sdk.Context, bountyId uint64, address string) (val types.BountyFunder, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetBountyFunderKeyForBountyId(bountyId)),
+	)
+	b := store.Get([]byte(address))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllBountyFunderForBounty returns the funders of a bounty
+func (k Keeper) GetAllBountyFunderForBounty(ctx sdk.Context, bountyId uint64) (list []types.BountyFunder) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetBountyFunderKeyForBountyId(bountyId)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BountyFunder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllBountyFunder returns all bountyFunder
+func (k Keeper) GetAllBountyFunder(ctx sdk.Context) (list []types.BountyFunder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyFunderKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BountyFunder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveAllBountyFunderForBounty removes the funders of a bounty from the store
+func (k Keeper) RemoveAllBountyFunderForBounty(ctx sdk.Context, bountyId uint64) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetBountyFunderKeyForBountyId(bountyId)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// AddBountyFunder adds amount to the contribution of an address to a bounty
+func (k Keeper) AddBountyFunder(ctx sdk.Context, bountyId uint64, address string, amount sdk.Coins) {
+	blockTime := ctx.BlockTime().Unix()
+
+	funder, found := k.GetBountyFunder(ctx, bountyId, address)
+	if !found {
+		funder = types.BountyFunder{
+			BountyId:  bountyId,
+			Address:   address,
+			CreatedAt: blockTime,
+		}
+	}
+	funder.Amount = funder.Amount.Add(amount...)
+	funder.UpdatedAt = blockTime
+
+	k.SetBountyFunder(ctx, funder)
+}
+
+// RefundBounty refunds an open or disputed bounty to its funders, pro rata to their contributions. The
+// part of the bounty without a funder record is the creator's contribution. What is left after rounding
+// goes to the bounty creator. The refund is atomic: either every funder is refunded or nobody is.
+func (k Keeper) RefundBounty(ctx sdk.Context, bounty types.Bounty) error {
+	if bounty.State != types.BountyStateSRCDEBITTED && bounty.State != types.BountyStateDISPUTED {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty already closed")
+	}
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, bounty.Amount...); err != nil {
+		return err
+	}
+
+	funders := k.GetAllBountyFunderForBounty(ctx, bounty.Id)
+	contributed := sdk.NewCoins()
+	for _, funder := range funders {
+		contributed = contributed.Add(funder.Amount...)
+	}
+
+	// bounties created before funders were recorded have no record of the creator's
+	// contribution, the part of the bounty not covered by the funders belongs to the creator
+	unrecorded := sdk.NewCoins()
+	for _, coin := range bounty.Amount {
+		if diff := coin.Amount.Sub(contributed.AmountOf(coin.Denom)); diff.IsPositive() {
+			unrecorded = unrecorded.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	if !unrecorded.IsZero() {
+		funders = append(funders, types.BountyFunder{
+			BountyId: bounty.Id,
+			Address:  bounty.Creator,
+			Amount:   unrecorded,
+		})
+		contributed = contributed.Add(unrecorded...)
+	}
+
+	refunds := make([]sdk.Coins, len(funders))
+	refunded := sdk.NewCoins()
+	for i, funder := range funders {
+		refunds[i] = sdk.NewCoins()
+		for _, coin := range bounty.Amount {
+			total := contributed.AmountOf(coin.Denom)
+			if total.IsZero() {
+				continue
+			}
+			share := coin.Amount.Mul(funder.Amount.AmountOf(coin.Denom)).Quo(total)
+			refunds[i] = refunds[i].Add(sdk.NewCoin(coin.Denom, share))
+		}
+		refunded = refunded.Add(refunds[i]...)
+	}
+	remainder, isNegative := bounty.Amount.SafeSub(refunded...)
+	if isNegative {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "refunds exceed bounty amount")
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	bountyAddress := GetBountyAddress(bounty.Id)
+	send := func(address string, amount sdk.Coins) error {
+		if amount.IsZero() {
+			return nil
+		}
+		accAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+		if k.bankKeeper.BlockedAddr(accAddress) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", address)
+		}
+		return k.bankKeeper.SendCoins(cacheCtx, bountyAddress, accAddress, amount)
+	}
+
+	for i, funder := range funders {
+		if err := send(funder.Address, refunds[i]); err != nil {
+			return err
+		}
+	}
+	if err := send(bounty.Creator, remainder); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// RewardBounty awards an open bounty to recipient. When bounty disputes are enabled the bounty
+// stays locked at the bounty address until the dispute window ends, and is then paid out by
+// ReleaseAwardedBounties. Otherwise it is paid right away.
+func (k Keeper) RewardBounty(ctx sdk.Context, bounty types.Bounty, recipient string) error {
+	if bounty.State != types.BountyStateSRCDEBITTED {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty already closed")
+	}
+
+	params := k.GetParams(ctx)
+	if params.BountyArbiter == "" || params.BountyDisputeWindow <= 0 {
+		return k.PayBounty(ctx, bounty, recipient)
+	}
+
+	if _, _, err := types.ComputeBountyPayouts(bounty.Amount, bounty.Splits); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	bounty.State = types.BountyStateAWARDED
+	bounty.RewardedTo = recipient
+	bounty.ExpireAt = time.Time{}.Unix()
+	bounty.ReleaseAt = blockTime + params.BountyDisputeWindow
+	bounty.UpdatedAt = blockTime
+
+	k.SetBounty(ctx, bounty)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.DeferBountyPayoutEventKey),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
+			sdk.NewAttribute(types.EventAttributeBountyRewardedToKey, bounty.RewardedTo),
+			sdk.NewAttribute(types.EventAttributeBountyReleaseAtKey, strconv.FormatInt(bounty.ReleaseAt, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	return nil
+}
+
+// ReleaseAwardedBounties pays out the awarded bounties whose dispute window has ended.
+// At most MaxBountyReleasesPerBlock bounties are paid in a block; the rest are paid in
+// the following blocks. A bounty which can't be paid is marked disputed, so that the
+// bounty arbiter decides where its funds go.
+func (k Keeper) ReleaseAwardedBounties(ctx sdk.Context) {
+	blockTime := ctx.BlockTime().Unix()
+
+	for _, id := range k.GetReleasableBountyIds(ctx, blockTime, types.MaxBountyReleasesPerBlock) {
+		bounty, found := k.GetBounty(ctx, id)
+		if !found {
+			continue
+		}
+
+		// pay in a cached context so that a failed payout doesn't leave partial writes
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.releaseAwardedBounty(cacheCtx, bounty); err != nil {
+			k.Logger(ctx).Error("failed to release awarded bounty", "id", bounty.Id, "err", err)
+
+			bounty.State = types.BountyStateDISPUTED
+			bounty.Dispute = &types.BountyDispute{
+				Creator:   "GITOPIA",
+				Reason:    err.Error(),
+				CreatedAt: blockTime,
+			}
+			bounty.UpdatedAt = blockTime
+			k.SetBounty(ctx, bounty)
+			continue
+		}
+		write()
+	}
+}
+
+func (k Keeper) releaseAwardedBounty(ctx sdk.Context, bounty types.Bounty) error {
+	if err := k.PayBounty(ctx, bounty, bounty.RewardedTo); err != nil {
+		return err
+	}
+
+	bountyAmountJson, _ := json.Marshal(bounty.Amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ReleaseBountyEventKey),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyAmountKey, string(bountyAmountJson)),
+			sdk.NewAttribute(types.EventAttributeBountyStateKey, types.BountyStateDESTCREDITED.String()),
+			sdk.NewAttribute(types.EventAttributeBountyRewardedToKey, bounty.RewardedTo),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(ctx.BlockTime().Unix(), 10)),
+		),
+	)
+
+	return nil
+}
+
+// AssigneeBountySplits splits a bounty equally between recipient and the other assignees of
+// an issue, of which only the first MaxBountySplits are paid. The share of recipient, along
+// with what is left over from rounding, is paid to recipient.
+func AssigneeBountySplits(assignees []string, recipient string) (splits []*types.BountySplit) {
+	var others []string
+	for _, assignee := range assignees {
+		if assignee == recipient {
+			continue
+		}
+		if len(others) == types.MaxBountySplits {
+			break
+		}
+		others = append(others, assignee)
+	}
+
+	percentage := uint64(100 / (len(others) + 1))
+	for _, assignee := range others {
+		splits = append(splits, &types.BountySplit{
+			Address:    assignee,
+			Percentage: percentage,
+		})
+	}
+	return splits
+}
+
+// rewardMergedBounty rewards a bounty of a merged pull request to recipient. A bounty which
+// can't be rewarded is left open, and the failure is logged and emitted as an event.
+func (k Keeper) rewardMergedBounty(ctx sdk.Context, bounty types.Bounty, recipient string) {
+	if err := k.RewardBounty(ctx, bounty, recipient); err != nil {
+		k.Logger(ctx).Error("failed to reward bounty", "id", bounty.Id, "err", err)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(sdk.AttributeKeyAction, types.RewardBountyFailedEventKey),
+				sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+				sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+				sdk.NewAttribute(types.EventAttributeBountyRewardedToKey, recipient),
+				sdk.NewAttribute(types.EventAttributeBountyErrorKey, err.Error()),
+			),
+		)
+	}
+}
+
+// PayBounty pays a bounty to its splits and the rest of it to recipient. The payout is
+// atomic: either every split and the recipient are paid or nothing is.
+func (k Keeper) PayBounty(ctx sdk.Context, bounty types.Bounty, recipient string) error {
+	if bounty.State == types.BountyStateDESTCREDITED || bounty.State == types.BountyStateREVERTEDBACK {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty already closed")
+	}
+
+	payouts, remainder, err := types.ComputeBountyPayouts(bounty.Amount, bounty.Splits)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, bounty.Amount...); err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	bountyAddress := GetBountyAddress(bounty.Id)
+	send := func(address string, amount sdk.Coins) error {
+		if amount.IsZero() {
+			return nil
+		}
+		accAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+		if k.bankKeeper.BlockedAddr(accAddress) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", address)
+		}
+		return k.bankKeeper.SendCoins(cacheCtx, bountyAddress, accAddress, amount)
+	}
+
+	for i, split := range bounty.Splits {
+		if err := send(split.Address, payouts[i]); err != nil {
+			return err
+		}
+	}
+	if err := send(recipient, remainder); err != nil {
+		return err
+	}
+	write()
+
+	for i, split := range bounty.Splits {
+		split.Rewarded = payouts[i]
+	}
+
+	bounty.State = types.BountyStateDESTCREDITED
+	bounty.RewardedTo = recipient
+	bounty.ExpireAt = time.Time{}.Unix()
+	bounty.ReleaseAt = time.Time{}.Unix()
+	bounty.UpdatedAt = ctx.BlockTime().Unix()
+
+	k.SetBounty(ctx, bounty)
+
+	return nil
+}
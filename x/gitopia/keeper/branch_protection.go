@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"fmt"
+	"path"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+// GetMatchingBranchProtectionRules returns all the repository branch protection rules matching the branch name
+func GetMatchingBranchProtectionRules(repository types.Repository, branchName string) []*types.BranchProtectionRule {
+	var rules []*types.BranchProtectionRule
+	for _, rule := range repository.BranchProtectionRules {
+		if matched, _ := path.Match(rule.Pattern, branchName); matched {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// canPushProtectedBranch checks if the user is allowed to update a branch guarded by the rule.
+// When the rule doesn't restrict pushers, only users with PushProtectedBranchPermission are allowed.
+func (k Keeper) canPushProtectedBranch(ctx sdk.Context, creator string, repository types.Repository, rule *types.BranchProtectionRule) bool {
+	if len(rule.AllowedPushers) > 0 {
+		_, exists := utils.AllowedPusherExists(rule.AllowedPushers, creator)
+		return exists
+	}
+
+	return k.HavePermission(ctx, creator, repository, types.PushProtectedBranchPermission)
+}
+
+// CheckBranchPushAllowed checks the branch protection rules before a branch ref is updated
+func (k Keeper) CheckBranchPushAllowed(ctx sdk.Context, creator string, repository types.Repository, branchName string) error {
+	for _, rule := range GetMatchingBranchProtectionRules(repository, branchName) {
+		if !k.canPushProtectedBranch(ctx, creator, repository, rule) {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) is not allowed to push to protected branch (%v)", creator, branchName))
+		}
+	}
+
+	return nil
+}
+
+// CheckBranchDeleteAllowed checks the branch protection rules before a branch is deleted
+func (k Keeper) CheckBranchDeleteAllowed(ctx sdk.Context, creator string, repository types.Repository, branchName string) error {
+	for _, rule := range GetMatchingBranchProtectionRules(repository, branchName) {
+		if rule.PreventDeletion {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("protected branch (%v) can't be deleted", branchName))
+		}
+		if !k.canPushProtectedBranch(ctx, creator, repository, rule) {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) is not allowed to delete protected branch (%v)", creator, branchName))
+		}
+	}
+
+	return nil
+}
+
+// checkPullRequestApprovals checks that the pull request has enough approvals on its current head
+// and that no reviewer has requested changes. Approvals given on an older head commit are stale and
+// approvals from users who lost review permission are ignored.
+func (k Keeper) checkPullRequestApprovals(ctx sdk.Context, baseRepository types.Repository, pullRequest types.PullRequest, requiredApprovals uint64) error {
+	headSha := k.GetPullRequestHeadSha(ctx, pullRequest)
+
+	var approvals uint64
+	for _, review := range k.GetLatestPullRequestReviews(ctx, baseRepository.Id, pullRequest.Iid) {
+		if !k.HavePermission(ctx, review.Creator, baseRepository, types.PullRequestReviewPermission) {
+			continue
+		}
+		if review.State == types.PullRequestReview_REQUEST_CHANGES {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("reviewer (%v) requested changes", review.Creator))
+		}
+		if review.CommitSha == headSha {
+			approvals += 1
+		}
+	}
+
+	if approvals < requiredApprovals {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest requires (%d) approvals, has (%d)", requiredApprovals, approvals))
+	}
+
+	return nil
+}
+
+// checkPullRequestStatusChecks checks that every required status check context reports SUCCESS
+// on the current head commit. Only statuses reported on the base repository are accepted.
+func (k Keeper) checkPullRequestStatusChecks(ctx sdk.Context, pullRequest types.PullRequest, requiredStatusChecks []string) error {
+	headSha := k.GetPullRequestHeadSha(ctx, pullRequest)
+
+	for _, context := range requiredStatusChecks {
+		// a fork owner can report any status on their fork
+		commitStatus, found := k.GetRepositoryCommitStatus(ctx, pullRequest.Base.RepositoryId, headSha, context)
+		if !found {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("required status check (%v) is expected", context))
+		}
+		if commitStatus.State != types.CommitStatus_SUCCESS {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("required status check (%v) is %v", context, commitStatus.State.String()))
+		}
+	}
+
+	return nil
+}
+
+// IsMergeMethodAllowed checks if the repository allows the merge method.
+// Repositories that don't restrict merge methods allow all of them.
+func IsMergeMethodAllowed(repository types.Repository, mergeMethod types.MergeMethod) bool {
+	if len(repository.AllowedMergeMethods) == 0 {
+		return true
+	}
+	for _, allowedMergeMethod := range repository.AllowedMergeMethods {
+		if allowedMergeMethod == mergeMethod {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckPullRequestMergeMethodAllowed checks the merge method against the repository settings and the
+// linear history requirement of the base branch protection rules
+func CheckPullRequestMergeMethodAllowed(baseRepository types.Repository, pullRequest types.PullRequest, mergeMethod types.MergeMethod) error {
+	if !IsMergeMethodAllowed(baseRepository, mergeMethod) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("merge method (%v) is not allowed in repository", mergeMethod.String()))
+	}
+
+	if mergeMethod == types.MergeMethod_MERGE {
+		for _, rule := range GetMatchingBranchProtectionRules(baseRepository, pullRequest.Base.Branch) {
+			if rule.RequireLinearHistory {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("protected branch (%v) requires linear history", pullRequest.Base.Branch))
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckPullRequestMergeAllowed checks the branch protection rules of the base branch before a pull request is merged
+func (k Keeper) CheckPullRequestMergeAllowed(ctx sdk.Context, creator string, baseRepository types.Repository, pullRequest types.PullRequest) error {
+	var requiredApprovals uint64
+	var requiredStatusChecks []string
+	var requireCodeOwnerReview bool
+	var requireConversationResolution bool
+	for _, rule := range GetMatchingBranchProtectionRules(baseRepository, pullRequest.Base.Branch) {
+		if len(rule.AllowedPushers) > 0 {
+			if _, exists := utils.AllowedPusherExists(rule.AllowedPushers, creator); !exists {
+				return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) is not allowed to merge into protected branch (%v)", creator, pullRequest.Base.Branch))
+			}
+		}
+		if rule.RequiredApprovals > requiredApprovals {
+			requiredApprovals = rule.RequiredApprovals
+		}
+		requiredStatusChecks = append(requiredStatusChecks, rule.RequiredStatusChecks...)
+		requireCodeOwnerReview = requireCodeOwnerReview || rule.RequireCodeOwnerReview
+		requireConversationResolution = requireConversationResolution || rule.RequireConversationResolution
+	}
+
+	if requiredApprovals > 0 {
+		if err := k.checkPullRequestApprovals(ctx, baseRepository, pullRequest, requiredApprovals); err != nil {
+			return err
+		}
+	}
+
+	if requireCodeOwnerReview {
+		if err := k.checkPullRequestCodeOwnerApprovals(ctx, baseRepository, pullRequest); err != nil {
+			return err
+		}
+	}
+
+	if len(requiredStatusChecks) > 0 {
+		if err := k.checkPullRequestStatusChecks(ctx, pullRequest, requiredStatusChecks); err != nil {
+			return err
+		}
+	}
+
+	if requireConversationResolution {
+		if unresolved := k.GetAllPullRequestUnresolvedCommentThread(ctx, baseRepository.Id, pullRequest.Iid); len(unresolved) > 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest has (%d) unresolved conversations", len(unresolved)))
+		}
+	}
+
+	return nil
+}
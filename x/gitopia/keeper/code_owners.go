@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+// MatchCodeOwnerPattern reports whether the file path matches a CODEOWNERS style pattern.
+// A pattern starting with or containing a "/" is relative to the repository root, otherwise it
+// matches at any depth. A pattern ending with "/" only matches the contents of a directory,
+// "**" matches any number of directories and the remaining segments follow path.Match.
+func MatchCodeOwnerPattern(pattern string, filePath string) bool {
+	directory := strings.HasSuffix(pattern, "/")
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+
+	pattern = strings.Trim(pattern, "/")
+	if pattern == "" {
+		return false
+	}
+
+	patternSegments := strings.Split(pattern, "/")
+	if !anchored {
+		patternSegments = append([]string{"**"}, patternSegments...)
+	}
+	if directory {
+		patternSegments = append(patternSegments, "*", "**")
+	} else {
+		patternSegments = append(patternSegments, "**")
+	}
+
+	return matchCodeOwnerSegments(patternSegments, strings.Split(strings.Trim(filePath, "/"), "/"))
+}
+
+func matchCodeOwnerSegments(pattern []string, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(name); i++ {
+			if matchCodeOwnerSegments(pattern[1:], name[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(name) == 0 {
+		return false
+	}
+	if matched, _ := path.Match(pattern[0], name[0]); !matched {
+		return false
+	}
+	return matchCodeOwnerSegments(pattern[1:], name[1:])
+}
+
+// GetCodeOwners returns the owners of a file path. As in a CODEOWNERS file, the last matching
+// rule takes precedence.
+func GetCodeOwners(repository types.Repository, filePath string) []string {
+	var owners []string
+	for _, rule := range repository.CodeOwners {
+		if MatchCodeOwnerPattern(rule.Pattern, filePath) {
+			owners = rule.Owners
+		}
+	}
+	return owners
+}
+
+// isCodeOwner checks if the user is one of the owners, either directly or as a member of an owner dao
+func (k Keeper) isCodeOwner(ctx sdk.Context, owners []string, address string) bool {
+	for _, owner := range owners {
+		if owner == address {
+			return true
+		}
+		if _, found := k.GetDaoMember(ctx, owner, address); found {
+			return true
+		}
+	}
+	return false
+}
+
+// AssignPullRequestCodeOwners requests the review of the owners of the changed paths of the pull
+// request. The pull request creator is never requested and reviewers are capped to 10.
+func (k Keeper) AssignPullRequestCodeOwners(ctx sdk.Context, repository types.Repository, pullRequest *types.PullRequest) (reviewers []string) {
+	for _, changedPath := range pullRequest.ChangedPaths {
+		for _, owner := range GetCodeOwners(repository, changedPath) {
+			if len(pullRequest.Reviewers) >= 10 {
+				return reviewers
+			}
+			if owner == pullRequest.Creator {
+				continue
+			}
+			if _, exists := utils.ReviewerExists(pullRequest.Reviewers, owner); exists {
+				continue
+			}
+			pullRequest.Reviewers = append(pullRequest.Reviewers, owner)
+			reviewers = append(reviewers, owner)
+		}
+	}
+	return reviewers
+}
+
+// checkPullRequestCodeOwnerApprovals checks that every changed path of the pull request which has
+// code owners is approved on the current head by one of its owners. The changed paths must
+// have been reported for the current head, otherwise the owners of the changes are unknown.
+func (k Keeper) checkPullRequestCodeOwnerApprovals(ctx sdk.Context, baseRepository types.Repository, pullRequest types.PullRequest) error {
+	headSha := k.GetPullRequestHeadSha(ctx, pullRequest)
+
+	if pullRequest.ChangedPathsSha != headSha {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("changed paths haven't been reported for head (%v)", headSha))
+	}
+
+	var approvers []string
+	for _, review := range k.GetLatestPullRequestReviews(ctx, baseRepository.Id, pullRequest.Iid) {
+		if review.State != types.PullRequestReview_APPROVE || review.CommitSha != headSha {
+			continue
+		}
+		if !k.HavePermission(ctx, review.Creator, baseRepository, types.PullRequestReviewPermission) {
+			continue
+		}
+		approvers = append(approvers, review.Creator)
+	}
+
+	for _, changedPath := range pullRequest.ChangedPaths {
+		owners := GetCodeOwners(baseRepository, changedPath)
+		if len(owners) == 0 {
+			continue
+		}
+
+		approved := false
+		for _, approver := range approvers {
+			if k.isCodeOwner(ctx, owners, approver) {
+				approved = true
+				break
+			}
+		}
+		if !approved {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("changed path (%v) requires approval from a code owner", changedPath))
+		}
+	}
+
+	return nil
+}
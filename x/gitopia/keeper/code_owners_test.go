@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchCodeOwnerPattern(t *testing.T) {
+	for _, tc := range []struct {
+		pattern  string
+		filePath string
+		match    bool
+	}{
+		{pattern: "*", filePath: "main.go", match: true},
+		{pattern: "*", filePath: "x/gitopia/keeper/keeper.go", match: true},
+		{pattern: "*.go", filePath: "x/gitopia/keeper/keeper.go", match: true},
+		{pattern: "*.go", filePath: "README.md", match: false},
+		{pattern: "docs", filePath: "docs/README.md", match: true},
+		{pattern: "docs", filePath: "x/docs/README.md", match: true},
+		{pattern: "/docs", filePath: "x/docs/README.md", match: false},
+		{pattern: "docs/", filePath: "docs", match: false},
+		{pattern: "docs/", filePath: "docs/api/README.md", match: true},
+		{pattern: "x/gitopia/", filePath: "x/gitopia/keeper/keeper.go", match: true},
+		{pattern: "x/gitopia/", filePath: "app/x/gitopia/keeper.go", match: false},
+		{pattern: "x/*/keeper", filePath: "x/gitopia/keeper/keeper.go", match: true},
+		{pattern: "x/**/keeper.go", filePath: "x/gitopia/keeper/keeper.go", match: true},
+		{pattern: "**/keeper.go", filePath: "keeper.go", match: true},
+		{pattern: "/", filePath: "main.go", match: false},
+	} {
+		t.Run(tc.pattern+" "+tc.filePath, func(t *testing.T) {
+			require.Equal(t, tc.match, keeper.MatchCodeOwnerPattern(tc.pattern, tc.filePath))
+		})
+	}
+}
+
+func TestGetCodeOwners(t *testing.T) {
+	repository := types.Repository{
+		CodeOwners: []*types.CodeOwnerRule{
+			{Pattern: "*", Owners: []string{"A"}},
+			{Pattern: "docs/", Owners: []string{"B", "C"}},
+			{Pattern: "*.go", Owners: []string{"C"}},
+		},
+	}
+
+	require.Equal(t, []string{"A"}, keeper.GetCodeOwners(repository, "README.md"))
+	require.Equal(t, []string{"B", "C"}, keeper.GetCodeOwners(repository, "docs/README.md"))
+	require.Equal(t, []string{"C"}, keeper.GetCodeOwners(repository, "docs/main.go"))
+	require.Empty(t, keeper.GetCodeOwners(types.Repository{}, "README.md"))
+}
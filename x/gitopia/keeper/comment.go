@@ -183,6 +183,22 @@ func (k Keeper) GetAllPullRequestComment(ctx sdk.Context, repositoryId uint64, p
 	return
 }
 
+// GetAllPullRequestUnresolvedCommentThread returns all unresolved review comment threads of a pullRequest
+func (k Keeper) GetAllPullRequestUnresolvedCommentThread(ctx sdk.Context, repositoryId uint64, pullRequestIid uint64) (list []types.Comment) {
+	for _, comment := range k.GetAllPullRequestComment(ctx, repositoryId, pullRequestIid) {
+		if IsCommentThreadRoot(comment) && !comment.Resolved {
+			list = append(list, comment)
+		}
+	}
+
+	return
+}
+
+// IsCommentThreadRoot checks if the comment starts a review comment thread
+func IsCommentThreadRoot(comment types.Comment) bool {
+	return comment.Parent == types.CommentParentPullRequest && comment.CommentType == types.CommentTypeReview && comment.InReplyTo == 0
+}
+
 // GetCommentIDBytes returns the byte representation of the ID
 func GetCommentIDBytes(id uint64) []byte {
 	bz := make([]byte, 8)
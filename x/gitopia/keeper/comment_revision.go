@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// SetCommentRevision set a specific commentRevision in the store
+func (k Keeper) SetCommentRevision(ctx sdk.Context, commentRevision types.CommentRevision) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetCommentRevisionKeyForComment(commentRevision.RepositoryId, commentRevision.Parent, commentRevision.ParentIid, commentRevision.CommentIid)),
+	)
+	b := k.cdc.MustMarshal(&commentRevision)
+	store.Set(GetCommentIDBytes(commentRevision.Revision), b)
+}
+
+// AppendCommentRevision records the previous body of a comment, or of the description when commentIid is 0.
+// Only the latest MaxCommentRevisions revisions are kept.
+func (k Keeper) AppendCommentRevision(ctx sdk.Context, repositoryId uint64, parent types.CommentParent, parentIid uint64, commentIid uint64, editor string, body string) types.CommentRevision {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetCommentRevisionKeyForComment(repositoryId, parent, parentIid, commentIid)),
+	)
+
+	var revision uint64 = 1
+	lastIterator := sdk.KVStoreReversePrefixIterator(store, []byte{})
+	if lastIterator.Valid() {
+		revision = GetCommentIDFromBytes(lastIterator.Key()) + 1
+	}
+	lastIterator.Close()
+
+	bodyHash := sha256.Sum256([]byte(body))
+	commentRevision := types.CommentRevision{
+		RepositoryId: repositoryId,
+		Parent:       parent,
+		ParentIid:    parentIid,
+		CommentIid:   commentIid,
+		Revision:     revision,
+		Editor:       editor,
+		Body:         body,
+		BodyHash:     hex.EncodeToString(bodyHash[:]),
+		EditedAt:     ctx.BlockTime().Unix(),
+	}
+	k.SetCommentRevision(ctx, commentRevision)
+
+	// Prune the oldest revisions
+	if revision > types.MaxCommentRevisions {
+		iterator := sdk.KVStorePrefixIterator(store, []byte{})
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			if GetCommentIDFromBytes(iterator.Key()) > revision-types.MaxCommentRevisions {
+				break
+			}
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	return commentRevision
+}
+
+// GetAllCommentRevisionForComment returns the edit history of a comment, or of the description when commentIid is 0
+func (k Keeper) GetAllCommentRevisionForComment(ctx sdk.Context, repositoryId uint64, parent types.CommentParent, parentIid uint64, commentIid uint64) (list []types.CommentRevision) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetCommentRevisionKeyForComment(repositoryId, parent, parentIid, commentIid)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CommentRevision
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllCommentRevisionForParent returns the edit history of all comments and the description of an issue or pull request
+func (k Keeper) GetAllCommentRevisionForParent(ctx sdk.Context, repositoryId uint64, parent types.CommentParent, parentIid uint64) (list []types.CommentRevision) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetCommentRevisionKeyForParent(repositoryId, parent, parentIid)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CommentRevision
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllCommentRevision returns all commentRevision
+func (k Keeper) GetAllCommentRevision(ctx sdk.Context) (list []types.CommentRevision) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommentRevisionKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CommentRevision
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveAllCommentRevisionForComment removes the edit history of a comment
+func (k Keeper) RemoveAllCommentRevisionForComment(ctx sdk.Context, repositoryId uint64, parent types.CommentParent, parentIid uint64, commentIid uint64) {
+	k.removeAllCommentRevision(ctx, types.GetCommentRevisionKeyForComment(repositoryId, parent, parentIid, commentIid))
+}
+
+// RemoveAllCommentRevisionForParent removes the edit history of all comments and the description of an issue or pull request
+func (k Keeper) RemoveAllCommentRevisionForParent(ctx sdk.Context, repositoryId uint64, parent types.CommentParent, parentIid uint64) {
+	k.removeAllCommentRevision(ctx, types.GetCommentRevisionKeyForParent(repositoryId, parent, parentIid))
+}
+
+func (k Keeper) removeAllCommentRevision(ctx sdk.Context, key string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(key))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
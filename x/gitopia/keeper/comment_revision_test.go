@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/gitopia/gitopia/testutil/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentRevisionAppend(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	keeper := &keepers.GitopiaKeeper
+
+	for i := 0; i < types.MaxCommentRevisions+5; i++ {
+		keeper.AppendCommentRevision(ctx, 0, types.CommentParentIssue, 1, 1, "A", fmt.Sprintf("body-%d", i))
+	}
+	keeper.AppendCommentRevision(ctx, 0, types.CommentParentIssue, 1, 0, "A", "description")
+
+	revisions := keeper.GetAllCommentRevisionForComment(ctx, 0, types.CommentParentIssue, 1, 1)
+	require.Len(t, revisions, types.MaxCommentRevisions)
+	require.Equal(t, uint64(6), revisions[0].Revision)
+	require.Equal(t, "body-5", revisions[0].Body)
+	require.Equal(t, uint64(types.MaxCommentRevisions+5), revisions[len(revisions)-1].Revision)
+	require.Len(t, revisions[0].BodyHash, 64)
+
+	require.Len(t, keeper.GetAllCommentRevisionForComment(ctx, 0, types.CommentParentIssue, 1, 0), 1)
+	require.Empty(t, keeper.GetAllCommentRevisionForComment(ctx, 0, types.CommentParentPullRequest, 1, 1))
+}
+
+func TestCommentRevisionRemove(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	keeper := &keepers.GitopiaKeeper
+
+	for _, commentIid := range []uint64{0, 1, 2} {
+		keeper.AppendCommentRevision(ctx, 0, types.CommentParentPullRequest, 1, commentIid, "A", "body")
+	}
+	keeper.AppendCommentRevision(ctx, 0, types.CommentParentPullRequest, 10, 1, "A", "body")
+
+	keeper.RemoveAllCommentRevisionForComment(ctx, 0, types.CommentParentPullRequest, 1, 1)
+	require.Empty(t, keeper.GetAllCommentRevisionForComment(ctx, 0, types.CommentParentPullRequest, 1, 1))
+	require.Len(t, keeper.GetAllCommentRevision(ctx), 3)
+
+	keeper.RemoveAllCommentRevisionForParent(ctx, 0, types.CommentParentPullRequest, 1)
+	require.Len(t, keeper.GetAllCommentRevision(ctx), 1)
+}
+
+func TestDescriptionRevisionUnchanged(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	users, _, _, _ := setupPreComment(ctx, t, srv)
+	issueIid, pullRequestIid := uint64(1), uint64(1)
+
+	for _, description := range []string{"description", "description", "edited"} {
+		_, err := srv.UpdateIssueDescription(ctx, &types.MsgUpdateIssueDescription{Creator: users[0], RepositoryId: 0, Iid: issueIid, Description: description})
+		require.NoError(t, err)
+		_, err = srv.UpdatePullRequestDescription(ctx, &types.MsgUpdatePullRequestDescription{Creator: users[0], RepositoryId: 0, Iid: pullRequestIid, Description: description})
+		require.NoError(t, err)
+	}
+
+	require.Len(t, keepers.GitopiaKeeper.GetAllCommentRevisionForComment(sdkCtx, 0, types.CommentParentIssue, issueIid, 0), 2)
+	require.Len(t, keepers.GitopiaKeeper.GetAllCommentRevisionForComment(sdkCtx, 0, types.CommentParentPullRequest, pullRequestIid, 0), 2)
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// GetCommitStatusCount get the total number of commitStatus
+func (k Keeper) GetCommitStatusCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.CommitStatusCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetCommitStatusCount set the total number of commitStatus
+func (k Keeper) SetCommitStatusCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.CommitStatusCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendCommitStatus appends a commitStatus in the store with a new id and update the count
+func (k Keeper) AppendCommitStatus(
+	ctx sdk.Context,
+	commitStatus types.CommitStatus,
+) uint64 {
+	// Create the commitStatus
+	count := k.GetCommitStatusCount(ctx)
+
+	// Set the ID of the appended value
+	commitStatus.Id = count
+
+	k.SetRepositoryCommitStatus(ctx, commitStatus)
+
+	// Update commitStatus count
+	k.SetCommitStatusCount(ctx, count+1)
+
+	return count
+}
+
+// SetRepositoryCommitStatus set a specific commitStatus in the store for repository-id, sha and context
+func (k Keeper) SetRepositoryCommitStatus(ctx sdk.Context, commitStatus types.CommitStatus) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetCommitStatusKeyForCommit(commitStatus.RepositoryId, commitStatus.Sha)),
+	)
+	b := k.cdc.MustMarshal(&commitStatus)
+	store.Set([]byte(commitStatus.Context), b)
+}
+
+// GetRepositoryCommitStatus returns a commitStatus from its context
+func (k Keeper) GetRepositoryCommitStatus(ctx sdk.Context, repositoryId uint64, sha string, context string) (val types.CommitStatus, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetCommitStatusKeyForCommit(repositoryId, sha)),
+	)
+	b := store.Get([]byte(context))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRepositoryCommitStatus removes a commitStatus from the store
+func (k Keeper) RemoveRepositoryCommitStatus(ctx sdk.Context, repositoryId uint64, sha string, context string) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetCommitStatusKeyForCommit(repositoryId, sha)),
+	)
+	store.Delete([]byte(context))
+}
+
+// GetAllCommitStatus returns all commitStatus
+func (k Keeper) GetAllCommitStatus(ctx sdk.Context) (list []types.CommitStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommitStatusKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CommitStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllRepositoryCommitStatus returns all commitStatus for repository-id
+func (k Keeper) GetAllRepositoryCommitStatus(ctx sdk.Context, repositoryId uint64) (list []types.CommitStatus) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetCommitStatusKeyForRepositoryId(repositoryId)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CommitStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllCommitStatusForCommit returns all commitStatus of a commit ordered by context
+func (k Keeper) GetAllCommitStatusForCommit(ctx sdk.Context, repositoryId uint64, sha string) (list []types.CommitStatus) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetCommitStatusKeyForCommit(repositoryId, sha)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CommitStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetCombinedCommitStatus combines the statuses of a commit into a single state.
+// The combined state is FAILURE if any context reports FAILURE or ERROR, PENDING if
+// there are no statuses or any context is PENDING, and SUCCESS otherwise.
+func GetCombinedCommitStatus(statuses []types.CommitStatus) types.CommitStatus_State {
+	if len(statuses) == 0 {
+		return types.CommitStatus_PENDING
+	}
+
+	state := types.CommitStatus_SUCCESS
+	for _, status := range statuses {
+		switch status.State {
+		case types.CommitStatus_FAILURE, types.CommitStatus_ERROR:
+			return types.CommitStatus_FAILURE
+		case types.CommitStatus_PENDING:
+			state = types.CommitStatus_PENDING
+		}
+	}
+	return state
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/gitopia/gitopia/testutil/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNCommitStatus(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.CommitStatus {
+	items := make([]types.CommitStatus, n)
+	for i := range items {
+		items[i].RepositoryId = 0
+		items[i].Sha = strings.Repeat("a", 40)
+		items[i].Context = fmt.Sprintf("ci/%d", i)
+		items[i].Id = keeper.AppendCommitStatus(ctx, items[i])
+	}
+	return items
+}
+
+func TestCommitStatusGet(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	keeper := &keepers.GitopiaKeeper
+	items := createNCommitStatus(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetRepositoryCommitStatus(ctx, item.RepositoryId, item.Sha, item.Context)
+		require.True(t, found)
+		require.Equal(t, item, got)
+	}
+}
+
+func TestCommitStatusRemove(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	keeper := &keepers.GitopiaKeeper
+	items := createNCommitStatus(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveRepositoryCommitStatus(ctx, item.RepositoryId, item.Sha, item.Context)
+		_, found := keeper.GetRepositoryCommitStatus(ctx, item.RepositoryId, item.Sha, item.Context)
+		require.False(t, found)
+	}
+}
+
+func TestCommitStatusGetAll(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	keeper := &keepers.GitopiaKeeper
+	items := createNCommitStatus(keeper, ctx, 10)
+	require.ElementsMatch(t, items, keeper.GetAllCommitStatusForCommit(ctx, 0, strings.Repeat("a", 40)))
+	require.ElementsMatch(t, items, keeper.GetAllRepositoryCommitStatus(ctx, 0))
+	require.ElementsMatch(t, items, keeper.GetAllCommitStatus(ctx))
+}
+
+func TestCommitStatusCount(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	keeper := &keepers.GitopiaKeeper
+	items := createNCommitStatus(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetCommitStatusCount(ctx))
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// SetFollow set a follow in the store along with its following index
+func (k Keeper) SetFollow(ctx sdk.Context, follow types.Follow) {
+	b := k.cdc.MustMarshal(&follow)
+
+	followerStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetFollowerKeyForAddress(follow.Following)),
+	)
+	followerStore.Set([]byte(follow.Follower), b)
+
+	followingStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetFollowingKeyForAddress(follow.Follower)),
+	)
+	followingStore.Set([]byte(follow.Following), b)
+}
+
+// GetFollow returns the follow of the following address by the follower address
+func (k Keeper) GetFollow(ctx sdk.Context, follower string, following string) (val types.Follow, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetFollowingKeyForAddress(follower)),
+	)
+	b := store.Get([]byte(following))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveFollow removes a follow and its following index from the store
+func (k Keeper) RemoveFollow(ctx sdk.Context, follower string, following string) {
+	followerStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetFollowerKeyForAddress(following)),
+	)
+	followerStore.Delete([]byte(follower))
+
+	followingStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetFollowingKeyForAddress(follower)),
+	)
+	followingStore.Delete([]byte(following))
+}
+
+// GetAllFollower returns all followers of an address
+func (k Keeper) GetAllFollower(ctx sdk.Context, address string) (list []types.Follow) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetFollowerKeyForAddress(address)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Follow
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllFollowing returns all the addresses followed by an address
+func (k Keeper) GetAllFollowing(ctx sdk.Context, address string) (list []types.Follow) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetFollowingKeyForAddress(address)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Follow
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllFollow returns all follows
+func (k Keeper) GetAllFollow(ctx sdk.Context) (list []types.Follow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FollowingKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Follow
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IncreaseFollowersCount increments the followers count of a user or dao
+// and returns the updated count
+func (k Keeper) IncreaseFollowersCount(ctx sdk.Context, address string) uint64 {
+	if user, found := k.GetUser(ctx, address); found {
+		user.FollowersCount++
+		k.SetUser(ctx, user)
+		return user.FollowersCount
+	}
+
+	if dao, found := k.GetDao(ctx, address); found {
+		dao.FollowersCount++
+		k.SetDao(ctx, dao)
+		return dao.FollowersCount
+	}
+
+	return 0
+}
+
+// DecreaseFollowersCount decrements the followers count of a user or dao
+// and returns the updated count
+func (k Keeper) DecreaseFollowersCount(ctx sdk.Context, address string) uint64 {
+	if user, found := k.GetUser(ctx, address); found {
+		if user.FollowersCount > 0 {
+			user.FollowersCount--
+			k.SetUser(ctx, user)
+		}
+		return user.FollowersCount
+	}
+
+	if dao, found := k.GetDao(ctx, address); found {
+		if dao.FollowersCount > 0 {
+			dao.FollowersCount--
+			k.SetDao(ctx, dao)
+		}
+		return dao.FollowersCount
+	}
+
+	return 0
+}
+
+// RemoveAllFollow removes every follow from and to an address and updates
+// the follow counts of the other ends
+func (k Keeper) RemoveAllFollow(ctx sdk.Context, address string) {
+	for _, follow := range k.GetAllFollowing(ctx, address) {
+		k.RemoveFollow(ctx, follow.Follower, follow.Following)
+		k.DecreaseFollowersCount(ctx, follow.Following)
+	}
+
+	for _, follow := range k.GetAllFollower(ctx, address) {
+		k.RemoveFollow(ctx, follow.Follower, follow.Following)
+
+		user, found := k.GetUser(ctx, follow.Follower)
+		if found && user.FollowingCount > 0 {
+			user.FollowingCount--
+			k.SetUser(ctx, user)
+		}
+	}
+}
@@ -31,6 +31,14 @@ func InitGenesis(ctx sdk.Context, k Keeper, genState types.GenesisState) {
 	// Set branch count
 	k.SetBranchCount(ctx, genState.BranchCount)
 
+	// Set all the commitStatus
+	for _, elem := range genState.CommitStatusList {
+		k.SetRepositoryCommitStatus(ctx, elem)
+	}
+
+	// Set commitStatus count
+	k.SetCommitStatusCount(ctx, genState.CommitStatusCount)
+
 	// Set all the tag
 	for _, elem := range genState.TagList {
 		k.SetRepositoryTag(ctx, elem)
@@ -65,12 +73,66 @@ func InitGenesis(ctx sdk.Context, k Keeper, genState types.GenesisState) {
 
 	// Set all the pullRequest
 	for _, elem := range genState.PullRequestList {
+		k.moveMilestoneMember(ctx, types.GetMilestonePullRequestKeyForMilestone, elem.Base.RepositoryId, 0, elem.Milestone, elem.Iid)
 		k.SetPullRequest(ctx, elem)
 	}
 
 	// Set pullRequest count
 	k.SetPullRequestCount(ctx, genState.PullRequestCount)
 
+	// Set all the pullRequestReview
+	for _, elem := range genState.PullRequestReviewList {
+		k.SetPullRequestReview(ctx, elem)
+	}
+
+	// Set pullRequestReview count
+	k.SetPullRequestReviewCount(ctx, genState.PullRequestReviewCount)
+
+	// Set all the pullRequestAutoMerge
+	for _, elem := range genState.PullRequestAutoMergeList {
+		k.SetPullRequestAutoMerge(ctx, elem)
+	}
+
+	// Set all the mergeQueue along with the tasks of the entries under test
+	for _, elem := range genState.MergeQueueList {
+		k.SetRepositoryMergeQueue(ctx, elem)
+		for _, entry := range elem.Entries {
+			if entry.Testing {
+				k.SetMergeQueueTask(ctx, entry.TaskId, elem.RepositoryId, elem.Branch)
+			}
+		}
+	}
+
+	// Set all the repositoryStar
+	for _, elem := range genState.RepositoryStarList {
+		k.SetRepositoryStar(ctx, elem)
+	}
+
+	// Set all the repositoryWatch
+	for _, elem := range genState.RepositoryWatchList {
+		k.SetRepositoryWatch(ctx, elem)
+	}
+
+	// Set all the follow
+	for _, elem := range genState.FollowList {
+		k.SetFollow(ctx, elem)
+	}
+
+	// Set all the commentRevision
+	for _, elem := range genState.CommentRevisionList {
+		k.SetCommentRevision(ctx, elem)
+	}
+
+	// Set all the bountyFunder
+	for _, elem := range genState.BountyFunderList {
+		k.SetBountyFunder(ctx, elem)
+	}
+
+	// Set all the notification
+	for _, elem := range genState.NotificationList {
+		k.SetNotification(ctx, elem)
+	}
+
 	// Set all the dao
 	for _, elem := range genState.DaoList {
 		k.SetDao(ctx, elem)
@@ -94,6 +156,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, genState types.GenesisState) {
 
 	// Set all the issue
 	for _, elem := range genState.IssueList {
+		k.moveMilestoneMember(ctx, types.GetMilestoneIssueKeyForMilestone, elem.RepositoryId, 0, elem.Milestone, elem.Iid)
 		k.SetIssue(ctx, elem)
 	}
 
@@ -177,6 +240,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 	genesis.BranchList = k.GetAllBranch(ctx)
 	genesis.BranchCount = k.GetBranchCount(ctx)
 
+	genesis.CommitStatusList = k.GetAllCommitStatus(ctx)
+	genesis.CommitStatusCount = k.GetCommitStatusCount(ctx)
+
 	genesis.TagList = k.GetAllTag(ctx)
 	genesis.TagCount = k.GetTagCount(ctx)
 
@@ -194,6 +260,25 @@ func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 	genesis.PullRequestList = k.GetAllPullRequest(ctx)
 	genesis.PullRequestCount = k.GetPullRequestCount(ctx)
 
+	// Get all pullRequestReview
+	genesis.PullRequestReviewList = k.GetAllPullRequestReview(ctx)
+	genesis.PullRequestReviewCount = k.GetPullRequestReviewCount(ctx)
+
+	genesis.PullRequestAutoMergeList = k.GetAllPullRequestAutoMerge(ctx)
+
+	genesis.MergeQueueList = k.GetAllMergeQueue(ctx)
+
+	genesis.RepositoryStarList = k.GetAllStar(ctx)
+	genesis.RepositoryWatchList = k.GetAllWatch(ctx)
+
+	genesis.FollowList = k.GetAllFollow(ctx)
+
+	genesis.CommentRevisionList = k.GetAllCommentRevision(ctx)
+
+	genesis.NotificationList = k.GetAllNotification(ctx)
+
+	genesis.BountyFunderList = k.GetAllBountyFunder(ctx)
+
 	// Get all dao
 	genesis.DaoList = k.GetAllDao(ctx)
 	genesis.DaoCount = k.GetDaoCount(ctx)
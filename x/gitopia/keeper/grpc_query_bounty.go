@@ -53,3 +53,35 @@ func (k Keeper) Bounty(c context.Context, req *types.QueryGetBountyRequest) (*ty
 
 	return &types.QueryGetBountyResponse{Bounty: bounty}, nil
 }
+
+func (k Keeper) BountyFunderAll(c context.Context, req *types.QueryAllBountyFunderRequest) (*types.QueryAllBountyFunderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var funders []types.BountyFunder
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetBounty(ctx, req.Id); !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	funderStore := prefix.NewStore(store, types.KeyPrefix(types.GetBountyFunderKeyForBountyId(req.Id)))
+
+	pageRes, err := query.Paginate(funderStore, req.Pagination, func(key []byte, value []byte) error {
+		var funder types.BountyFunder
+		if err := k.cdc.Unmarshal(value, &funder); err != nil {
+			return err
+		}
+
+		funders = append(funders, funder)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllBountyFunderResponse{BountyFunder: funders, Pagination: pageRes}, nil
+}
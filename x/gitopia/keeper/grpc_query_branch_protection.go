@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RepositoryBranchProtectionRuleAll(c context.Context, req *types.QueryAllRepositoryBranchProtectionRuleRequest) (*types.QueryAllRepositoryBranchProtectionRuleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, "repository not found")
+	}
+
+	return &types.QueryAllRepositoryBranchProtectionRuleResponse{BranchProtectionRule: repository.BranchProtectionRules}, nil
+}
+
+func (k Keeper) RepositoryBranchProtection(c context.Context, req *types.QueryGetRepositoryBranchProtectionRequest) (*types.QueryGetRepositoryBranchProtectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, "repository not found")
+	}
+
+	return &types.QueryGetRepositoryBranchProtectionResponse{
+		BranchProtectionRule: GetMatchingBranchProtectionRules(repository, req.BranchName),
+	}, nil
+}
@@ -96,6 +96,68 @@ func (k Keeper) PullRequestCommentAll(c context.Context, req *types.QueryAllPull
 	return &types.QueryAllPullRequestCommentResponse{Comment: comments, Pagination: pageRes}, nil
 }
 
+func (k Keeper) CommentHistory(c context.Context, req *types.QueryCommentHistoryRequest) (*types.QueryCommentHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var commentRevisions []types.CommentRevision
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	commentRevisionStore := prefix.NewStore(store, types.KeyPrefix(types.GetCommentRevisionKeyForComment(req.RepositoryId, req.Parent, req.ParentIid, req.CommentIid)))
+
+	pageRes, err := query.Paginate(commentRevisionStore, req.Pagination, func(key []byte, value []byte) error {
+		var commentRevision types.CommentRevision
+		if err := k.cdc.Unmarshal(value, &commentRevision); err != nil {
+			return err
+		}
+
+		commentRevisions = append(commentRevisions, commentRevision)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCommentHistoryResponse{CommentRevision: commentRevisions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PullRequestUnresolvedCommentThreadAll(c context.Context, req *types.QueryAllPullRequestUnresolvedCommentThreadRequest) (*types.QueryAllPullRequestUnresolvedCommentThreadResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var comments []*types.Comment
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	commentStore := prefix.NewStore(store, types.KeyPrefix(types.GetCommentKeyForPullRequest(req.RepositoryId, req.PullRequestIid)))
+
+	pageRes, err := query.FilteredPaginate(commentStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var comment types.Comment
+		if err := k.cdc.Unmarshal(value, &comment); err != nil {
+			return false, err
+		}
+
+		if !IsCommentThreadRoot(comment) || comment.Resolved {
+			return false, nil
+		}
+
+		if accumulate {
+			comments = append(comments, &comment)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPullRequestUnresolvedCommentThreadResponse{Comment: comments, Pagination: pageRes}, nil
+}
+
 func (k Keeper) IssueComment(c context.Context, req *types.QueryGetIssueCommentRequest) (*types.QueryGetIssueCommentResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RepositoryCommitStatusAll(c context.Context, req *types.QueryAllRepositoryCommitStatusRequest) (*types.QueryAllRepositoryCommitStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var commitStatuses []*types.CommitStatus
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, "repository not found")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	commitStatusStore := prefix.NewStore(store, types.KeyPrefix(types.GetCommitStatusKeyForCommit(repository.Id, req.Sha)))
+
+	pageRes, err := query.Paginate(commitStatusStore, req.Pagination, func(key []byte, value []byte) error {
+		var commitStatus types.CommitStatus
+		if err := k.cdc.Unmarshal(value, &commitStatus); err != nil {
+			return err
+		}
+
+		commitStatuses = append(commitStatuses, &commitStatus)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRepositoryCommitStatusResponse{CommitStatus: commitStatuses, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RepositoryCombinedCommitStatus(c context.Context, req *types.QueryGetRepositoryCombinedCommitStatusRequest) (*types.QueryGetRepositoryCombinedCommitStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, "repository not found")
+	}
+
+	// ref can be a branch name, a tag name or a commit sha
+	sha := req.Ref
+	if branch, found := k.GetRepositoryBranch(ctx, repository.Id, req.Ref); found {
+		sha = branch.Sha
+	} else if tag, found := k.GetRepositoryTag(ctx, repository.Id, req.Ref); found {
+		sha = tag.Sha
+	}
+
+	var commitStatuses []*types.CommitStatus
+	statuses := k.GetAllCommitStatusForCommit(ctx, repository.Id, sha)
+	for i := range statuses {
+		commitStatuses = append(commitStatuses, &statuses[i])
+	}
+
+	return &types.QueryGetRepositoryCombinedCommitStatusResponse{
+		State:        GetCombinedCommitStatus(statuses),
+		Sha:          sha,
+		CommitStatus: commitStatuses,
+	}, nil
+}
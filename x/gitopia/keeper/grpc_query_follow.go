@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) UserFollowers(c context.Context, req *types.QueryUserFollowersRequest) (*types.QueryUserFollowersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var follows []types.Follow
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	followerStore := prefix.NewStore(store, types.KeyPrefix(types.GetFollowerKeyForAddress(address.Address)))
+
+	pageRes, err := query.Paginate(followerStore, req.Pagination, func(key []byte, value []byte) error {
+		var follow types.Follow
+		if err := k.cdc.Unmarshal(value, &follow); err != nil {
+			return err
+		}
+
+		follows = append(follows, follow)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUserFollowersResponse{Follow: follows, Pagination: pageRes}, nil
+}
+
+func (k Keeper) UserFollowing(c context.Context, req *types.QueryUserFollowingRequest) (*types.QueryUserFollowingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var follows []types.Follow
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	followingStore := prefix.NewStore(store, types.KeyPrefix(types.GetFollowingKeyForAddress(address.Address)))
+
+	pageRes, err := query.Paginate(followingStore, req.Pagination, func(key []byte, value []byte) error {
+		var follow types.Follow
+		if err := k.cdc.Unmarshal(value, &follow); err != nil {
+			return err
+		}
+
+		follows = append(follows, follow)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUserFollowingResponse{Follow: follows, Pagination: pageRes}, nil
+}
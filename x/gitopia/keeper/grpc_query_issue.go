@@ -207,6 +207,34 @@ func PaginateAllRepositoryIssue(
 		issues = issueBuffer
 	}
 
+	if option.Milestone == "ANY" {
+		var issueBuffer []*types.Issue
+		for _, issue := range issues {
+			if issue.Milestone != 0 {
+				issueBuffer = append(issueBuffer, issue)
+			}
+		}
+		issues = issueBuffer
+	} else if option.Milestone == "NONE" {
+		var issueBuffer []*types.Issue
+		for _, issue := range issues {
+			if issue.Milestone == 0 {
+				issueBuffer = append(issueBuffer, issue)
+			}
+		}
+		issues = issueBuffer
+	}
+
+	if option.MilestoneId != 0 {
+		var issueBuffer []*types.Issue
+		for _, issue := range issues {
+			if issue.Milestone == option.MilestoneId {
+				issueBuffer = append(issueBuffer, issue)
+			}
+		}
+		issues = issueBuffer
+	}
+
 	if option.UpdatedAfter != 0 {
 		var issueBuffer []*types.Issue
 		for _, issue := range issues {
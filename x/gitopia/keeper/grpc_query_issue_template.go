@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RepositoryIssueTemplateAll(c context.Context, req *types.QueryAllRepositoryIssueTemplateRequest) (*types.QueryAllRepositoryIssueTemplateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, "repository not found")
+	}
+
+	return &types.QueryAllRepositoryIssueTemplateResponse{IssueTemplate: repository.IssueTemplates}, nil
+}
+
+func (k Keeper) RepositoryIssueTemplate(c context.Context, req *types.QueryGetRepositoryIssueTemplateRequest) (*types.QueryGetRepositoryIssueTemplateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, "repository not found")
+	}
+
+	i, exists := utils.RepositoryIssueTemplateIdExists(repository.IssueTemplates, req.TemplateId)
+	if !exists {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, "issue template not found")
+	}
+
+	return &types.QueryGetRepositoryIssueTemplateResponse{IssueTemplate: repository.IssueTemplates[i]}, nil
+}
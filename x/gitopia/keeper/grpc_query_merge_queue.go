@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RepositoryMergeQueue(c context.Context, req *types.QueryGetRepositoryMergeQueueRequest) (*types.QueryGetRepositoryMergeQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, "repository not found")
+	}
+
+	mergeQueue, found := k.GetRepositoryMergeQueue(ctx, repository.Id, req.BranchName)
+	if !found {
+		mergeQueue = types.MergeQueue{
+			RepositoryId: repository.Id,
+			Branch:       req.BranchName,
+		}
+	}
+
+	return &types.QueryGetRepositoryMergeQueueResponse{MergeQueue: mergeQueue}, nil
+}
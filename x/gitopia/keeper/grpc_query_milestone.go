@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RepositoryMilestoneAll(c context.Context, req *types.QueryAllRepositoryMilestoneRequest) (*types.QueryAllRepositoryMilestoneResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, "repository not found")
+	}
+
+	return &types.QueryAllRepositoryMilestoneResponse{Milestone: repository.Milestones}, nil
+}
+
+func (k Keeper) RepositoryMilestone(c context.Context, req *types.QueryGetRepositoryMilestoneRequest) (*types.QueryGetRepositoryMilestoneResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, "repository not found")
+	}
+
+	i, exists := utils.RepositoryMilestoneIdExists(repository.Milestones, req.MilestoneId)
+	if !exists {
+		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, "milestone not found")
+	}
+
+	openIssues, closedIssues, openPullRequests, closedPullRequests := k.GetRepositoryMilestoneProgress(ctx, repository.Id, req.MilestoneId)
+
+	return &types.QueryGetRepositoryMilestoneResponse{
+		Milestone:               repository.Milestones[i],
+		OpenIssuesCount:         openIssues,
+		ClosedIssuesCount:       closedIssues,
+		OpenPullRequestsCount:   openPullRequests,
+		ClosedPullRequestsCount: closedPullRequests,
+	}, nil
+}
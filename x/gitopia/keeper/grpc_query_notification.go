@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) NotificationAll(c context.Context, req *types.QueryAllNotificationRequest) (*types.QueryAllNotificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var notifications []types.Notification
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	notificationStore := prefix.NewStore(store, types.KeyPrefix(types.GetNotificationKeyForAddress(address.Address)))
+
+	pageRes, err := query.FilteredPaginate(notificationStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var notification types.Notification
+		if err := k.cdc.Unmarshal(value, &notification); err != nil {
+			return false, err
+		}
+
+		if req.Unread && notification.Read {
+			return false, nil
+		}
+
+		if accumulate {
+			notifications = append(notifications, notification)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllNotificationResponse{Notification: notifications, Pagination: pageRes}, nil
+}
@@ -26,6 +26,23 @@ func (k Keeper) CheckGitServerAuthorization(c context.Context, req *types.QueryC
 		}
 	}
 
+	// archived repositories are read-only, the git server must not push to them
+	if req.RepositoryName != "" {
+		address, err := k.ResolveAddress(ctx, req.RepositoryOwnerId)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+		if !found {
+			return nil, status.Error(codes.NotFound, "repository not found")
+		}
+
+		if repository.Archived {
+			return &types.QueryCheckGitServerAuthorizationResponse{HaveAuthorization: false}, nil
+		}
+	}
+
 	return &types.QueryCheckGitServerAuthorizationResponse{HaveAuthorization: true}, nil
 }
 
@@ -48,3 +65,23 @@ func (k Keeper) CheckStorageProviderAuthorization(c context.Context, req *types.
 
 	return &types.QueryCheckStorageProviderAuthorizationResponse{HaveAuthorization: true}, nil
 }
+
+func (k Keeper) CheckCiProviderAuthorization(c context.Context, req *types.QueryCheckCiProviderAuthorizationRequest) (*types.QueryCheckCiProviderAuthorizationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	grantee, _ := sdk.AccAddressFromBech32(req.ProviderAddress)
+	granter, _ := sdk.AccAddressFromBech32(req.UserAddress)
+
+	for _, t := range CiTypeUrls {
+		authorization, _ := k.authzKeeper.GetAuthorization(ctx, grantee, granter, t)
+		if authorization == nil {
+			return &types.QueryCheckCiProviderAuthorizationResponse{HaveAuthorization: false}, nil
+		}
+	}
+
+	return &types.QueryCheckCiProviderAuthorizationResponse{HaveAuthorization: true}, nil
+}
@@ -262,6 +262,34 @@ func PaginateAllRepositoryPullRequest(
 		pullRequests = pullRequestBuffer
 	}
 
+	if option.Milestone == "ANY" {
+		var pullRequestBuffer []*types.PullRequest
+		for _, pullRequest := range pullRequests {
+			if pullRequest.Milestone != 0 {
+				pullRequestBuffer = append(pullRequestBuffer, pullRequest)
+			}
+		}
+		pullRequests = pullRequestBuffer
+	} else if option.Milestone == "NONE" {
+		var pullRequestBuffer []*types.PullRequest
+		for _, pullRequest := range pullRequests {
+			if pullRequest.Milestone == 0 {
+				pullRequestBuffer = append(pullRequestBuffer, pullRequest)
+			}
+		}
+		pullRequests = pullRequestBuffer
+	}
+
+	if option.MilestoneId != 0 {
+		var pullRequestBuffer []*types.PullRequest
+		for _, pullRequest := range pullRequests {
+			if pullRequest.Milestone == option.MilestoneId {
+				pullRequestBuffer = append(pullRequestBuffer, pullRequest)
+			}
+		}
+		pullRequests = pullRequestBuffer
+	}
+
 	if option.UpdatedAfter != 0 {
 		var pullRequestBuffer []*types.PullRequest
 		for _, pullRequest := range pullRequests {
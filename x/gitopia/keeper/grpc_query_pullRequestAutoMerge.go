@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PullRequestAutoMerge(c context.Context, req *types.QueryGetPullRequestAutoMergeRequest) (*types.QueryGetPullRequestAutoMergeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	autoMerge, found := k.GetPullRequestAutoMerge(ctx, req.RepositoryId, req.PullRequestIid)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetPullRequestAutoMergeResponse{PullRequestAutoMerge: &autoMerge}, nil
+}
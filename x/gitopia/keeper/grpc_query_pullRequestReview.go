@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PullRequestReviewAll(c context.Context, req *types.QueryAllPullRequestReviewRequest) (*types.QueryAllPullRequestReviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var reviews []*types.PullRequestReview
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, req.RepositoryId, req.PullRequestIid)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	// A review is stale once the head branch has moved past the reviewed commit
+	headSha := k.GetPullRequestHeadSha(ctx, pullRequest)
+
+	store := ctx.KVStore(k.storeKey)
+	reviewStore := prefix.NewStore(store, types.KeyPrefix(types.GetPullRequestReviewKeyForPullRequest(req.RepositoryId, req.PullRequestIid)))
+
+	pageRes, err := query.Paginate(reviewStore, req.Pagination, func(key []byte, value []byte) error {
+		var review types.PullRequestReview
+		if err := k.cdc.Unmarshal(value, &review); err != nil {
+			return err
+		}
+
+		review.Stale = review.CommitSha != headSha
+		reviews = append(reviews, &review)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPullRequestReviewResponse{PullRequestReview: reviews, Pagination: pageRes}, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	ks "github.com/cosmos/cosmos-sdk/store/types"
@@ -23,20 +24,31 @@ func (k Keeper) RepositoryAll(c context.Context, req *types.QueryAllRepositoryRe
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	archived, err := parseRepositoryArchivedOption(req.Option)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	var repositorys []*types.Repository
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(k.storeKey)
 	repositoryStore := prefix.NewStore(store, types.KeyPrefix(types.RepositoryKey))
 
-	pageRes, err := query.Paginate(repositoryStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(repositoryStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var repository types.Repository
 		if err := k.cdc.Unmarshal(value, &repository); err != nil {
-			return err
+			return false, err
 		}
 
-		repositorys = append(repositorys, &repository)
-		return nil
+		if archived != nil && repository.Archived != *archived {
+			return false, nil
+		}
+
+		if accumulate {
+			repositorys = append(repositorys, &repository)
+		}
+		return true, nil
 	})
 
 	if err != nil {
@@ -46,6 +58,20 @@ func (k Keeper) RepositoryAll(c context.Context, req *types.QueryAllRepositoryRe
 	return &types.QueryAllRepositoryResponse{Repository: repositorys, Pagination: pageRes}, nil
 }
 
+// parseRepositoryArchivedOption returns the archived state to filter repositories by, or nil
+// to list every repository
+func parseRepositoryArchivedOption(option *types.RepositoryOptions) (*bool, error) {
+	if option == nil || option.Archived == "" {
+		return nil, nil
+	}
+
+	archived, err := strconv.ParseBool(option.Archived)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archived option (%v)", option.Archived)
+	}
+	return &archived, nil
+}
+
 func (k Keeper) Repository(c context.Context, req *types.QueryGetRepositoryRequest) (*types.QueryGetRepositoryResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RepositoryStargazerAll(c context.Context, req *types.QueryAllRepositoryStargazerRequest) (*types.QueryAllRepositoryStargazerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var stars []types.RepositoryStar
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, status.Error(codes.NotFound, "repository not found")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	starStore := prefix.NewStore(store, types.KeyPrefix(types.GetRepositoryStarKeyForRepositoryId(repository.Id)))
+
+	pageRes, err := query.Paginate(starStore, req.Pagination, func(key []byte, value []byte) error {
+		var star types.RepositoryStar
+		if err := k.cdc.Unmarshal(value, &star); err != nil {
+			return err
+		}
+
+		stars = append(stars, star)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRepositoryStargazerResponse{RepositoryStar: stars, Pagination: pageRes}, nil
+}
+
+func (k Keeper) UserStarredRepositoryAll(c context.Context, req *types.QueryAllUserStarredRepositoryRequest) (*types.QueryAllUserStarredRepositoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	repositorys, pageRes, err := k.paginateUserSubscriptions(ctx, starSubscription, address.Address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllUserStarredRepositoryResponse{Repository: repositorys, Pagination: pageRes}, nil
+}
@@ -72,18 +72,29 @@ func (k Keeper) AnyRepositoryAll(c context.Context, req *types.QueryAllAnyReposi
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
+	archived, err := parseRepositoryArchivedOption(req.Option)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	repositoryStore := prefix.NewStore(store, types.KeyPrefix(types.GetRepositoryKeyForAddress(address.Address)))
 
 	var repositorys []*types.Repository
-	pageRes, err := query.Paginate(repositoryStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(repositoryStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var repository types.Repository
 		if err := k.cdc.Unmarshal(value, &repository); err != nil {
-			return err
+			return false, err
 		}
 
-		repositorys = append(repositorys, &repository)
-		return nil
+		if archived != nil && repository.Archived != *archived {
+			return false, nil
+		}
+
+		if accumulate {
+			repositorys = append(repositorys, &repository)
+		}
+		return true, nil
 	})
 
 	if err != nil {
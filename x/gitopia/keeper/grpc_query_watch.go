@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RepositoryWatcherAll(c context.Context, req *types.QueryAllRepositoryWatcherRequest) (*types.QueryAllRepositoryWatcherResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var watches []types.RepositoryWatch
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, status.Error(codes.NotFound, "repository not found")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	watchStore := prefix.NewStore(store, types.KeyPrefix(types.GetRepositoryWatchKeyForRepositoryId(repository.Id)))
+
+	pageRes, err := query.Paginate(watchStore, req.Pagination, func(key []byte, value []byte) error {
+		var watch types.RepositoryWatch
+		if err := k.cdc.Unmarshal(value, &watch); err != nil {
+			return err
+		}
+
+		watches = append(watches, watch)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRepositoryWatcherResponse{RepositoryWatch: watches, Pagination: pageRes}, nil
+}
+
+func (k Keeper) UserWatchedRepositoryAll(c context.Context, req *types.QueryAllUserWatchedRepositoryRequest) (*types.QueryAllUserWatchedRepositoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	repositorys, pageRes, err := k.paginateUserSubscriptions(ctx, watchSubscription, address.Address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllUserWatchedRepositoryResponse{Repository: repositorys, Pagination: pageRes}, nil
+}
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gitopia/gitopia/x/gitopia/types"
 )
 
@@ -124,6 +126,15 @@ func (k Keeper) GetAllIssue(ctx sdk.Context) (list []types.Issue) {
 	return
 }
 
+// CheckIssueNotTransferred returns an error if the issue was transferred to another repository.
+// Only a tombstone pointing to the transferred issue is left in the source repository.
+func CheckIssueNotTransferred(issue types.Issue) error {
+	if issue.TransferredTo != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue (%d) is transferred", issue.Iid))
+	}
+	return nil
+}
+
 // GetIssueIDBytes returns the byte representation of the ID
 func GetIssueIDBytes(id uint64) []byte {
 	bz := make([]byte, 8)
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+// getReferencedIssue returns the issue an issue reference points to. References without an owner
+// are resolved in the base repository of the pull request. Transferred issues are ignored.
+func (k Keeper) getReferencedIssue(ctx sdk.Context, baseRepository types.Repository, reference utils.IssueReference) (types.Issue, bool) {
+	repository := baseRepository
+	if reference.Owner != "" {
+		ownerAddress, err := k.ResolveAddress(ctx, reference.Owner)
+		if err != nil {
+			return types.Issue{}, false
+		}
+
+		var found bool
+		repository, found = k.GetAddressRepository(ctx, ownerAddress.Address, reference.Repository)
+		if !found {
+			return types.Issue{}, false
+		}
+	}
+
+	// Archived repositories are read-only
+	if repository.Archived {
+		return types.Issue{}, false
+	}
+
+	issue, found := k.GetRepositoryIssue(ctx, repository.Id, reference.Iid)
+	if !found || CheckIssueNotTransferred(issue) != nil {
+		return types.Issue{}, false
+	}
+
+	return issue, true
+}
+
+// AddPullRequestIssueReferences parses the issue references in text. Every referenced issue gets a
+// cross-reference comment the first time the pull request mentions it. Issues of the base repository
+// referenced with a closing keyword are also linked to the pull request, so that they are closed
+// when it gets merged. References to unknown issues are ignored. The caller stores the pull request.
+func (k Keeper) AddPullRequestIssueReferences(ctx sdk.Context, creator string, baseRepository types.Repository, pullRequest *types.PullRequest, text string) {
+	blockTime := ctx.BlockTime().Unix()
+
+	for _, reference := range utils.ParseIssueReferences(text) {
+		issue, found := k.getReferencedIssue(ctx, baseRepository, reference)
+		if !found {
+			continue
+		}
+
+		modified := false
+
+		if _, exists := utils.IssueIdExists(pullRequest.CrossReferences, issue.Id); !exists {
+			pullRequest.CrossReferences = append(pullRequest.CrossReferences, &types.IssueIid{
+				Id:  issue.Id,
+				Iid: issue.Iid,
+			})
+
+			pullRequestReference := fmt.Sprintf("#%v", pullRequest.Iid)
+			if issue.RepositoryId != pullRequest.Base.RepositoryId {
+				pullRequestReference = fmt.Sprintf("%v/%v#%v", baseRepository.Owner.Id, baseRepository.Name, pullRequest.Iid)
+			}
+
+			issue.CommentsCount += 1
+			k.AppendComment(ctx, types.Comment{
+				Creator:      "GITOPIA",
+				RepositoryId: issue.RepositoryId,
+				ParentIid:    issue.Iid,
+				Parent:       types.CommentParentIssue,
+				CommentIid:   issue.CommentsCount,
+				Body:         utils.CrossReferenceCommentBody(creator, pullRequestReference),
+				System:       true,
+				CreatedAt:    blockTime,
+				UpdatedAt:    blockTime,
+				CommentType:  types.CommentTypeCrossReference,
+			})
+			modified = true
+		}
+
+		if reference.Closing &&
+			issue.RepositoryId == pullRequest.Base.RepositoryId &&
+			len(pullRequest.Issues) < 10 {
+			if _, exists := utils.IssueIidExists(pullRequest.Issues, issue.Iid); !exists {
+				pullRequest.Issues = append(pullRequest.Issues, &types.IssueIid{
+					Id:  issue.Id,
+					Iid: issue.Iid,
+				})
+				pullRequest.CommentsCount += 1
+				pullRequest.UpdatedAt = blockTime
+
+				k.AppendComment(ctx, types.Comment{
+					Creator:      "GITOPIA",
+					RepositoryId: pullRequest.Base.RepositoryId,
+					ParentIid:    pullRequest.Iid,
+					Parent:       types.CommentParentPullRequest,
+					CommentIid:   pullRequest.CommentsCount,
+					Body:         utils.LinkIssueCommentBody(creator, issue.Iid),
+					System:       true,
+					CreatedAt:    blockTime,
+					UpdatedAt:    blockTime,
+					CommentType:  types.CommentTypeNone,
+				})
+
+				if _, exists := utils.PullRequestIidExists(issue.PullRequests, pullRequest.Iid); !exists {
+					issue.PullRequests = append(issue.PullRequests, &types.PullRequestIid{
+						Id:  pullRequest.Id,
+						Iid: pullRequest.Iid,
+					})
+					issue.CommentsCount += 1
+					k.AppendComment(ctx, types.Comment{
+						Creator:      "GITOPIA",
+						RepositoryId: issue.RepositoryId,
+						ParentIid:    issue.Iid,
+						Parent:       types.CommentParentIssue,
+						CommentIid:   issue.CommentsCount,
+						Body:         utils.LinkPullRequestCommentBody(creator, pullRequest.Iid),
+						System:       true,
+						CreatedAt:    blockTime,
+						UpdatedAt:    blockTime,
+						CommentType:  types.CommentTypeNone,
+					})
+				}
+				modified = true
+			}
+		}
+
+		if modified {
+			issue.UpdatedAt = blockTime
+			k.SetIssue(ctx, issue)
+		}
+	}
+}
@@ -24,6 +24,8 @@ type (
 		memKey              storetypes.StoreKey
 		minterAccountName   string
 		feeCollectorAccount string
+		// authority is the address allowed to update the bounty arbiter, usually the gov module account
+		authority string
 
 		accountKeeper authkeeper.AccountKeeper
 		authzKeeper   *authzkeeper.Keeper
@@ -43,6 +45,7 @@ func NewKeeper(
 	authzKeeper *authzkeeper.Keeper,
 	bankKeeper bankKeeper.Keeper,
 	mintKeeper mintkeeper.Keeper,
+	authority string,
 	// this line is used by starport scaffolding # ibc/keeper/parameter
 ) *Keeper {
 	return &Keeper{
@@ -51,6 +54,7 @@ func NewKeeper(
 		memKey:              memKey,
 		minterAccountName:   minterAccountName,
 		feeCollectorAccount: feeCollectorAccount,
+		authority:           authority,
 
 		accountKeeper: ak,
 		authzKeeper:   authzKeeper,
@@ -60,6 +64,11 @@ func NewKeeper(
 	}
 }
 
+// GetAuthority returns the address allowed to update the bounty arbiter
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
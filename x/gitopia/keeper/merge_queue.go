@@ -0,0 +1,268 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// SetRepositoryMergeQueue set the merge queue of a repository branch in the store.
+// An empty queue is removed from the store.
+func (k Keeper) SetRepositoryMergeQueue(ctx sdk.Context, mergeQueue types.MergeQueue) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetMergeQueueKeyForRepositoryId(mergeQueue.RepositoryId)),
+	)
+	if len(mergeQueue.Entries) == 0 {
+		store.Delete([]byte(mergeQueue.Branch))
+		return
+	}
+	b := k.cdc.MustMarshal(&mergeQueue)
+	store.Set([]byte(mergeQueue.Branch), b)
+}
+
+// GetRepositoryMergeQueue returns the merge queue of a repository branch
+func (k Keeper) GetRepositoryMergeQueue(ctx sdk.Context, repositoryId uint64, branch string) (val types.MergeQueue, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetMergeQueueKeyForRepositoryId(repositoryId)),
+	)
+	b := store.Get([]byte(branch))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllMergeQueue returns all mergeQueue
+func (k Keeper) GetAllMergeQueue(ctx sdk.Context) (list []types.MergeQueue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MergeQueueKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MergeQueue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveAllRepositoryMergeQueue removes every merge queue of a repository from the store
+func (k Keeper) RemoveAllRepositoryMergeQueue(ctx sdk.Context, repositoryId uint64) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetMergeQueueKeyForRepositoryId(repositoryId)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var list []types.MergeQueue
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MergeQueue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	iterator.Close()
+
+	for _, mergeQueue := range list {
+		for _, entry := range mergeQueue.Entries {
+			if entry.Testing {
+				k.RemoveMergeQueueTask(ctx, entry.TaskId)
+			}
+		}
+		store.Delete([]byte(mergeQueue.Branch))
+	}
+}
+
+// SetMergeQueueTask maps a merge queue test task to the merge queue it belongs to
+func (k Keeper) SetMergeQueueTask(ctx sdk.Context, taskId uint64, repositoryId uint64, branch string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MergeQueueTaskKey))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, repositoryId)
+	store.Set(GetTaskIDBytes(taskId), append(bz, []byte(branch)...))
+}
+
+// GetMergeQueueTask returns the merge queue a test task belongs to
+func (k Keeper) GetMergeQueueTask(ctx sdk.Context, taskId uint64) (repositoryId uint64, branch string, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MergeQueueTaskKey))
+	bz := store.Get(GetTaskIDBytes(taskId))
+	if bz == nil {
+		return 0, "", false
+	}
+	return binary.BigEndian.Uint64(bz[:8]), string(bz[8:]), true
+}
+
+// RemoveMergeQueueTask removes the merge queue mapping of a test task
+func (k Keeper) RemoveMergeQueueTask(ctx sdk.Context, taskId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MergeQueueTaskKey))
+	store.Delete(GetTaskIDBytes(taskId))
+}
+
+// GetMergeQueueEntryPosition returns the position of a pull request in the merge queue
+func GetMergeQueueEntryPosition(mergeQueue types.MergeQueue, pullRequestIid uint64) (int, bool) {
+	for i, entry := range mergeQueue.Entries {
+		if entry.PullRequestIid == pullRequestIid {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// AdvanceMergeQueue hands the entry at the front of the merge queue to the git server, which
+// tests the pull request on top of the latest base branch and reports the result with
+// UpdateTask. An entry stays at the front of the queue until its merge is recorded by
+// SetPullRequestState, so the next entry is only tested once the base branch contains every
+// pull request merged from the queue before it. Entries which no longer satisfy the merge
+// requirements are evicted.
+func (k Keeper) AdvanceMergeQueue(ctx sdk.Context, mergeQueue *types.MergeQueue) {
+	for len(mergeQueue.Entries) > 0 {
+		entry := &mergeQueue.Entries[0]
+		if entry.Testing {
+			return
+		}
+
+		if err := k.checkMergeQueueEntry(ctx, mergeQueue.RepositoryId, *entry); err != nil {
+			k.evictMergeQueueEntry(ctx, mergeQueue, 0, err.Error())
+			continue
+		}
+
+		entry.TaskId = k.AppendTask(ctx, types.Task{
+			Type:     types.TypeTestMergeQueueEntry,
+			State:    types.StatePending,
+			Creator:  entry.Creator,
+			Provider: entry.Provider,
+		})
+		entry.Testing = true
+		k.SetMergeQueueTask(ctx, entry.TaskId, mergeQueue.RepositoryId, mergeQueue.Branch)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(sdk.AttributeKeyAction, types.TestMergeQueueEntryEventKey),
+				sdk.NewAttribute(types.EventAttributeCreatorKey, entry.Creator),
+				sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(mergeQueue.RepositoryId, 10)),
+				sdk.NewAttribute(types.EventAttributeRepoBranchKey, mergeQueue.Branch),
+				sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(entry.PullRequestIid, 10)),
+				sdk.NewAttribute(types.EventAttributePullRequestMergeMethodKey, entry.MergeMethod.String()),
+				sdk.NewAttribute(types.EventAttributeTaskIdKey, strconv.FormatUint(entry.TaskId, 10)),
+			),
+		)
+		return
+	}
+}
+
+// HandleMergeQueueTaskResult advances the merge queue once the git server has reported the
+// result of a task of the entry at the front of the queue. A successful test queues the merge
+// of the pull request and keeps the entry at the front until the merge is recorded, a failed
+// test or merge evicts it from the queue.
+func (k Keeper) HandleMergeQueueTaskResult(ctx sdk.Context, task types.Task) {
+	if task.State == types.StatePending {
+		return
+	}
+
+	repositoryId, branch, found := k.GetMergeQueueTask(ctx, task.Id)
+	if !found {
+		return
+	}
+	k.RemoveMergeQueueTask(ctx, task.Id)
+
+	mergeQueue, found := k.GetRepositoryMergeQueue(ctx, repositoryId, branch)
+	if !found || !mergeQueue.Entries[0].Testing || mergeQueue.Entries[0].TaskId != task.Id {
+		return
+	}
+
+	entry := mergeQueue.Entries[0]
+	if task.Type == types.TypeSetPullRequestState {
+		// a merged pull request leaves the queue through SetPullRequestState, any other
+		// result of the merge task means the pull request couldn't be merged
+		reason := task.Message
+		if reason == "" {
+			reason = fmt.Sprintf("pullRequest (%d) couldn't be merged", entry.PullRequestIid)
+		}
+		k.evictMergeQueueEntry(ctx, &mergeQueue, 0, reason)
+	} else if task.State == types.StateFailure {
+		k.evictMergeQueueEntry(ctx, &mergeQueue, 0, task.Message)
+	} else if err := k.checkMergeQueueEntry(ctx, repositoryId, entry); err != nil {
+		k.evictMergeQueueEntry(ctx, &mergeQueue, 0, err.Error())
+	} else {
+		pullRequest, _ := k.GetRepositoryPullRequest(ctx, repositoryId, entry.PullRequestIid)
+		mergeQueue.Entries[0].TaskId = k.QueuePullRequestMerge(ctx, entry.Creator, entry.Provider, pullRequest, entry.MergeMethod)
+		k.SetMergeQueueTask(ctx, mergeQueue.Entries[0].TaskId, repositoryId, branch)
+	}
+
+	k.AdvanceMergeQueue(ctx, &mergeQueue)
+	k.SetRepositoryMergeQueue(ctx, mergeQueue)
+}
+
+// DropPullRequestFromMergeQueue removes a pull request from the merge queue of its base branch
+// and advances the queue. It returns false if the pull request isn't queued.
+func (k Keeper) DropPullRequestFromMergeQueue(ctx sdk.Context, pullRequest types.PullRequest) bool {
+	mergeQueue, found := k.GetRepositoryMergeQueue(ctx, pullRequest.Base.RepositoryId, pullRequest.Base.Branch)
+	if !found {
+		return false
+	}
+
+	i, exists := GetMergeQueueEntryPosition(mergeQueue, pullRequest.Iid)
+	if !exists {
+		return false
+	}
+
+	if mergeQueue.Entries[i].Testing {
+		k.RemoveMergeQueueTask(ctx, mergeQueue.Entries[i].TaskId)
+	}
+	mergeQueue.Entries = append(mergeQueue.Entries[:i], mergeQueue.Entries[i+1:]...)
+
+	k.AdvanceMergeQueue(ctx, &mergeQueue)
+	k.SetRepositoryMergeQueue(ctx, mergeQueue)
+
+	return true
+}
+
+func (k Keeper) checkMergeQueueEntry(ctx sdk.Context, repositoryId uint64, entry types.MergeQueueEntry) error {
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, repositoryId, entry.PullRequestIid)
+	if !found || pullRequest.State != types.PullRequest_OPEN {
+		return fmt.Errorf("pullRequest (%d) is not open", entry.PullRequestIid)
+	}
+	if pullRequest.Draft {
+		return fmt.Errorf("pullRequest (%d) is a draft", entry.PullRequestIid)
+	}
+
+	repository, found := k.GetRepositoryById(ctx, repositoryId)
+	if !found {
+		return fmt.Errorf("repository id (%d) doesn't exist", repositoryId)
+	}
+	if !k.HavePermission(ctx, entry.Creator, repository, types.PullRequestMergeQueuePermission) {
+		return fmt.Errorf("user (%v) doesn't have permission to merge pullRequest", entry.Creator)
+	}
+	if err := CheckPullRequestMergeMethodAllowed(repository, pullRequest, entry.MergeMethod); err != nil {
+		return err
+	}
+	return k.CheckPullRequestMergeAllowed(ctx, entry.Creator, repository, pullRequest)
+}
+
+func (k Keeper) evictMergeQueueEntry(ctx sdk.Context, mergeQueue *types.MergeQueue, i int, reason string) {
+	entry := mergeQueue.Entries[i]
+	if entry.Testing {
+		k.RemoveMergeQueueTask(ctx, entry.TaskId)
+	}
+	mergeQueue.Entries = append(mergeQueue.Entries[:i], mergeQueue.Entries[i+1:]...)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.EvictMergeQueueEntryEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, entry.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(mergeQueue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoBranchKey, mergeQueue.Branch),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(entry.PullRequestIid, 10)),
+			sdk.NewAttribute(types.EventAttributeMessageKey, reason),
+		),
+	)
+}
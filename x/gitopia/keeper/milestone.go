@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// MoveIssueToMilestone sets the milestone of an issue and moves it to the index of the milestone.
+// The issue isn't saved.
+func (k Keeper) MoveIssueToMilestone(ctx sdk.Context, issue *types.Issue, milestoneId uint64) {
+	k.moveMilestoneMember(ctx, types.GetMilestoneIssueKeyForMilestone, issue.RepositoryId, issue.Milestone, milestoneId, issue.Iid)
+	issue.Milestone = milestoneId
+}
+
+// MovePullRequestToMilestone sets the milestone of a pull request and moves it to the index of the
+// milestone. The pull request isn't saved.
+func (k Keeper) MovePullRequestToMilestone(ctx sdk.Context, pullRequest *types.PullRequest, milestoneId uint64) {
+	k.moveMilestoneMember(ctx, types.GetMilestonePullRequestKeyForMilestone, pullRequest.Base.RepositoryId, pullRequest.Milestone, milestoneId, pullRequest.Iid)
+	pullRequest.Milestone = milestoneId
+}
+
+// moveMilestoneMember moves iid from the index of milestone from to the one of milestone to.
+// Milestone 0 is no milestone and isn't indexed.
+func (k Keeper) moveMilestoneMember(ctx sdk.Context, milestoneKey func(repositoryId uint64, milestoneId uint64) string, repositoryId uint64, from uint64, to uint64, iid uint64) {
+	if from != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(milestoneKey(repositoryId, from)))
+		store.Delete(sdk.Uint64ToBigEndian(iid))
+	}
+	if to != 0 {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(milestoneKey(repositoryId, to)))
+		store.Set(sdk.Uint64ToBigEndian(iid), []byte{})
+	}
+}
+
+// getMilestoneMembers returns the iids in the index of a milestone
+func (k Keeper) getMilestoneMembers(ctx sdk.Context, keyPrefix string) (iids []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		iids = append(iids, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	return iids
+}
+
+// GetRepositoryMilestoneProgress returns the number of open and closed issues and pull requests
+// in the repository milestone. Merged pull requests are counted as closed.
+func (k Keeper) GetRepositoryMilestoneProgress(ctx sdk.Context, repositoryId uint64, milestoneId uint64) (openIssues uint64, closedIssues uint64, openPullRequests uint64, closedPullRequests uint64) {
+	for _, iid := range k.getMilestoneMembers(ctx, types.GetMilestoneIssueKeyForMilestone(repositoryId, milestoneId)) {
+		issue, found := k.GetRepositoryIssue(ctx, repositoryId, iid)
+		if !found {
+			continue
+		}
+		if issue.State == types.Issue_OPEN {
+			openIssues += 1
+		} else {
+			closedIssues += 1
+		}
+	}
+
+	for _, iid := range k.getMilestoneMembers(ctx, types.GetMilestonePullRequestKeyForMilestone(repositoryId, milestoneId)) {
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, repositoryId, iid)
+		if !found {
+			continue
+		}
+		if pullRequest.State == types.PullRequest_OPEN {
+			openPullRequests += 1
+		} else {
+			closedPullRequests += 1
+		}
+	}
+
+	return
+}
+
+// RemoveRepositoryMilestoneFromAll clears the milestone from the issues and pull requests in it
+func (k Keeper) RemoveRepositoryMilestoneFromAll(ctx sdk.Context, repositoryId uint64, milestoneId uint64) {
+	for _, iid := range k.getMilestoneMembers(ctx, types.GetMilestoneIssueKeyForMilestone(repositoryId, milestoneId)) {
+		issue, found := k.GetRepositoryIssue(ctx, repositoryId, iid)
+		if !found {
+			k.moveMilestoneMember(ctx, types.GetMilestoneIssueKeyForMilestone, repositoryId, milestoneId, 0, iid)
+			continue
+		}
+		k.MoveIssueToMilestone(ctx, &issue, 0)
+		k.SetIssue(ctx, issue)
+	}
+
+	for _, iid := range k.getMilestoneMembers(ctx, types.GetMilestonePullRequestKeyForMilestone(repositoryId, milestoneId)) {
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, repositoryId, iid)
+		if !found {
+			k.moveMilestoneMember(ctx, types.GetMilestonePullRequestKeyForMilestone, repositoryId, milestoneId, 0, iid)
+			continue
+		}
+		k.MovePullRequestToMilestone(ctx, &pullRequest, 0)
+		k.SetPullRequest(ctx, pullRequest)
+	}
+}
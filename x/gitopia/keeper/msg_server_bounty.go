@@ -27,19 +27,37 @@ func (k msgServer) CreateBounty(goCtx context.Context, msg *types.MsgCreateBount
 	}
 
 	var issue types.Issue
+	var pullRequest types.PullRequest
 	switch msg.Parent {
 	case types.BountyParentIssue:
 		issue, found = k.GetRepositoryIssue(ctx, msg.RepositoryId, msg.ParentIid)
 		if !found {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist", msg.ParentIid))
 		}
-		if len(issue.Assignees) > 1 {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issue with bounty can't have more then 1 assignee")
+		if err := CheckIssueNotTransferred(issue); err != nil {
+			return nil, err
+		}
+	case types.BountyParentPullRequest:
+		pullRequest, found = k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist", msg.ParentIid))
+		}
+		if pullRequest.State != types.PullRequest_OPEN {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is not open", msg.ParentIid))
 		}
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
 	}
 
+	repository, found := k.GetRepositoryById(ctx, msg.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	var bounty = types.Bounty{
 		Creator:      msg.Creator,
 		Amount:       msg.Amount,
@@ -70,6 +88,7 @@ func (k msgServer) CreateBounty(goCtx context.Context, msg *types.MsgCreateBount
 		ctx,
 		bounty,
 	)
+	k.AddBountyFunder(ctx, id, msg.Creator, msg.Amount)
 
 	/* can never be default */
 	switch msg.Parent {
@@ -96,6 +115,29 @@ func (k msgServer) CreateBounty(goCtx context.Context, msg *types.MsgCreateBount
 			comment,
 		)
 		k.SetIssue(ctx, issue)
+	case types.BountyParentPullRequest:
+		pullRequest.Bounties = append(pullRequest.Bounties, id)
+		pullRequest.CommentsCount += 1
+		pullRequest.UpdatedAt = blockTime
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         utils.CreateBountyCommentBody(msg.Creator, msg.Amount),
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeAddBounty,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+		k.SetPullRequest(ctx, pullRequest)
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid bounty parent")
 	}
@@ -107,7 +149,7 @@ func (k msgServer) CreateBounty(goCtx context.Context, msg *types.MsgCreateBount
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.CreateBountyEventKey),
 			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
-			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(id, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyAmountKey, string(bountyAmountJson)),
 			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
@@ -151,6 +193,7 @@ func (k msgServer) UpdateBountyExpiry(goCtx context.Context, msg *types.MsgUpdat
 	}
 
 	var issue types.Issue
+	var pullRequest types.PullRequest
 	switch bounty.Parent {
 	case types.BountyParentIssue:
 		issue, found = k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
@@ -162,6 +205,16 @@ func (k msgServer) UpdateBountyExpiry(goCtx context.Context, msg *types.MsgUpdat
 				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contains open PR")
 			}
 		}
+	case types.BountyParentPullRequest:
+		pullRequest, found = k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist", bounty.ParentIid))
+		}
+		if pullRequest.State == types.PullRequest_OPEN {
+			if msg.Expiry < bounty.ExpireAt {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pull request is open")
+			}
+		}
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
 	}
@@ -195,6 +248,28 @@ func (k msgServer) UpdateBountyExpiry(goCtx context.Context, msg *types.MsgUpdat
 			comment,
 		)
 		k.SetIssue(ctx, issue)
+	case types.BountyParentPullRequest:
+		pullRequest.CommentsCount += 1
+		pullRequest.UpdatedAt = blockTime
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         utils.UpdateBountyExpiryCommentBody(msg.Creator),
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeModifiedBounty,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+		k.SetPullRequest(ctx, pullRequest)
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid bounty parent")
 	}
@@ -204,7 +279,7 @@ func (k msgServer) UpdateBountyExpiry(goCtx context.Context, msg *types.MsgUpdat
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.UpdateBountyExpiryEventKey),
 			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
-			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
 			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
@@ -238,6 +313,7 @@ func (k msgServer) CloseBounty(goCtx context.Context, msg *types.MsgCloseBounty)
 	}
 
 	var issue types.Issue
+	var pullRequest types.PullRequest
 	switch bounty.Parent {
 	case types.BountyParentIssue:
 		issue, found = k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
@@ -247,26 +323,19 @@ func (k msgServer) CloseBounty(goCtx context.Context, msg *types.MsgCloseBounty)
 		if len(issue.PullRequests) > 0 {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't close bounty; contains open PR")
 		}
+	case types.BountyParentPullRequest:
+		pullRequest, found = k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist", bounty.ParentIid))
+		}
+		if pullRequest.State == types.PullRequest_OPEN {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't close bounty; pull request is open")
+		}
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
 	}
 
-	creatorAccAddress, err := sdk.AccAddressFromBech32(msg.Creator)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := k.bankKeeper.IsSendEnabledCoins(ctx, bounty.Amount...); err != nil {
-		return nil, err
-	}
-	if k.bankKeeper.BlockedAddr(creatorAccAddress) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Creator)
-	}
-
-	bountyAddress := GetBountyAddress(bounty.Id)
-	if err := k.bankKeeper.SendCoins(
-		ctx, bountyAddress, creatorAccAddress, bounty.Amount,
-	); err != nil {
+	if err := k.RefundBounty(ctx, bounty); err != nil {
 		return nil, err
 	}
 
@@ -301,6 +370,28 @@ func (k msgServer) CloseBounty(goCtx context.Context, msg *types.MsgCloseBounty)
 			comment,
 		)
 		k.SetIssue(ctx, issue)
+	case types.BountyParentPullRequest:
+		pullRequest.CommentsCount += 1
+		pullRequest.UpdatedAt = blockTime
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         utils.CloseBountyCommentBody(msg.Creator),
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeClosedBounty,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+		k.SetPullRequest(ctx, pullRequest)
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid bounty parent")
 	}
@@ -310,7 +401,7 @@ func (k msgServer) CloseBounty(goCtx context.Context, msg *types.MsgCloseBounty)
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.CloseBountyEventKey),
 			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
-			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
 			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
@@ -340,7 +431,12 @@ func (k msgServer) DeleteBounty(goCtx context.Context, msg *types.MsgDeleteBount
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	if bounty.State == types.BountyStateAWARDED || bounty.State == types.BountyStateDISPUTED {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't delete bounty; payout is pending")
+	}
+
 	var issue types.Issue
+	var pullRequest types.PullRequest
 	switch bounty.Parent {
 	case types.BountyParentIssue:
 		issue, found = k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
@@ -357,32 +453,33 @@ func (k msgServer) DeleteBounty(goCtx context.Context, msg *types.MsgDeleteBount
 		} else {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't find bountyId (%d) under issue (%d)", bounty.Id, bounty.ParentIid))
 		}
-	default:
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
-	}
-
-	if bounty.State == types.BountyStateSRCDEBITTED {
-		creatorAccAddress, err := sdk.AccAddressFromBech32(msg.Creator)
-		if err != nil {
-			return nil, err
+	case types.BountyParentPullRequest:
+		pullRequest, found = k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist", bounty.ParentIid))
 		}
 
-		if err := k.bankKeeper.IsSendEnabledCoins(ctx, bounty.Amount...); err != nil {
-			return nil, err
+		if pullRequest.State == types.PullRequest_OPEN {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't delete bounty; pull request is open")
 		}
-		if k.bankKeeper.BlockedAddr(creatorAccAddress) {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Creator)
+
+		if i, exists := utils.BountyIdExists(pullRequest.Bounties, bounty.Id); exists {
+			pullRequest.Bounties = append(pullRequest.Bounties[:i], pullRequest.Bounties[i+1:]...)
+		} else {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't find bountyId (%d) under pullRequest (%d)", bounty.Id, bounty.ParentIid))
 		}
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
+	}
 
-		bountyAddress := GetBountyAddress(bounty.Id)
-		if err := k.bankKeeper.SendCoins(
-			ctx, bountyAddress, creatorAccAddress, bounty.Amount,
-		); err != nil {
+	if bounty.State == types.BountyStateSRCDEBITTED {
+		if err := k.RefundBounty(ctx, bounty); err != nil {
 			return nil, err
 		}
 	}
 
 	k.RemoveBounty(ctx, msg.Id)
+	k.RemoveAllBountyFunderForBounty(ctx, msg.Id)
 
 	blockTime := ctx.BlockTime().Unix()
 
@@ -410,6 +507,28 @@ func (k msgServer) DeleteBounty(goCtx context.Context, msg *types.MsgDeleteBount
 			comment,
 		)
 		k.SetIssue(ctx, issue)
+	case types.BountyParentPullRequest:
+		pullRequest.CommentsCount += 1
+		pullRequest.UpdatedAt = blockTime
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         utils.DeleteBountyCommentBody(msg.Creator),
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeNone,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+		k.SetPullRequest(ctx, pullRequest)
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid bounty parent")
 	}
@@ -419,7 +538,7 @@ func (k msgServer) DeleteBounty(goCtx context.Context, msg *types.MsgDeleteBount
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.DeleteBountyEventKey),
 			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
-			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
 			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
@@ -428,3 +547,575 @@ func (k msgServer) DeleteBounty(goCtx context.Context, msg *types.MsgDeleteBount
 
 	return &types.MsgDeleteBountyResponse{}, nil
 }
+
+func (k msgServer) SetBountySplits(goCtx context.Context, msg *types.MsgSetBountySplits) (*types.MsgSetBountySplitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime().Unix()
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	bounty, found := k.GetBounty(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("bounty with key %d doesn't exist", msg.Id))
+	}
+
+	if bounty.State != types.BountyStateSRCDEBITTED {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty already closed")
+	}
+
+	repository, found := k.GetRepositoryById(ctx, bounty.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", bounty.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.BountySplitPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if _, _, err := types.ComputeBountyPayouts(bounty.Amount, msg.Splits); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	var issue types.Issue
+	var pullRequest types.PullRequest
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		issue, found = k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist", bounty.ParentIid))
+		}
+	case types.BountyParentPullRequest:
+		pullRequest, found = k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist", bounty.ParentIid))
+		}
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
+	}
+
+	bounty.Splits = msg.Splits
+	bounty.UpdatedAt = blockTime
+
+	k.SetBounty(ctx, bounty)
+
+	/* can never be default */
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		issue.CommentsCount += 1
+		issue.UpdatedAt = blockTime
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentIssue,
+			CommentIid:   issue.CommentsCount,
+			Body:         utils.SetBountySplitsCommentBody(msg.Creator),
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeModifiedBounty,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+		k.SetIssue(ctx, issue)
+	case types.BountyParentPullRequest:
+		pullRequest.CommentsCount += 1
+		pullRequest.UpdatedAt = blockTime
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         utils.SetBountySplitsCommentBody(msg.Creator),
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeModifiedBounty,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+		k.SetPullRequest(ctx, pullRequest)
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid bounty parent")
+	}
+
+	splitsJson, _ := json.Marshal(bounty.Splits)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetBountySplitsEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountySplitsKey, string(splitsJson)),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetBountySplitsResponse{}, nil
+}
+
+func (k msgServer) FundBounty(goCtx context.Context, msg *types.MsgFundBounty) (*types.MsgFundBountyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime().Unix()
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	bounty, found := k.GetBounty(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("bounty with key %d doesn't exist", msg.Id))
+	}
+
+	if bounty.State != types.BountyStateSRCDEBITTED {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty already closed")
+	}
+
+	if bounty.ExpireAt <= blockTime {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty expired")
+	}
+
+	repository, found := k.GetRepositoryById(ctx, bounty.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", bounty.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	var issue types.Issue
+	var pullRequest types.PullRequest
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		issue, found = k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist", bounty.ParentIid))
+		}
+	case types.BountyParentPullRequest:
+		pullRequest, found = k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist", bounty.ParentIid))
+		}
+		if pullRequest.State != types.PullRequest_OPEN {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is not open", bounty.ParentIid))
+		}
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
+	}
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	creatorAccAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	bountyAddress := GetBountyAddress(bounty.Id)
+	if err := k.bankKeeper.SendCoins(ctx, creatorAccAddress, bountyAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	bounty.Amount = bounty.Amount.Add(msg.Amount...)
+	bounty.UpdatedAt = blockTime
+
+	k.SetBounty(ctx, bounty)
+	k.AddBountyFunder(ctx, bounty.Id, msg.Creator, msg.Amount)
+
+	/* can never be default */
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		issue.CommentsCount += 1
+		issue.UpdatedAt = blockTime
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentIssue,
+			CommentIid:   issue.CommentsCount,
+			Body:         utils.FundBountyCommentBody(msg.Creator, msg.Amount),
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeModifiedBounty,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+		k.SetIssue(ctx, issue)
+	case types.BountyParentPullRequest:
+		pullRequest.CommentsCount += 1
+		pullRequest.UpdatedAt = blockTime
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         utils.FundBountyCommentBody(msg.Creator, msg.Amount),
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeModifiedBounty,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+		k.SetPullRequest(ctx, pullRequest)
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid bounty parent")
+	}
+
+	bountyAmountJson, _ := json.Marshal(bounty.Amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.FundBountyEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyAmountKey, string(bountyAmountJson)),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgFundBountyResponse{}, nil
+}
+
+func (k msgServer) DisputeBounty(goCtx context.Context, msg *types.MsgDisputeBounty) (*types.MsgDisputeBountyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime().Unix()
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	bounty, found := k.GetBounty(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("bounty with key %d doesn't exist", msg.Id))
+	}
+
+	if k.GetParams(ctx).BountyArbiter == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty disputes are disabled")
+	}
+
+	if bounty.State != types.BountyStateAWARDED {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only an awarded bounty can be disputed")
+	}
+
+	if bounty.ReleaseAt <= blockTime {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty dispute window has ended")
+	}
+
+	repository, found := k.GetRepositoryById(ctx, bounty.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", bounty.RepositoryId))
+	}
+
+	if !k.isBountyStakeholder(ctx, bounty, repository, msg.Creator) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	bounty.State = types.BountyStateDISPUTED
+	bounty.Dispute = &types.BountyDispute{
+		Creator:   msg.Creator,
+		Reason:    msg.Reason,
+		CreatedAt: blockTime,
+	}
+	bounty.UpdatedAt = blockTime
+
+	k.SetBounty(ctx, bounty)
+
+	k.appendBountyComment(ctx, bounty, utils.DisputeBountyCommentBody(msg.Creator, msg.Reason), types.CommentTypeModifiedBounty)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.DisputeBountyEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
+			sdk.NewAttribute(types.EventAttributeBountyDisputeReasonKey, msg.Reason),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgDisputeBountyResponse{}, nil
+}
+
+func (k msgServer) ResolveBountyDispute(goCtx context.Context, msg *types.MsgResolveBountyDispute) (*types.MsgResolveBountyDisputeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime().Unix()
+
+	// the arbiter isn't required to be a gitopia user. Governance can always resolve a
+	// dispute, so that disputed bounties aren't stuck when the arbiter is removed.
+	if arbiter := k.GetParams(ctx).BountyArbiter; msg.Creator != k.authority && (arbiter == "" || msg.Creator != arbiter) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) isn't the bounty arbiter", msg.Creator))
+	}
+
+	bounty, found := k.GetBounty(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("bounty with key %d doesn't exist", msg.Id))
+	}
+
+	if bounty.State != types.BountyStateDISPUTED {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty isn't disputed")
+	}
+
+	bounty.Dispute.ResolvedBy = msg.Creator
+	bounty.Dispute.ResolvedAt = blockTime
+
+	if msg.Recipient == "" {
+		if err := k.RefundBounty(ctx, bounty); err != nil {
+			return nil, err
+		}
+
+		bounty.State = types.BountyStateREVERTEDBACK
+		bounty.RewardedTo = ""
+		bounty.ReleaseAt = time.Time{}.Unix()
+		bounty.UpdatedAt = blockTime
+
+		k.SetBounty(ctx, bounty)
+	} else {
+		// the arbiter's decision overrides the maintainer defined payout splits
+		bounty.Splits = nil
+		if err := k.PayBounty(ctx, bounty, msg.Recipient); err != nil {
+			return nil, err
+		}
+	}
+
+	bounty, _ = k.GetBounty(ctx, bounty.Id)
+
+	k.appendBountyComment(ctx, bounty, utils.ResolveBountyDisputeCommentBody(msg.Creator, msg.Recipient), types.CommentTypeClosedBounty)
+
+	bountyAmountJson, _ := json.Marshal(bounty.Amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ResolveBountyDisputeEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyAmountKey, string(bountyAmountJson)),
+			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
+			sdk.NewAttribute(types.EventAttributeBountyRewardedToKey, bounty.RewardedTo),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgResolveBountyDisputeResponse{}, nil
+}
+
+// appendBountyComment adds a system comment to the issue or pull request of a bounty.
+// Nothing is added when the parent has been deleted.
+func (k Keeper) appendBountyComment(ctx sdk.Context, bounty types.Bounty, body string, commentType types.CommentType) {
+	blockTime := ctx.BlockTime().Unix()
+
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		issue, found := k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return
+		}
+
+		issue.CommentsCount += 1
+		issue.UpdatedAt = blockTime
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentIssue,
+			CommentIid:   issue.CommentsCount,
+			Body:         body,
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  commentType,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+		k.SetIssue(ctx, issue)
+	case types.BountyParentPullRequest:
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return
+		}
+
+		pullRequest.CommentsCount += 1
+		pullRequest.UpdatedAt = blockTime
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         body,
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  commentType,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+		k.SetPullRequest(ctx, pullRequest)
+	}
+}
+
+func (k msgServer) AwardBounty(goCtx context.Context, msg *types.MsgAwardBounty) (*types.MsgAwardBountyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	bounty, found := k.GetBounty(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("bounty with key %d doesn't exist", msg.Id))
+	}
+
+	if bounty.State != types.BountyStateSRCDEBITTED {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty already closed")
+	}
+
+	repository, found := k.GetRepositoryById(ctx, bounty.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", bounty.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.BountyAwardPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if _, found := k.GetUser(ctx, msg.Recipient); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("user (%v) doesn't exist", msg.Recipient))
+	}
+
+	if err := k.RewardBounty(ctx, bounty, msg.Recipient); err != nil {
+		return nil, err
+	}
+
+	bounty, _ = k.GetBounty(ctx, bounty.Id)
+
+	k.appendBountyComment(ctx, bounty, utils.AwardBountyCommentBody(msg.Creator, msg.Recipient), types.CommentTypeClosedBounty)
+
+	bountyAmountJson, _ := json.Marshal(bounty.Amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AwardBountyEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyAmountKey, string(bountyAmountJson)),
+			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
+			sdk.NewAttribute(types.EventAttributeBountyRewardedToKey, bounty.RewardedTo),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgAwardBountyResponse{}, nil
+}
+
+func (k msgServer) UpdateBountyArbiter(goCtx context.Context, msg *types.MsgUpdateBountyArbiter) (*types.MsgUpdateBountyArbiterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("invalid authority; expected %v, got %v", k.authority, msg.Authority))
+	}
+
+	params := k.GetParams(ctx)
+	params.BountyArbiter = msg.Arbiter
+	params.BountyDisputeWindow = msg.DisputeWindow
+	k.SetParams(ctx, params)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UpdateBountyArbiterEventKey),
+			sdk.NewAttribute(types.EventAttributeBountyArbiterKey, msg.Arbiter),
+			sdk.NewAttribute(types.EventAttributeBountyDisputeWindowKey, strconv.FormatInt(msg.DisputeWindow, 10)),
+		),
+	)
+
+	return &types.MsgUpdateBountyArbiterResponse{}, nil
+}
+
+// isBountyStakeholder returns true if user funded the bounty, is assigned to its issue,
+// created its pull request, is the recipient of the bounty or maintains its repository.
+func (k Keeper) isBountyStakeholder(ctx sdk.Context, bounty types.Bounty, repository types.Repository, user string) bool {
+	if user == bounty.Creator || user == bounty.RewardedTo {
+		return true
+	}
+	if _, found := k.GetBountyFunder(ctx, bounty.Id, user); found {
+		return true
+	}
+
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		if issue, found := k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid); found {
+			if _, exists := utils.AssigneeExists(issue.Assignees, user); exists {
+				return true
+			}
+		}
+	case types.BountyParentPullRequest:
+		if pullRequest, found := k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid); found && pullRequest.Creator == user {
+			return true
+		}
+	}
+
+	return k.HavePermission(ctx, user, repository, types.BountyDisputePermission)
+}
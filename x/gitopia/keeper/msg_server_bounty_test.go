@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/gitopia/gitopia/app/params"
 	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
 	"github.com/gitopia/gitopia/x/gitopia/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -218,3 +220,573 @@ func TestBountyMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestBountyMsgServerPullRequestSplits(t *testing.T) {
+	srv, context, keepers := setupMsgServerWithKeepers(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	owner, contributor, reviewer, designer := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	for _, user := range []string{owner, contributor, reviewer, designer} {
+		_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: user, Username: user})
+		assert.NoError(t, err)
+	}
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	assert.NoError(t, err)
+	testutil.FundAccount(keepers.BankKeeper, ctx, ownerAddr, sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(1000))))
+
+	repositoryId := types.RepositoryId{Id: owner, Name: "repository"}
+	_, err = srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: owner, Name: repositoryId.Name, Owner: owner})
+	assert.NoError(t, err)
+	headSha := strings.Repeat("a", 40)
+	for _, branch := range []string{"head", "base"} {
+		_, err = srv.SetBranch(ctx, &types.MsgSetBranch{Creator: owner, RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: branch, Sha: headSha}})
+		assert.NoError(t, err)
+	}
+	_, err = srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: owner, RepositoryId: repositoryId, User: contributor, Role: "WRITE"})
+	assert.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: contributor, Title: "title", HeadRepositoryId: repositoryId, HeadBranch: "head", BaseRepositoryId: repositoryId, BaseBranch: "base"})
+	assert.NoError(t, err)
+
+	bRes, err := srv.CreateBounty(ctx, &types.MsgCreateBounty{
+		Creator:   owner,
+		Amount:    []sdk.Coin{{Denom: params.BaseCoinUnit, Amount: sdk.NewInt(1000)}},
+		Expiry:    time.Now().Add(time.Hour * 24).Unix(),
+		ParentIid: 1,
+		Parent:    types.BountyParentPullRequest,
+	})
+	assert.NoError(t, err)
+
+	pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(ctx, 0, 1)
+	assert.True(t, found)
+	assert.Equal(t, []uint64{bRes.Id}, pullRequest.Bounties)
+
+	splits := []*types.BountySplit{
+		{Address: reviewer, Percentage: 30},
+		{Address: designer, Amount: sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(100)))},
+	}
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSetBountySplits
+		err     error
+	}{
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgSetBountySplits{Creator: owner, Id: 10, Splits: splits},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgSetBountySplits{Creator: contributor, Id: bRes.Id, Splits: splits},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "Exceeds Amount",
+			request: &types.MsgSetBountySplits{Creator: owner, Id: bRes.Id, Splits: []*types.BountySplit{
+				{Address: reviewer, Percentage: 100},
+				{Address: designer, Amount: sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(1)))},
+			}},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Success",
+			request: &types.MsgSetBountySplits{Creator: owner, Id: bRes.Id, Splits: splits},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SetBountySplits(ctx, tc.request)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("Close With Open PullRequest", func(t *testing.T) {
+		_, err := srv.CloseBounty(ctx, &types.MsgCloseBounty{Creator: owner, Id: bRes.Id})
+		assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Paid On Merge", func(t *testing.T) {
+		_, err := srv.InvokeMergePullRequest(ctx, &types.MsgInvokeMergePullRequest{Creator: owner, RepositoryId: 0, Iid: 1, Provider: owner})
+		assert.NoError(t, err)
+		_, err = srv.SetPullRequestState(ctx, &types.MsgSetPullRequestState{Creator: owner, RepositoryId: 0, Iid: 1, State: "MERGED", MergeCommitSha: headSha, TaskId: 0})
+		assert.NoError(t, err)
+
+		balance := func(address string) math.Int {
+			accAddress, err := sdk.AccAddressFromBech32(address)
+			assert.NoError(t, err)
+			return keepers.BankKeeper.GetBalance(ctx, accAddress, params.BaseCoinUnit).Amount
+		}
+		assert.Equal(t, math.NewInt(300), balance(reviewer))
+		assert.Equal(t, math.NewInt(100), balance(designer))
+		assert.Equal(t, math.NewInt(600), balance(contributor))
+
+		bounty, found := keepers.GitopiaKeeper.GetBounty(ctx, bRes.Id)
+		assert.True(t, found)
+		assert.Equal(t, types.BountyStateDESTCREDITED, bounty.State)
+		assert.Equal(t, contributor, bounty.RewardedTo)
+		assert.Equal(t, sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(300))), bounty.Splits[0].Rewarded)
+		assert.Equal(t, sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(100))), bounty.Splits[1].Rewarded)
+	})
+}
+
+func TestBountyMsgServerIssueAssignees(t *testing.T) {
+	srv, context, keepers := setupMsgServerWithKeepers(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	owner, contributor, reviewer := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	for _, user := range []string{owner, contributor, reviewer} {
+		_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: user, Username: user})
+		assert.NoError(t, err)
+	}
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	assert.NoError(t, err)
+	testutil.FundAccount(keepers.BankKeeper, ctx, ownerAddr, sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(2000))))
+
+	repositoryId := types.RepositoryId{Id: owner, Name: "repository"}
+	_, err = srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: owner, Name: repositoryId.Name, Owner: owner})
+	assert.NoError(t, err)
+	headSha := strings.Repeat("a", 40)
+	for _, branch := range []string{"head", "base"} {
+		_, err = srv.SetBranch(ctx, &types.MsgSetBranch{Creator: owner, RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: branch, Sha: headSha}})
+		assert.NoError(t, err)
+	}
+	_, err = srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: owner, RepositoryId: repositoryId, User: contributor, Role: "WRITE"})
+	assert.NoError(t, err)
+
+	iRes, err := srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: owner, RepositoryId: repositoryId, Title: "issue"})
+	assert.NoError(t, err)
+	_, err = srv.AddIssueAssignees(ctx, &types.MsgAddIssueAssignees{Creator: owner, RepositoryId: 0, Iid: iRes.Iid, Assignees: []string{contributor, reviewer}})
+	assert.NoError(t, err)
+
+	bRes, err := srv.CreateBounty(ctx, &types.MsgCreateBounty{
+		Creator:   owner,
+		Amount:    []sdk.Coin{{Denom: params.BaseCoinUnit, Amount: sdk.NewInt(1000)}},
+		Expiry:    time.Now().Add(time.Hour * 24).Unix(),
+		ParentIid: iRes.Iid,
+	})
+	assert.NoError(t, err)
+
+	// a bounty which can't be paid out doesn't block the merge
+	unpayable, err := srv.CreateBounty(ctx, &types.MsgCreateBounty{
+		Creator:   owner,
+		Amount:    []sdk.Coin{{Denom: params.BaseCoinUnit, Amount: sdk.NewInt(1000)}},
+		Expiry:    time.Now().Add(time.Hour * 24).Unix(),
+		ParentIid: iRes.Iid,
+	})
+	assert.NoError(t, err)
+	bounty, found := keepers.GitopiaKeeper.GetBounty(ctx, unpayable.Id)
+	assert.True(t, found)
+	bounty.Splits = []*types.BountySplit{{Address: reviewer, Amount: sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(2000)))}}
+	keepers.GitopiaKeeper.SetBounty(ctx, bounty)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: contributor, Title: "title", HeadRepositoryId: repositoryId, HeadBranch: "head", BaseRepositoryId: repositoryId, BaseBranch: "base", IssueIids: []uint64{iRes.Iid}})
+	assert.NoError(t, err)
+	_, err = srv.InvokeMergePullRequest(ctx, &types.MsgInvokeMergePullRequest{Creator: owner, RepositoryId: 0, Iid: 1, Provider: owner})
+	assert.NoError(t, err)
+	_, err = srv.SetPullRequestState(ctx, &types.MsgSetPullRequestState{Creator: owner, RepositoryId: 0, Iid: 1, State: "MERGED", MergeCommitSha: headSha, TaskId: 0})
+	assert.NoError(t, err)
+
+	balance := func(address string) math.Int {
+		accAddress, err := sdk.AccAddressFromBech32(address)
+		assert.NoError(t, err)
+		return keepers.BankKeeper.GetBalance(ctx, accAddress, params.BaseCoinUnit).Amount
+	}
+	assert.Equal(t, math.NewInt(500), balance(contributor))
+	assert.Equal(t, math.NewInt(500), balance(reviewer))
+
+	bounty, found = keepers.GitopiaKeeper.GetBounty(ctx, bRes.Id)
+	assert.True(t, found)
+	assert.Equal(t, types.BountyStateDESTCREDITED, bounty.State)
+	assert.Equal(t, contributor, bounty.RewardedTo)
+
+	bounty, found = keepers.GitopiaKeeper.GetBounty(ctx, unpayable.Id)
+	assert.True(t, found)
+	assert.Equal(t, types.BountyStateSRCDEBITTED, bounty.State)
+
+	var failed bool
+	for _, event := range ctx.EventManager().Events() {
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == sdk.AttributeKeyAction && string(attribute.Value) == types.RewardBountyFailedEventKey {
+				failed = true
+			}
+		}
+	}
+	assert.True(t, failed)
+}
+
+func TestAssigneeBountySplits(t *testing.T) {
+	var assignees []string
+	for i := 0; i < 15; i++ {
+		assignees = append(assignees, sample.AccAddress())
+	}
+	recipient := assignees[3]
+
+	splits := keeper.AssigneeBountySplits(assignees, recipient)
+	assert.Len(t, splits, types.MaxBountySplits)
+	assert.NoError(t, types.ValidateBountySplits(splits))
+	for _, split := range splits {
+		assert.NotEqual(t, recipient, split.Address)
+		assert.Equal(t, uint64(100/(types.MaxBountySplits+1)), split.Percentage)
+	}
+
+	splits = keeper.AssigneeBountySplits(assignees[:2], recipient)
+	assert.Len(t, splits, 2)
+	assert.Equal(t, uint64(33), splits[0].Percentage)
+}
+
+
+
+func TestBountyMsgServerFund(t *testing.T) {
+	srv, context, keepers := setupMsgServerWithKeepers(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	owner, funder := sample.AccAddress(), sample.AccAddress()
+	balance := func(address string) math.Int {
+		accAddress, err := sdk.AccAddressFromBech32(address)
+		assert.NoError(t, err)
+		return keepers.BankKeeper.GetBalance(ctx, accAddress, params.BaseCoinUnit).Amount
+	}
+	for _, user := range []string{owner, funder} {
+		_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: user, Username: user})
+		assert.NoError(t, err)
+		userAddr, err := sdk.AccAddressFromBech32(user)
+		assert.NoError(t, err)
+		testutil.FundAccount(keepers.BankKeeper, ctx, userAddr, sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(1000))))
+	}
+
+	rRes, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: owner, Name: "repository", Owner: owner})
+	assert.NoError(t, err)
+	iRes, err := srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: owner, RepositoryId: rRes.RepositoryId, Title: "issue"})
+	assert.NoError(t, err)
+	bRes, err := srv.CreateBounty(ctx, &types.MsgCreateBounty{
+		Creator:   owner,
+		Amount:    []sdk.Coin{{Denom: params.BaseCoinUnit, Amount: sdk.NewInt(300)}},
+		Expiry:    time.Now().Add(time.Hour * 24).Unix(),
+		ParentIid: iRes.Iid,
+	})
+	assert.NoError(t, err)
+
+	amount := sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(100)))
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgFundBounty
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgFundBounty{Creator: sample.AccAddress(), Id: bRes.Id, Amount: amount},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgFundBounty{Creator: funder, Id: 10, Amount: amount},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Success",
+			request: &types.MsgFundBounty{Creator: funder, Id: bRes.Id, Amount: amount},
+		},
+		{
+			desc:    "Fund Again",
+			request: &types.MsgFundBounty{Creator: funder, Id: bRes.Id, Amount: amount},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.FundBounty(ctx, tc.request)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("Funders", func(t *testing.T) {
+		bounty, found := keepers.GitopiaKeeper.GetBounty(ctx, bRes.Id)
+		assert.True(t, found)
+		assert.Equal(t, sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(500))), bounty.Amount)
+
+		res, err := keepers.GitopiaKeeper.BountyFunderAll(ctx, &types.QueryAllBountyFunderRequest{Id: bRes.Id})
+		assert.NoError(t, err)
+		assert.Len(t, res.BountyFunder, 2)
+		for _, bountyFunder := range res.BountyFunder {
+			if bountyFunder.Address == funder {
+				assert.Equal(t, sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(200))), bountyFunder.Amount)
+			} else {
+				assert.Equal(t, owner, bountyFunder.Address)
+				assert.Equal(t, sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(300))), bountyFunder.Amount)
+			}
+		}
+	})
+	t.Run("Refunded Pro Rata", func(t *testing.T) {
+		_, err := srv.CloseBounty(ctx, &types.MsgCloseBounty{Creator: owner, Id: bRes.Id})
+		assert.NoError(t, err)
+		assert.Equal(t, math.NewInt(1000), balance(owner))
+		assert.Equal(t, math.NewInt(1000), balance(funder))
+
+		_, err = srv.FundBounty(ctx, &types.MsgFundBounty{Creator: funder, Id: bRes.Id, Amount: amount})
+		assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Refund Without Creator Record", func(t *testing.T) {
+		// bounties created before funders were recorded have no funder records
+		bRes, err := srv.CreateBounty(ctx, &types.MsgCreateBounty{
+			Creator:   owner,
+			Amount:    []sdk.Coin{{Denom: params.BaseCoinUnit, Amount: sdk.NewInt(100)}},
+			Expiry:    time.Now().Add(time.Hour * 24).Unix(),
+			ParentIid: iRes.Iid,
+		})
+		assert.NoError(t, err)
+		keepers.GitopiaKeeper.RemoveAllBountyFunderForBounty(ctx, bRes.Id)
+
+		_, err = srv.FundBounty(ctx, &types.MsgFundBounty{Creator: funder, Id: bRes.Id, Amount: sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(10)))})
+		assert.NoError(t, err)
+
+		_, err = srv.CloseBounty(ctx, &types.MsgCloseBounty{Creator: owner, Id: bRes.Id})
+		assert.NoError(t, err)
+		assert.Equal(t, math.NewInt(1000), balance(owner))
+		assert.Equal(t, math.NewInt(1000), balance(funder))
+	})
+}
+
+func TestBountyMsgServerDispute(t *testing.T) {
+	srv, context, keepers := setupMsgServerWithKeepers(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	owner, contributor, reviewer, stranger, arbiter := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	for _, user := range []string{owner, contributor, reviewer, stranger} {
+		_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: user, Username: user})
+		assert.NoError(t, err)
+	}
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	assert.NoError(t, err)
+	testutil.FundAccount(keepers.BankKeeper, ctx, ownerAddr, sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(3000))))
+
+	gParams := types.DefaultParams()
+	gParams.BountyArbiter = arbiter
+	keepers.GitopiaKeeper.SetParams(ctx, gParams)
+
+	repositoryId := types.RepositoryId{Id: owner, Name: "repository"}
+	_, err = srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: owner, Name: repositoryId.Name, Owner: owner})
+	assert.NoError(t, err)
+	headSha := strings.Repeat("a", 40)
+	for _, branch := range []string{"head", "base"} {
+		_, err = srv.SetBranch(ctx, &types.MsgSetBranch{Creator: owner, RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: branch, Sha: headSha}})
+		assert.NoError(t, err)
+	}
+	_, err = srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: owner, RepositoryId: repositoryId, User: contributor, Role: "WRITE"})
+	assert.NoError(t, err)
+	_, err = srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: owner, RepositoryId: repositoryId, User: reviewer, Role: "MAINTAIN"})
+	assert.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: contributor, Title: "title", HeadRepositoryId: repositoryId, HeadBranch: "head", BaseRepositoryId: repositoryId, BaseBranch: "base"})
+	assert.NoError(t, err)
+
+	var bountyIds []uint64
+	for i := 0; i < 3; i++ {
+		bRes, err := srv.CreateBounty(ctx, &types.MsgCreateBounty{
+			Creator:   owner,
+			Amount:    []sdk.Coin{{Denom: params.BaseCoinUnit, Amount: sdk.NewInt(1000)}},
+			Expiry:    time.Now().Add(time.Hour * 24).Unix(),
+			ParentIid: 1,
+			Parent:    types.BountyParentPullRequest,
+		})
+		assert.NoError(t, err)
+		bountyIds = append(bountyIds, bRes.Id)
+	}
+
+	balance := func(address string) math.Int {
+		accAddress, err := sdk.AccAddressFromBech32(address)
+		assert.NoError(t, err)
+		return keepers.BankKeeper.GetBalance(ctx, accAddress, params.BaseCoinUnit).Amount
+	}
+
+	t.Run("Not Awarded", func(t *testing.T) {
+		_, err := srv.DisputeBounty(ctx, &types.MsgDisputeBounty{Creator: reviewer, Id: bountyIds[0], Reason: "reason"})
+		assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Locked On Merge", func(t *testing.T) {
+		_, err := srv.InvokeMergePullRequest(ctx, &types.MsgInvokeMergePullRequest{Creator: owner, RepositoryId: 0, Iid: 1, Provider: owner})
+		assert.NoError(t, err)
+		_, err = srv.SetPullRequestState(ctx, &types.MsgSetPullRequestState{Creator: owner, RepositoryId: 0, Iid: 1, State: "MERGED", MergeCommitSha: headSha, TaskId: 0})
+		assert.NoError(t, err)
+
+		assert.True(t, balance(contributor).IsZero())
+		for _, id := range bountyIds {
+			bounty, found := keepers.GitopiaKeeper.GetBounty(ctx, id)
+			assert.True(t, found)
+			assert.Equal(t, types.BountyStateAWARDED, bounty.State)
+			assert.Equal(t, contributor, bounty.RewardedTo)
+			assert.Equal(t, ctx.BlockTime().Unix()+gParams.BountyDisputeWindow, bounty.ReleaseAt)
+		}
+	})
+	t.Run("Dispute Unauthorized", func(t *testing.T) {
+		_, err := srv.DisputeBounty(ctx, &types.MsgDisputeBounty{Creator: stranger, Id: bountyIds[0], Reason: "reason"})
+		assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Dispute", func(t *testing.T) {
+		for _, id := range bountyIds[:2] {
+			_, err := srv.DisputeBounty(ctx, &types.MsgDisputeBounty{Creator: reviewer, Id: id, Reason: "reason"})
+			assert.NoError(t, err)
+
+			bounty, found := keepers.GitopiaKeeper.GetBounty(ctx, id)
+			assert.True(t, found)
+			assert.Equal(t, types.BountyStateDISPUTED, bounty.State)
+			assert.Equal(t, reviewer, bounty.Dispute.Creator)
+		}
+	})
+	t.Run("Resolve Unauthorized", func(t *testing.T) {
+		_, err := srv.ResolveBountyDispute(ctx, &types.MsgResolveBountyDispute{Creator: owner, Id: bountyIds[0], Recipient: reviewer})
+		assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Resolve Not Disputed", func(t *testing.T) {
+		_, err := srv.ResolveBountyDispute(ctx, &types.MsgResolveBountyDispute{Creator: arbiter, Id: bountyIds[2], Recipient: reviewer})
+		assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Resolve To Recipient", func(t *testing.T) {
+		_, err := srv.ResolveBountyDispute(ctx, &types.MsgResolveBountyDispute{Creator: arbiter, Id: bountyIds[0], Recipient: reviewer})
+		assert.NoError(t, err)
+		assert.Equal(t, math.NewInt(1000), balance(reviewer))
+
+		bounty, found := keepers.GitopiaKeeper.GetBounty(ctx, bountyIds[0])
+		assert.True(t, found)
+		assert.Equal(t, types.BountyStateDESTCREDITED, bounty.State)
+		assert.Equal(t, reviewer, bounty.RewardedTo)
+		assert.Equal(t, arbiter, bounty.Dispute.ResolvedBy)
+	})
+	t.Run("Resolve Refund Without Arbiter", func(t *testing.T) {
+		authority := keepers.GitopiaKeeper.GetAuthority()
+		_, err := srv.UpdateBountyArbiter(ctx, &types.MsgUpdateBountyArbiter{Authority: authority, DisputeWindow: gParams.BountyDisputeWindow})
+		assert.NoError(t, err)
+
+		_, err = srv.ResolveBountyDispute(ctx, &types.MsgResolveBountyDispute{Creator: arbiter, Id: bountyIds[1]})
+		assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+		// governance resolves the disputes left over by the arbiter
+		_, err = srv.ResolveBountyDispute(ctx, &types.MsgResolveBountyDispute{Creator: authority, Id: bountyIds[1]})
+		assert.NoError(t, err)
+		assert.Equal(t, math.NewInt(1000), balance(owner))
+
+		bounty, found := keepers.GitopiaKeeper.GetBounty(ctx, bountyIds[1])
+		assert.True(t, found)
+		assert.Equal(t, types.BountyStateREVERTEDBACK, bounty.State)
+	})
+	t.Run("Released After Window", func(t *testing.T) {
+		bounty, found := keepers.GitopiaKeeper.GetBounty(ctx, bountyIds[2])
+		assert.True(t, found)
+
+		keepers.GitopiaKeeper.ReleaseAwardedBounties(ctx.WithBlockTime(time.Unix(bounty.ReleaseAt-1, 0)))
+		assert.True(t, balance(contributor).IsZero())
+
+		windowEndCtx := ctx.WithBlockTime(time.Unix(bounty.ReleaseAt, 0))
+		_, err := srv.DisputeBounty(windowEndCtx, &types.MsgDisputeBounty{Creator: reviewer, Id: bountyIds[2], Reason: "reason"})
+		assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+		keepers.GitopiaKeeper.ReleaseAwardedBounties(windowEndCtx)
+		assert.Equal(t, math.NewInt(1000), balance(contributor))
+
+		bounty, found = keepers.GitopiaKeeper.GetBounty(ctx, bountyIds[2])
+		assert.True(t, found)
+		assert.Equal(t, types.BountyStateDESTCREDITED, bounty.State)
+	})
+}
+
+func TestBountyMsgServerAward(t *testing.T) {
+	srv, context, keepers := setupMsgServerWithKeepers(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	owner, maintainer, designer, stranger := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	for _, user := range []string{owner, maintainer, designer, stranger} {
+		_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: user, Username: user})
+		assert.NoError(t, err)
+	}
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	assert.NoError(t, err)
+	testutil.FundAccount(keepers.BankKeeper, ctx, ownerAddr, sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, math.NewInt(1000))))
+
+	repositoryId := types.RepositoryId{Id: owner, Name: "repository"}
+	rRes, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: owner, Name: repositoryId.Name, Owner: owner})
+	assert.NoError(t, err)
+	_, err = srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: owner, RepositoryId: repositoryId, User: maintainer, Role: "WRITE"})
+	assert.NoError(t, err)
+	_, err = srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: owner, RepositoryId: rRes.RepositoryId, Title: "issue"})
+	assert.NoError(t, err)
+
+	bRes, err := srv.CreateBounty(ctx, &types.MsgCreateBounty{
+		Creator:   owner,
+		Amount:    []sdk.Coin{{Denom: params.BaseCoinUnit, Amount: sdk.NewInt(1000)}},
+		Expiry:    time.Now().Add(time.Hour * 24).Unix(),
+		ParentIid: 1,
+		Parent:    types.BountyParentIssue,
+	})
+	assert.NoError(t, err)
+
+	issue, found := keepers.GitopiaKeeper.GetRepositoryIssue(ctx, 0, 1)
+	assert.True(t, found)
+	commentsCount := issue.CommentsCount
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgAwardBounty
+		err     error
+	}{
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgAwardBounty{Creator: maintainer, Id: 10, Recipient: designer},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgAwardBounty{Creator: stranger, Id: bRes.Id, Recipient: designer},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Recipient Not Found",
+			request: &types.MsgAwardBounty{Creator: maintainer, Id: bRes.Id, Recipient: sample.AccAddress()},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Success",
+			request: &types.MsgAwardBounty{Creator: maintainer, Id: bRes.Id, Recipient: designer},
+		},
+		{
+			desc:    "Already Awarded",
+			request: &types.MsgAwardBounty{Creator: maintainer, Id: bRes.Id, Recipient: designer},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.AwardBounty(ctx, tc.request)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	designerAddr, err := sdk.AccAddressFromBech32(designer)
+	assert.NoError(t, err)
+	assert.Equal(t, math.NewInt(1000), keepers.BankKeeper.GetBalance(ctx, designerAddr, params.BaseCoinUnit).Amount)
+
+	bounty, found := keepers.GitopiaKeeper.GetBounty(ctx, bRes.Id)
+	assert.True(t, found)
+	assert.Equal(t, types.BountyStateDESTCREDITED, bounty.State)
+	assert.Equal(t, designer, bounty.RewardedTo)
+
+	issue, found = keepers.GitopiaKeeper.GetRepositoryIssue(ctx, 0, 1)
+	assert.True(t, found)
+	assert.Equal(t, commentsCount+1, issue.CommentsCount)
+}
+
+func TestBountyMsgServerUpdateArbiter(t *testing.T) {
+	srv, context, keepers := setupMsgServerWithKeepers(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	arbiter := sample.AccAddress()
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		_, err := srv.UpdateBountyArbiter(ctx, &types.MsgUpdateBountyArbiter{Authority: sample.AccAddress(), Arbiter: arbiter})
+		assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Completed", func(t *testing.T) {
+		_, err := srv.UpdateBountyArbiter(ctx, &types.MsgUpdateBountyArbiter{Authority: keepers.GitopiaKeeper.GetAuthority(), Arbiter: arbiter, DisputeWindow: 60})
+		assert.NoError(t, err)
+		assert.Equal(t, arbiter, keepers.GitopiaKeeper.GetParams(ctx).BountyArbiter)
+		assert.Equal(t, int64(60), keepers.GitopiaKeeper.GetParams(ctx).BountyDisputeWindow)
+	})
+}
@@ -29,10 +29,18 @@ func (k msgServer) SetBranch(goCtx context.Context, msg *types.MsgSetBranch) (*t
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.PushBranchPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
 
+	if err := k.CheckBranchPushAllowed(ctx, msg.Creator, repository, msg.Branch.Name); err != nil {
+		return nil, err
+	}
+
 	// Set default branch if this is the first branch
 	if len(k.GetAllRepositoryBranch(ctx, repository.Id)) == 0 {
 		repository.DefaultBranch = msg.Branch.Name
@@ -99,10 +107,20 @@ func (k msgServer) MultiSetBranch(goCtx context.Context, msg *types.MsgMultiSetB
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.PushBranchPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
 
+	for _, branch := range msg.Branches {
+		if err := k.CheckBranchPushAllowed(ctx, msg.Creator, repository, branch.Name); err != nil {
+			return nil, err
+		}
+	}
+
 	// Set default branch if this is the first branch
 	if len(k.GetAllRepositoryBranch(ctx, repository.Id)) == 0 {
 		repository.DefaultBranch = msg.Branches[0].Name
@@ -174,6 +192,10 @@ func (k msgServer) SetDefaultBranch(goCtx context.Context, msg *types.MsgSetDefa
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.PushBranchPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
@@ -227,10 +249,18 @@ func (k msgServer) DeleteBranch(goCtx context.Context, msg *types.MsgDeleteBranc
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.PushBranchPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
 
+	if err := k.CheckBranchDeleteAllowed(ctx, msg.Creator, repository, msg.Branch); err != nil {
+		return nil, err
+	}
+
 	branch, found := k.GetRepositoryBranch(ctx, repository.Id, msg.Branch)
 	if found {
 		k.RemoveRepositoryBranch(ctx, repository.Id, msg.Branch)
@@ -280,10 +310,20 @@ func (k msgServer) MultiDeleteBranch(goCtx context.Context, msg *types.MsgMultiD
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.PushBranchPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
 
+	for _, branch := range msg.Branches {
+		if err := k.CheckBranchDeleteAllowed(ctx, msg.Creator, repository, branch); err != nil {
+			return nil, err
+		}
+	}
+
 	/* Check if all branch exists */
 	var deletedBranches []types.Branch
 	for _, branch := range msg.Branches {
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+func (k msgServer) CreateBranchProtectionRule(goCtx context.Context, msg *types.MsgCreateBranchProtectionRule) (*types.MsgCreateBranchProtectionRuleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.BranchProtectionRulePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if _, exists := utils.BranchProtectionRulePatternExists(repository.BranchProtectionRules, msg.Pattern); exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("branch protection rule (%v) already exists", msg.Pattern))
+	}
+
+	repository.BranchProtectionRulesCount += 1
+	var rule = types.BranchProtectionRule{
+		Id:                            repository.BranchProtectionRulesCount,
+		Pattern:                       msg.Pattern,
+		RequiredApprovals:             msg.RequiredApprovals,
+		RequiredStatusChecks:          msg.RequiredStatusChecks,
+		AllowedPushers:                msg.AllowedPushers,
+		RequireLinearHistory:          msg.RequireLinearHistory,
+		PreventDeletion:               msg.PreventDeletion,
+		RequireCodeOwnerReview:        msg.RequireCodeOwnerReview,
+		RequireConversationResolution: msg.RequireConversationResolution,
+		CreatedAt:                     ctx.BlockTime().Unix(),
+		UpdatedAt:                     ctx.BlockTime().Unix(),
+	}
+
+	repository.BranchProtectionRules = append(repository.BranchProtectionRules, &rule)
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+
+	k.SetRepository(ctx, repository)
+
+	ruleJson, _ := json.Marshal(rule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.CreateBranchProtectionRuleEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeBranchProtectionRuleKey, string(ruleJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgCreateBranchProtectionRuleResponse{Id: rule.Id}, nil
+}
+
+func (k msgServer) UpdateBranchProtectionRule(goCtx context.Context, msg *types.MsgUpdateBranchProtectionRule) (*types.MsgUpdateBranchProtectionRuleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.BranchProtectionRulePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	i, exists := utils.BranchProtectionRuleIdExists(repository.BranchProtectionRules, msg.RuleId)
+	if !exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("branch protection rule (%d) doesn't exist", msg.RuleId))
+	}
+	if j, exists := utils.BranchProtectionRulePatternExists(repository.BranchProtectionRules, msg.Pattern); exists && i != j {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("branch protection rule (%v) already exists", msg.Pattern))
+	}
+
+	rule := repository.BranchProtectionRules[i]
+	rule.Pattern = msg.Pattern
+	rule.RequiredApprovals = msg.RequiredApprovals
+	rule.RequiredStatusChecks = msg.RequiredStatusChecks
+	rule.AllowedPushers = msg.AllowedPushers
+	rule.RequireLinearHistory = msg.RequireLinearHistory
+	rule.PreventDeletion = msg.PreventDeletion
+	rule.RequireCodeOwnerReview = msg.RequireCodeOwnerReview
+	rule.RequireConversationResolution = msg.RequireConversationResolution
+	rule.UpdatedAt = ctx.BlockTime().Unix()
+
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetRepository(ctx, repository)
+
+	ruleJson, _ := json.Marshal(rule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UpdateBranchProtectionRuleEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeBranchProtectionRuleKey, string(ruleJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgUpdateBranchProtectionRuleResponse{}, nil
+}
+
+func (k msgServer) DeleteBranchProtectionRule(goCtx context.Context, msg *types.MsgDeleteBranchProtectionRule) (*types.MsgDeleteBranchProtectionRuleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.BranchProtectionRulePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	i, exists := utils.BranchProtectionRuleIdExists(repository.BranchProtectionRules, msg.RuleId)
+	if !exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("branch protection rule (%d) doesn't exist", msg.RuleId))
+	}
+
+	rule := repository.BranchProtectionRules[i]
+	repository.BranchProtectionRules = append(repository.BranchProtectionRules[:i], repository.BranchProtectionRules[i+1:]...)
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetRepository(ctx, repository)
+
+	ruleJson, _ := json.Marshal(rule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.DeleteBranchProtectionRuleEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeBranchProtectionRuleKey, string(ruleJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgDeleteBranchProtectionRuleResponse{}, nil
+}
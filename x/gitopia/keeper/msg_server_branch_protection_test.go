@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func TestBranchProtectionRuleMsgServerCreate(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+	users := setupPreRepository(ctx, t, srv)
+	repositoryId := types.RepositoryId{
+		Id:   users[0],
+		Name: "repository",
+	}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: repositoryId.Id, Name: repositoryId.Name, Owner: repositoryId.Id})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgCreateBranchProtectionRule
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgCreateBranchProtectionRule{Creator: "X", RepositoryId: repositoryId, Pattern: "main"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Repository Not Exists",
+			request: &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: types.RepositoryId{Id: users[0], Name: "name"}, Pattern: "main"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgCreateBranchProtectionRule{Creator: users[1], RepositoryId: repositoryId, Pattern: "main"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: "main", RequiredApprovals: 1},
+		},
+		{
+			desc:    "Pattern Exists",
+			request: &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: "main"},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.CreateBranchProtectionRule(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBranchProtectionRuleMsgServerUpdate(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+	users := setupPreRepository(ctx, t, srv)
+	repositoryId := types.RepositoryId{
+		Id:   users[0],
+		Name: "repository",
+	}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: repositoryId.Id, Name: repositoryId.Name, Owner: repositoryId.Id})
+	require.NoError(t, err)
+	_, err = srv.CreateBranchProtectionRule(ctx, &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: "main"})
+	require.NoError(t, err)
+	_, err = srv.CreateBranchProtectionRule(ctx, &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: "release/*"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgUpdateBranchProtectionRule
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgUpdateBranchProtectionRule{Creator: "X", RepositoryId: repositoryId, RuleId: 1, Pattern: "main"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgUpdateBranchProtectionRule{Creator: users[1], RepositoryId: repositoryId, RuleId: 1, Pattern: "main"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Rule Not Exists",
+			request: &types.MsgUpdateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, RuleId: 10, Pattern: "main"},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Pattern Exists",
+			request: &types.MsgUpdateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, RuleId: 1, Pattern: "release/*"},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgUpdateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, RuleId: 1, Pattern: "main", PreventDeletion: true},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.UpdateBranchProtectionRule(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBranchProtectionRuleMsgServerDelete(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+	users := setupPreRepository(ctx, t, srv)
+	repositoryId := types.RepositoryId{
+		Id:   users[0],
+		Name: "repository",
+	}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: repositoryId.Id, Name: repositoryId.Name, Owner: repositoryId.Id})
+	require.NoError(t, err)
+	_, err = srv.CreateBranchProtectionRule(ctx, &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: "main"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgDeleteBranchProtectionRule
+		err     error
+	}{
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgDeleteBranchProtectionRule{Creator: users[1], RepositoryId: repositoryId, RuleId: 1},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgDeleteBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, RuleId: 1},
+		},
+		{
+			desc:    "Rule Not Exists",
+			request: &types.MsgDeleteBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, RuleId: 1},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.DeleteBranchProtectionRule(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBranchProtectionRuleEnforcement(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+	users := setupPreRepository(ctx, t, srv)
+	repositoryId := types.RepositoryId{
+		Id:   users[0],
+		Name: "repository",
+	}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: repositoryId.Id, Name: repositoryId.Name, Owner: repositoryId.Id})
+	require.NoError(t, err)
+	_, err = srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: users[0], RepositoryId: repositoryId, User: users[1], Role: "WRITE"})
+	require.NoError(t, err)
+	_, err = srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: users[0], RepositoryId: repositoryId, User: users[2], Role: "WRITE"})
+	require.NoError(t, err)
+
+	_, err = srv.MultiSetBranch(ctx, &types.MsgMultiSetBranch{Creator: users[1], RepositoryId: repositoryId, Branches: []types.MsgMultiSetBranch_Branch{{Name: "main"}, {Name: "release/v1"}, {Name: "feature"}}})
+	require.NoError(t, err)
+
+	_, err = srv.CreateBranchProtectionRule(ctx, &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: "main", PreventDeletion: true})
+	require.NoError(t, err)
+	_, err = srv.CreateBranchProtectionRule(ctx, &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: "release/*", AllowedPushers: []string{users[2]}})
+	require.NoError(t, err)
+
+	t.Run("Push Unprotected Branch", func(t *testing.T) {
+		_, err := srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[1], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: "feature"}})
+		require.NoError(t, err)
+	})
+	t.Run("Push Protected Branch Without Admin Permission", func(t *testing.T) {
+		_, err := srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[1], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: "main"}})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Push Protected Branch As Owner", func(t *testing.T) {
+		_, err := srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[0], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: "main"}})
+		require.NoError(t, err)
+	})
+	t.Run("Push Protected Branch Not In Allowed Pushers", func(t *testing.T) {
+		_, err := srv.MultiSetBranch(ctx, &types.MsgMultiSetBranch{Creator: users[0], RepositoryId: repositoryId, Branches: []types.MsgMultiSetBranch_Branch{{Name: "feature"}, {Name: "release/v1"}}})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Push Protected Branch In Allowed Pushers", func(t *testing.T) {
+		_, err := srv.MultiSetBranch(ctx, &types.MsgMultiSetBranch{Creator: users[2], RepositoryId: repositoryId, Branches: []types.MsgMultiSetBranch_Branch{{Name: "feature"}, {Name: "release/v2"}}})
+		require.NoError(t, err)
+	})
+	t.Run("Delete Branch Prevented", func(t *testing.T) {
+		_, err := srv.DeleteBranch(ctx, &types.MsgDeleteBranch{Creator: users[0], RepositoryId: repositoryId, Branch: "main"})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Delete Protected Branch Not In Allowed Pushers", func(t *testing.T) {
+		_, err := srv.MultiDeleteBranch(ctx, &types.MsgMultiDeleteBranch{Creator: users[1], RepositoryId: repositoryId, Branches: []string{"feature", "release/v1"}})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Delete Protected Branch In Allowed Pushers", func(t *testing.T) {
+		_, err := srv.DeleteBranch(ctx, &types.MsgDeleteBranch{Creator: users[2], RepositoryId: repositoryId, Branch: "release/v2"})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func TestRepositoryMsgServerUpdateCodeOwners(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+
+	users, repositoryId, _ := setupPrePullRequest(ctx, t, srv)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgUpdateRepositoryCodeOwners
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgUpdateRepositoryCodeOwners{Creator: "C", RepositoryId: repositoryId, CodeOwners: []*types.CodeOwnerRule{{Pattern: "*", Owners: []string{users[1]}}}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Repository Not Exists",
+			request: &types.MsgUpdateRepositoryCodeOwners{Creator: users[0], RepositoryId: types.RepositoryId{Id: users[0], Name: "name"}, CodeOwners: []*types.CodeOwnerRule{{Pattern: "*", Owners: []string{users[1]}}}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgUpdateRepositoryCodeOwners{Creator: users[1], RepositoryId: repositoryId, CodeOwners: []*types.CodeOwnerRule{{Pattern: "*", Owners: []string{users[1]}}}},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Owner Not Exists",
+			request: &types.MsgUpdateRepositoryCodeOwners{Creator: users[0], RepositoryId: repositoryId, CodeOwners: []*types.CodeOwnerRule{{Pattern: "*", Owners: []string{"C"}}}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgUpdateRepositoryCodeOwners{Creator: users[0], RepositoryId: repositoryId, CodeOwners: []*types.CodeOwnerRule{{Pattern: "*", Owners: []string{users[1]}}}},
+		},
+		{
+			desc:    "Clear",
+			request: &types.MsgUpdateRepositoryCodeOwners{Creator: users[0], RepositoryId: repositoryId},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.UpdateRepositoryCodeOwners(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPullRequestMsgServerSetChangedPaths(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: "C", Username: "C"})
+	require.NoError(t, err)
+	gitServer := sample.AccAddress()
+	gParams := types.DefaultParams()
+	gParams.GitServer = gitServer
+	keepers.GitopiaKeeper.SetParams(sdkCtx, gParams)
+	_, err = srv.UpdateRepositoryCodeOwners(ctx, &types.MsgUpdateRepositoryCodeOwners{Creator: users[0], RepositoryId: repositoryId, CodeOwners: []*types.CodeOwnerRule{
+		{Pattern: "*", Owners: []string{users[0]}},
+		{Pattern: "docs/", Owners: []string{users[1]}},
+	}})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSetPullRequestChangedPaths
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgSetPullRequestChangedPaths{Creator: "X", RepositoryId: 0, Iid: 1, ChangedPaths: []string{"docs/README.md"}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "PullRequest Not Exists",
+			request: &types.MsgSetPullRequestChangedPaths{Creator: gitServer, RepositoryId: 0, Iid: 10, ChangedPaths: []string{"docs/README.md"}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgSetPullRequestChangedPaths{Creator: "C", RepositoryId: 0, Iid: 1, ChangedPaths: []string{"docs/README.md"}},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Author",
+			request: &types.MsgSetPullRequestChangedPaths{Creator: users[0], RepositoryId: 0, Iid: 1},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgSetPullRequestChangedPaths{Creator: gitServer, RepositoryId: 0, Iid: 1, ChangedPaths: []string{"main.go", "docs/README.md"}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SetPullRequestChangedPaths(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("Code Owners Requested", func(t *testing.T) {
+		pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(sdkCtx, 0, 1)
+		require.True(t, found)
+		require.Equal(t, []string{"main.go", "docs/README.md"}, pullRequest.ChangedPaths)
+		// the author is never requested for review
+		require.Equal(t, []string{users[1]}, pullRequest.Reviewers)
+		require.Equal(t, uint64(1), pullRequest.CommentsCount)
+
+		comment, found := keepers.GitopiaKeeper.GetPullRequestComment(sdkCtx, 0, 1, 1)
+		require.True(t, found)
+		require.Equal(t, types.CommentTypeAddReviewers, comment.CommentType)
+	})
+	t.Run("Reviewers Not Duplicated", func(t *testing.T) {
+		_, err := srv.SetPullRequestChangedPaths(ctx, &types.MsgSetPullRequestChangedPaths{Creator: gitServer, RepositoryId: 0, Iid: 1, ChangedPaths: []string{"docs/api.md"}})
+		require.NoError(t, err)
+
+		pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(sdkCtx, 0, 1)
+		require.True(t, found)
+		require.Equal(t, []string{users[1]}, pullRequest.Reviewers)
+		require.Equal(t, uint64(1), pullRequest.CommentsCount)
+	})
+}
+
+func TestPullRequestCodeOwnerReviewGating(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: "C", Username: "C"})
+	require.NoError(t, err)
+	for _, user := range []string{users[1], "C"} {
+		_, err = srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: users[0], RepositoryId: repositoryId, User: user, Role: "WRITE"})
+		require.NoError(t, err)
+	}
+	headSha := strings.Repeat("a", 40)
+	_, err = srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[0], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: branches[0], Sha: headSha}})
+	require.NoError(t, err)
+	_, err = srv.UpdateRepositoryCodeOwners(ctx, &types.MsgUpdateRepositoryCodeOwners{Creator: users[0], RepositoryId: repositoryId, CodeOwners: []*types.CodeOwnerRule{{Pattern: "docs/", Owners: []string{users[1]}}}})
+	require.NoError(t, err)
+	_, err = srv.CreateBranchProtectionRule(ctx, &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: branches[1], RequireCodeOwnerReview: true})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+
+	invokeMerge := &types.MsgInvokeMergePullRequest{Creator: users[0], RepositoryId: 0, Iid: 1, Provider: users[0]}
+
+	t.Run("Changed Paths Not Reported", func(t *testing.T) {
+		_, err := srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Missing Code Owner Approval", func(t *testing.T) {
+		_, err := srv.SetPullRequestChangedPaths(ctx, &types.MsgSetPullRequestChangedPaths{Creator: "C", RepositoryId: 0, Iid: 1, ChangedPaths: []string{"main.go", "docs/README.md"}})
+		require.NoError(t, err)
+		_, err = srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Approved By Non Code Owner", func(t *testing.T) {
+		_, err := srv.SubmitPullRequestReview(ctx, &types.MsgSubmitPullRequestReview{Creator: "C", RepositoryId: 0, Iid: 1, State: types.PullRequestReview_APPROVE, CommitSha: headSha})
+		require.NoError(t, err)
+		_, err = srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Approved By Code Owner", func(t *testing.T) {
+		_, err := srv.SubmitPullRequestReview(ctx, &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 1, State: types.PullRequestReview_APPROVE, CommitSha: headSha})
+		require.NoError(t, err)
+		_, err = srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.NoError(t, err)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
 )
 
 func (k msgServer) CreateComment(goCtx context.Context, msg *types.MsgCreateComment) (*types.MsgCreateCommentResponse, error) {
@@ -21,6 +22,7 @@ func (k msgServer) CreateComment(goCtx context.Context, msg *types.MsgCreateComm
 	var issue types.Issue
 	var pullRequest types.PullRequest
 	var found bool
+	var locked bool
 	commentType := types.CommentTypeReply
 
 	if msg.Parent == types.CommentParentIssue {
@@ -28,13 +30,18 @@ func (k msgServer) CreateComment(goCtx context.Context, msg *types.MsgCreateComm
 		if !found {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.ParentIid))
 		}
+		if err := CheckIssueNotTransferred(issue); err != nil {
+			return nil, err
+		}
 		commentIid = issue.CommentsCount + 1
+		locked = issue.Locked
 	} else if msg.Parent == types.CommentParentPullRequest {
 		pullRequest, found = k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.ParentIid)
 		if !found {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.ParentIid))
 		}
 		commentIid = pullRequest.CommentsCount + 1
+		locked = pullRequest.Locked
 		if len(msg.DiffHunk) > 0 {
 			commentType = types.CommentTypeReview
 		}
@@ -42,6 +49,30 @@ func (k msgServer) CreateComment(goCtx context.Context, msg *types.MsgCreateComm
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid parent type %v", msg.Parent))
 	}
 
+	repository, found := k.GetRepositoryById(ctx, msg.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if locked && !k.HavePermission(ctx, msg.Creator, repository, types.LockedConversationCommentPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "conversation is locked, only collaborators can comment")
+	}
+
+	var threadRoot types.Comment
+	if msg.InReplyTo != 0 {
+		threadRoot, found = k.GetPullRequestComment(ctx, msg.RepositoryId, msg.ParentIid, msg.InReplyTo)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("comment (%d) doesn't exist", msg.InReplyTo))
+		}
+		if !IsCommentThreadRoot(threadRoot) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("comment (%d) is not a review comment thread", msg.InReplyTo))
+		}
+	}
+
 	var comment = types.Comment{
 		Creator:      msg.Creator,
 		RepositoryId: msg.RepositoryId,
@@ -56,6 +87,7 @@ func (k msgServer) CreateComment(goCtx context.Context, msg *types.MsgCreateComm
 		CreatedAt:    ctx.BlockTime().Unix(),
 		UpdatedAt:    ctx.BlockTime().Unix(),
 		CommentType:  commentType,
+		InReplyTo:    msg.InReplyTo,
 	}
 
 	id := k.AppendComment(
@@ -72,6 +104,23 @@ func (k msgServer) CreateComment(goCtx context.Context, msg *types.MsgCreateComm
 		k.SetPullRequest(ctx, pullRequest)
 	}
 
+	/* Add the reply to the review comment thread */
+	if comment.InReplyTo != 0 {
+		threadRoot.Replies = append(threadRoot.Replies, comment.CommentIid)
+		k.SetComment(ctx, threadRoot)
+	}
+
+	notified := make(map[string]bool)
+	notification := types.Notification{
+		Actor:        msg.Creator,
+		RepositoryId: comment.RepositoryId,
+		Parent:       comment.Parent,
+		ParentIid:    comment.ParentIid,
+		CommentIid:   comment.CommentIid,
+	}
+	k.Notify(ctx, notified, types.Notification_MENTION, k.GetMentionedUsers(ctx, comment.Body, ""), notification)
+	k.Notify(ctx, notified, types.Notification_SUBSCRIBED, k.GetRepositoryWatchers(ctx, comment.RepositoryId), notification)
+
 	return &types.MsgCreateCommentResponse{
 		Id: id,
 	}, nil
@@ -102,16 +151,38 @@ func (k msgServer) UpdateComment(goCtx context.Context, msg *types.MsgUpdateComm
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid comment parent (%v)", msg.Parent))
 	}
 
+	repository, found := k.GetRepositoryById(ctx, msg.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if msg.Creator != comment.Creator {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	if comment.Body != msg.Body {
+		k.AppendCommentRevision(ctx, comment.RepositoryId, comment.Parent, comment.ParentIid, comment.CommentIid, msg.Creator, comment.Body)
+	}
+
+	previousBody := comment.Body
 	comment.Body = msg.Body
 	comment.Attachments = msg.Attachments
 	comment.UpdatedAt = ctx.BlockTime().Unix()
 
 	k.SetComment(ctx, comment)
 
+	k.Notify(ctx, make(map[string]bool), types.Notification_MENTION, k.GetMentionedUsers(ctx, comment.Body, previousBody), types.Notification{
+		Actor:        msg.Creator,
+		RepositoryId: comment.RepositoryId,
+		Parent:       comment.Parent,
+		ParentIid:    comment.ParentIid,
+		CommentIid:   comment.CommentIid,
+	})
+
 	return &types.MsgUpdateCommentResponse{}, nil
 }
 
@@ -140,15 +211,42 @@ func (k msgServer) DeleteComment(goCtx context.Context, msg *types.MsgDeleteComm
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid comment parent (%v)", msg.Parent))
 	}
 
+	repository, found := k.GetRepositoryById(ctx, msg.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if msg.Creator != comment.Creator {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	k.RemoveAllCommentRevisionForComment(ctx, comment.RepositoryId, comment.Parent, comment.ParentIid, comment.CommentIid)
+
 	switch msg.Parent {
 	case types.CommentParentIssue:
 		k.RemoveIssueComment(ctx, comment.RepositoryId, comment.ParentIid, comment.CommentIid)
 	case types.CommentParentPullRequest:
 		k.RemovePullRequestComment(ctx, comment.RepositoryId, comment.ParentIid, comment.CommentIid)
+
+		/* Remove the replies along with the review comment thread */
+		for _, replyIid := range comment.Replies {
+			k.RemovePullRequestComment(ctx, comment.RepositoryId, comment.ParentIid, replyIid)
+			k.RemoveAllCommentRevisionForComment(ctx, comment.RepositoryId, comment.Parent, comment.ParentIid, replyIid)
+		}
+
+		/* Remove the reply from the review comment thread */
+		if comment.InReplyTo != 0 {
+			if threadRoot, found := k.GetPullRequestComment(ctx, comment.RepositoryId, comment.ParentIid, comment.InReplyTo); found {
+				if i, exists := utils.PullRequestCommentExists(threadRoot.Replies, comment.CommentIid); exists {
+					threadRoot.Replies = append(threadRoot.Replies[:i], threadRoot.Replies[i+1:]...)
+					k.SetComment(ctx, threadRoot)
+				}
+			}
+		}
 	}
 
 	return &types.MsgDeleteCommentResponse{}, nil
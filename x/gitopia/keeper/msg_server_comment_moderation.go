@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+// getModeratedComment returns the comment if the creator is allowed to moderate it
+func (k msgServer) getModeratedComment(ctx sdk.Context, creator string, repositoryId uint64, parentIid uint64, parent types.CommentParent, commentIid uint64) (types.Comment, error) {
+	if _, found := k.GetUser(ctx, creator); !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", creator))
+	}
+
+	var comment types.Comment
+	var found bool
+
+	switch parent {
+	case types.CommentParentIssue:
+		comment, found = k.GetIssueComment(ctx, repositoryId, parentIid, commentIid)
+	case types.CommentParentPullRequest:
+		comment, found = k.GetPullRequestComment(ctx, repositoryId, parentIid, commentIid)
+	default:
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid comment parent (%v)", parent))
+	}
+	if !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("comment (%d) doesn't exist", commentIid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, repositoryId)
+	if !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", repositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return types.Comment{}, err
+	}
+
+	if !k.HavePermission(ctx, creator, repository, types.CommentModerationPermission) {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", creator))
+	}
+
+	if comment.System {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("system comment (%d) can't be moderated", commentIid))
+	}
+
+	return comment, nil
+}
+
+func (k msgServer) HideComment(goCtx context.Context, msg *types.MsgHideComment) (*types.MsgHideCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	comment, err := k.getModeratedComment(ctx, msg.Creator, msg.RepositoryId, msg.ParentIid, msg.Parent, msg.CommentIid)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Hidden {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("comment (%d) is already hidden", msg.CommentIid))
+	}
+
+	comment.Hidden = true
+	comment.HiddenReason = msg.Reason
+	comment.HiddenBy = msg.Creator
+
+	k.SetComment(ctx, comment)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.HideCommentEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(msg.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentParentKey, msg.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeCommentParentIidKey, strconv.FormatUint(msg.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentIidKey, strconv.FormatUint(msg.CommentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentHiddenKey, strconv.FormatBool(comment.Hidden)),
+			sdk.NewAttribute(types.EventAttributeHiddenReasonKey, comment.HiddenReason.String()),
+		),
+	)
+
+	return &types.MsgHideCommentResponse{}, nil
+}
+
+func (k msgServer) UnhideComment(goCtx context.Context, msg *types.MsgUnhideComment) (*types.MsgUnhideCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	comment, err := k.getModeratedComment(ctx, msg.Creator, msg.RepositoryId, msg.ParentIid, msg.Parent, msg.CommentIid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !comment.Hidden {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("comment (%d) is not hidden", msg.CommentIid))
+	}
+
+	comment.Hidden = false
+	comment.HiddenReason = types.CommentHiddenReasonNone
+	comment.HiddenBy = ""
+
+	k.SetComment(ctx, comment)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UnhideCommentEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(msg.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentParentKey, msg.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeCommentParentIidKey, strconv.FormatUint(msg.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentIidKey, strconv.FormatUint(msg.CommentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentHiddenKey, strconv.FormatBool(comment.Hidden)),
+		),
+	)
+
+	return &types.MsgUnhideCommentResponse{}, nil
+}
+
+func (k msgServer) LockConversation(goCtx context.Context, msg *types.MsgLockConversation) (*types.MsgLockConversationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.setConversationLocked(ctx, msg.Creator, msg.RepositoryId, msg.ParentIid, msg.Parent, true); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLockConversationResponse{}, nil
+}
+
+func (k msgServer) UnlockConversation(goCtx context.Context, msg *types.MsgUnlockConversation) (*types.MsgUnlockConversationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.setConversationLocked(ctx, msg.Creator, msg.RepositoryId, msg.ParentIid, msg.Parent, false); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnlockConversationResponse{}, nil
+}
+
+// setConversationLocked locks or unlocks the conversation of an issue or pull request
+// and records the change as a system comment
+func (k msgServer) setConversationLocked(ctx sdk.Context, creator string, repositoryId uint64, parentIid uint64, parent types.CommentParent, locked bool) error {
+	if _, found := k.GetUser(ctx, creator); !found {
+		return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", creator))
+	}
+
+	var issue types.Issue
+	var pullRequest types.PullRequest
+	var found bool
+	var wasLocked bool
+
+	switch parent {
+	case types.CommentParentIssue:
+		issue, found = k.GetRepositoryIssue(ctx, repositoryId, parentIid)
+		if !found {
+			return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", parentIid))
+		}
+		if err := CheckIssueNotTransferred(issue); err != nil {
+			return err
+		}
+		wasLocked = issue.Locked
+	case types.CommentParentPullRequest:
+		pullRequest, found = k.GetRepositoryPullRequest(ctx, repositoryId, parentIid)
+		if !found {
+			return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", parentIid))
+		}
+		wasLocked = pullRequest.Locked
+	default:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid comment parent (%v)", parent))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, repositoryId)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", repositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return err
+	}
+
+	if !k.HavePermission(ctx, creator, repository, types.LockConversationPermission) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", creator))
+	}
+
+	if wasLocked == locked {
+		if locked {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "conversation is already locked")
+		}
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "conversation is not locked")
+	}
+
+	eventKey := types.LockConversationEventKey
+	commentType := types.CommentTypeConversationLocked
+	commentBody := utils.ConversationLockedCommentBody(creator)
+	if !locked {
+		eventKey = types.UnlockConversationEventKey
+		commentType = types.CommentTypeConversationUnlocked
+		commentBody = utils.ConversationUnlockedCommentBody(creator)
+	}
+
+	var comment = types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: repositoryId,
+		ParentIid:    parentIid,
+		Parent:       parent,
+		Body:         commentBody,
+		System:       true,
+		CreatedAt:    ctx.BlockTime().Unix(),
+		UpdatedAt:    ctx.BlockTime().Unix(),
+		CommentType:  commentType,
+	}
+
+	switch parent {
+	case types.CommentParentIssue:
+		issue.Locked = locked
+		issue.CommentsCount += 1
+		issue.UpdatedAt = ctx.BlockTime().Unix()
+		comment.CommentIid = issue.CommentsCount
+		k.SetIssue(ctx, issue)
+	case types.CommentParentPullRequest:
+		pullRequest.Locked = locked
+		pullRequest.CommentsCount += 1
+		pullRequest.UpdatedAt = ctx.BlockTime().Unix()
+		comment.CommentIid = pullRequest.CommentsCount
+		k.SetPullRequest(ctx, pullRequest)
+	}
+
+	k.AppendComment(
+		ctx,
+		comment,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, eventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentParentKey, parent.String()),
+			sdk.NewAttribute(types.EventAttributeCommentParentIidKey, strconv.FormatUint(parentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeLockedKey, strconv.FormatBool(locked)),
+		),
+	)
+
+	return nil
+}
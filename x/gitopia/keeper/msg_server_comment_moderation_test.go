@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func TestCommentModerationMsgServerHide(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, _, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentIssue, Body: "spam"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgHideComment
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgHideComment{Creator: "C", ParentIid: 1, Parent: types.CommentParentIssue, CommentIid: 1, Reason: types.CommentHiddenReasonSpam},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Comment Not Exists",
+			request: &types.MsgHideComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentIssue, CommentIid: 10, Reason: types.CommentHiddenReasonSpam},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgHideComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentIssue, CommentIid: 1, Reason: types.CommentHiddenReasonSpam},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgHideComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentIssue, CommentIid: 1, Reason: types.CommentHiddenReasonSpam},
+		},
+		{
+			desc:    "Already Hidden",
+			request: &types.MsgHideComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentIssue, CommentIid: 1, Reason: types.CommentHiddenReasonOutdated},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.HideComment(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	comment, found := keepers.GitopiaKeeper.GetIssueComment(sdkCtx, 0, 1, 1)
+	require.True(t, found)
+	require.True(t, comment.Hidden)
+	require.Equal(t, types.CommentHiddenReasonSpam, comment.HiddenReason)
+	require.Equal(t, users[0], comment.HiddenBy)
+}
+
+func TestCommentModerationMsgServerUnhide(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: users[0], RepositoryId: repositoryId, User: users[1], Role: "TRIAGE"})
+	require.NoError(t, err)
+	_, err = srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentPullRequest, Body: "outdated"})
+	require.NoError(t, err)
+	_, err = srv.HideComment(ctx, &types.MsgHideComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentPullRequest, CommentIid: 1, Reason: types.CommentHiddenReasonOutdated})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgUnhideComment
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgUnhideComment{Creator: "C", ParentIid: 1, Parent: types.CommentParentPullRequest, CommentIid: 1},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgUnhideComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentPullRequest, CommentIid: 1},
+		},
+		{
+			desc:    "Not Hidden",
+			request: &types.MsgUnhideComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentPullRequest, CommentIid: 1},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.UnhideComment(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	comment, found := keepers.GitopiaKeeper.GetPullRequestComment(sdkCtx, 0, 1, 1)
+	require.True(t, found)
+	require.False(t, comment.Hidden)
+	require.Equal(t, types.CommentHiddenReasonNone, comment.HiddenReason)
+	require.Empty(t, comment.HiddenBy)
+}
+
+func TestCommentModerationMsgServerLockConversation(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: "C", Username: "C"})
+	require.NoError(t, err)
+	_, err = srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: users[0], RepositoryId: repositoryId, User: "C", Role: "READ"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgLockConversation
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgLockConversation{Creator: "D", ParentIid: 1, Parent: types.CommentParentIssue},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Issue Not Exists",
+			request: &types.MsgLockConversation{Creator: users[0], ParentIid: 10, Parent: types.CommentParentIssue},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgLockConversation{Creator: users[1], ParentIid: 1, Parent: types.CommentParentIssue},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Issue Completed",
+			request: &types.MsgLockConversation{Creator: users[0], ParentIid: 1, Parent: types.CommentParentIssue},
+		},
+		{
+			desc:    "Already Locked",
+			request: &types.MsgLockConversation{Creator: users[0], ParentIid: 1, Parent: types.CommentParentIssue},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "PullRequest Completed",
+			request: &types.MsgLockConversation{Creator: users[0], ParentIid: 1, Parent: types.CommentParentPullRequest},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.LockConversation(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	issue, found := keepers.GitopiaKeeper.GetRepositoryIssue(sdkCtx, 0, 1)
+	require.True(t, found)
+	require.True(t, issue.Locked)
+	comment, found := keepers.GitopiaKeeper.GetIssueComment(sdkCtx, 0, 1, issue.CommentsCount)
+	require.True(t, found)
+	require.True(t, comment.System)
+	require.Equal(t, types.CommentTypeConversationLocked, comment.CommentType)
+
+	t.Run("Comment By Non Collaborator", func(t *testing.T) {
+		_, err := srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentIssue, Body: "comment"})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Comment By Collaborator", func(t *testing.T) {
+		_, err := srv.CreateComment(ctx, &types.MsgCreateComment{Creator: "C", ParentIid: 1, Parent: types.CommentParentIssue, Body: "comment"})
+		require.NoError(t, err)
+	})
+	t.Run("Unlock", func(t *testing.T) {
+		_, err := srv.UnlockConversation(ctx, &types.MsgUnlockConversation{Creator: users[0], ParentIid: 1, Parent: types.CommentParentIssue})
+		require.NoError(t, err)
+		_, err = srv.UnlockConversation(ctx, &types.MsgUnlockConversation{Creator: users[0], ParentIid: 1, Parent: types.CommentParentIssue})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		_, err = srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentIssue, Body: "comment"})
+		require.NoError(t, err)
+	})
+}
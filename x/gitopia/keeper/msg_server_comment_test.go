@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
@@ -96,6 +97,38 @@ func TestCommentMsgServerUpdate(t *testing.T) {
 	}
 }
 
+func TestCommentMsgServerHistory(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, _, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentIssue, Body: "first"})
+	require.NoError(t, err)
+
+	for _, body := range []string{"second", "second", "third"} {
+		_, err = srv.UpdateComment(ctx, &types.MsgUpdateComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentIssue, CommentIid: 1, Body: body})
+		require.NoError(t, err)
+	}
+	_, err = srv.UpdateIssueDescription(ctx, &types.MsgUpdateIssueDescription{Creator: users[0], RepositoryId: 0, Iid: 1, Description: "description"})
+	require.NoError(t, err)
+	_, err = srv.UpdatePullRequestDescription(ctx, &types.MsgUpdatePullRequestDescription{Creator: users[0], RepositoryId: 0, Iid: 1, Description: "description"})
+	require.NoError(t, err)
+
+	res, err := keepers.GitopiaKeeper.CommentHistory(ctx, &types.QueryCommentHistoryRequest{RepositoryId: 0, Parent: types.CommentParentIssue, ParentIid: 1, CommentIid: 1})
+	require.NoError(t, err)
+	require.Len(t, res.CommentRevision, 2)
+	require.Equal(t, "first", res.CommentRevision[0].Body)
+	require.Equal(t, "second", res.CommentRevision[1].Body)
+	require.Equal(t, users[0], res.CommentRevision[1].Editor)
+
+	require.Len(t, keepers.GitopiaKeeper.GetAllCommentRevisionForComment(sdkCtx, 0, types.CommentParentIssue, 1, 0), 1)
+	require.Len(t, keepers.GitopiaKeeper.GetAllCommentRevisionForComment(sdkCtx, 0, types.CommentParentPullRequest, 1, 0), 1)
+
+	_, err = srv.DeleteComment(ctx, &types.MsgDeleteComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentIssue, CommentIid: 1})
+	require.NoError(t, err)
+	require.Empty(t, keepers.GitopiaKeeper.GetAllCommentRevisionForComment(sdkCtx, 0, types.CommentParentIssue, 1, 1))
+}
+
 func TestCommentMsgServerDelete(t *testing.T) {
 	srv, ctx := setupMsgServer(t)
 	users, _, _, _ := setupPreComment(ctx, t, srv)
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// getResolvableCommentThread returns the review comment thread if the creator is allowed to resolve it.
+// The pull request author, the thread author and collaborators with write permission can resolve a thread.
+func (k msgServer) getResolvableCommentThread(ctx sdk.Context, creator string, repositoryId uint64, pullRequestIid uint64, commentIid uint64) (types.Comment, error) {
+	if _, found := k.GetUser(ctx, creator); !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, repositoryId, pullRequestIid)
+	if !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", pullRequestIid))
+	}
+
+	comment, found := k.GetPullRequestComment(ctx, repositoryId, pullRequestIid, commentIid)
+	if !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("comment (%d) doesn't exist", commentIid))
+	}
+
+	if !IsCommentThreadRoot(comment) {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("comment (%d) is not a review comment thread", commentIid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, repositoryId)
+	if !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", repositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return types.Comment{}, err
+	}
+
+	if creator != pullRequest.Creator && creator != comment.Creator {
+		if !k.HavePermission(ctx, creator, repository, types.PullRequestResolveThreadPermission) {
+			return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", creator))
+		}
+	}
+
+	return comment, nil
+}
+
+func (k msgServer) ResolveCommentThread(goCtx context.Context, msg *types.MsgResolveCommentThread) (*types.MsgResolveCommentThreadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	comment, err := k.getResolvableCommentThread(ctx, msg.Creator, msg.RepositoryId, msg.PullRequestIid, msg.CommentIid)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Resolved {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("comment thread (%d) is already resolved", msg.CommentIid))
+	}
+
+	comment.Resolved = true
+	comment.ResolvedBy = msg.Creator
+
+	k.SetComment(ctx, comment)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ResolveCommentThreadEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(msg.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(msg.PullRequestIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentIidKey, strconv.FormatUint(msg.CommentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentResolvedKey, strconv.FormatBool(comment.Resolved)),
+		),
+	)
+
+	return &types.MsgResolveCommentThreadResponse{}, nil
+}
+
+func (k msgServer) UnresolveCommentThread(goCtx context.Context, msg *types.MsgUnresolveCommentThread) (*types.MsgUnresolveCommentThreadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	comment, err := k.getResolvableCommentThread(ctx, msg.Creator, msg.RepositoryId, msg.PullRequestIid, msg.CommentIid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !comment.Resolved {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("comment thread (%d) is not resolved", msg.CommentIid))
+	}
+
+	comment.Resolved = false
+	comment.ResolvedBy = ""
+
+	k.SetComment(ctx, comment)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UnresolveCommentThreadEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(msg.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(msg.PullRequestIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentIidKey, strconv.FormatUint(msg.CommentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentResolvedKey, strconv.FormatBool(comment.Resolved)),
+		),
+	)
+
+	return &types.MsgUnresolveCommentThreadResponse{}, nil
+}
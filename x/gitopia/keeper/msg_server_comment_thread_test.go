@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func TestCommentThreadMsgServerReply(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, _, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentPullRequest, DiffHunk: "@@ -1 +1 @@", Path: "main.go"})
+	require.NoError(t, err)
+	_, err = srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentPullRequest})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgCreateComment
+		err     error
+	}{
+		{
+			desc:    "Thread Not Exists",
+			request: &types.MsgCreateComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentPullRequest, InReplyTo: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Not A Review Comment",
+			request: &types.MsgCreateComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentPullRequest, InReplyTo: 2},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgCreateComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentPullRequest, InReplyTo: 1},
+		},
+		{
+			desc:    "Reply To Reply",
+			request: &types.MsgCreateComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentPullRequest, InReplyTo: 3},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.CreateComment(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	thread, found := keepers.GitopiaKeeper.GetPullRequestComment(sdkCtx, 0, 1, 1)
+	require.True(t, found)
+	require.Equal(t, []uint64{3}, thread.Replies)
+
+	t.Run("Delete Reply", func(t *testing.T) {
+		_, err := srv.DeleteComment(ctx, &types.MsgDeleteComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentPullRequest, CommentIid: 3})
+		require.NoError(t, err)
+
+		thread, found := keepers.GitopiaKeeper.GetPullRequestComment(sdkCtx, 0, 1, 1)
+		require.True(t, found)
+		require.Empty(t, thread.Replies)
+	})
+	t.Run("Delete Thread", func(t *testing.T) {
+		_, err := srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentPullRequest, InReplyTo: 1})
+		require.NoError(t, err)
+		_, err = srv.DeleteComment(ctx, &types.MsgDeleteComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentPullRequest, CommentIid: 1})
+		require.NoError(t, err)
+
+		_, found := keepers.GitopiaKeeper.GetPullRequestComment(sdkCtx, 0, 1, 4)
+		require.False(t, found)
+	})
+}
+
+func TestCommentThreadMsgServerResolve(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, _, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: "C", Username: "C"})
+	require.NoError(t, err)
+	_, err = srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentPullRequest, DiffHunk: "@@ -1 +1 @@", Path: "main.go"})
+	require.NoError(t, err)
+	_, err = srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentPullRequest})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgResolveCommentThread
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgResolveCommentThread{Creator: "D", PullRequestIid: 1, CommentIid: 1},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "PullRequest Not Exists",
+			request: &types.MsgResolveCommentThread{Creator: users[0], PullRequestIid: 10, CommentIid: 1},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Comment Not Exists",
+			request: &types.MsgResolveCommentThread{Creator: users[0], PullRequestIid: 1, CommentIid: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Not A Review Comment",
+			request: &types.MsgResolveCommentThread{Creator: users[0], PullRequestIid: 1, CommentIid: 2},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgResolveCommentThread{Creator: "C", PullRequestIid: 1, CommentIid: 1},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgResolveCommentThread{Creator: users[0], PullRequestIid: 1, CommentIid: 1},
+		},
+		{
+			desc:    "Already Resolved",
+			request: &types.MsgResolveCommentThread{Creator: users[1], PullRequestIid: 1, CommentIid: 1},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.ResolveCommentThread(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	thread, found := keepers.GitopiaKeeper.GetPullRequestComment(sdkCtx, 0, 1, 1)
+	require.True(t, found)
+	require.True(t, thread.Resolved)
+	require.Equal(t, users[0], thread.ResolvedBy)
+	require.Empty(t, keepers.GitopiaKeeper.GetAllPullRequestUnresolvedCommentThread(sdkCtx, 0, 1))
+}
+
+func TestCommentThreadMsgServerUnresolve(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, _, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: "C", Username: "C"})
+	require.NoError(t, err)
+	_, err = srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentPullRequest, DiffHunk: "@@ -1 +1 @@", Path: "main.go"})
+	require.NoError(t, err)
+	_, err = srv.ResolveCommentThread(ctx, &types.MsgResolveCommentThread{Creator: users[1], PullRequestIid: 1, CommentIid: 1})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgUnresolveCommentThread
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgUnresolveCommentThread{Creator: "D", PullRequestIid: 1, CommentIid: 1},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgUnresolveCommentThread{Creator: "C", PullRequestIid: 1, CommentIid: 1},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgUnresolveCommentThread{Creator: users[0], PullRequestIid: 1, CommentIid: 1},
+		},
+		{
+			desc:    "Not Resolved",
+			request: &types.MsgUnresolveCommentThread{Creator: users[0], PullRequestIid: 1, CommentIid: 1},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.UnresolveCommentThread(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	thread, found := keepers.GitopiaKeeper.GetPullRequestComment(sdkCtx, 0, 1, 1)
+	require.True(t, found)
+	require.False(t, thread.Resolved)
+	require.Empty(t, thread.ResolvedBy)
+
+	res, err := keepers.GitopiaKeeper.PullRequestUnresolvedCommentThreadAll(ctx, &types.QueryAllPullRequestUnresolvedCommentThreadRequest{RepositoryId: 0, PullRequestIid: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Comment, 1)
+}
+
+func TestPullRequestConversationResolutionGating(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.CreateBranchProtectionRule(ctx, &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: branches[1], RequireConversationResolution: true})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	_, err = srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[1], ParentIid: 1, Parent: types.CommentParentPullRequest, DiffHunk: "@@ -1 +1 @@", Path: "main.go"})
+	require.NoError(t, err)
+
+	invokeMerge := &types.MsgInvokeMergePullRequest{Creator: users[0], RepositoryId: 0, Iid: 1, Provider: users[0]}
+
+	t.Run("Unresolved Conversation", func(t *testing.T) {
+		_, err := srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Resolved Conversation", func(t *testing.T) {
+		_, err := srv.ResolveCommentThread(ctx, &types.MsgResolveCommentThread{Creator: users[0], RepositoryId: 0, PullRequestIid: 1, CommentIid: 1})
+		require.NoError(t, err)
+		_, err = srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.NoError(t, err)
+	})
+}
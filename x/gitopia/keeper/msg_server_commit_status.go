@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func (k msgServer) SetCommitStatus(goCtx context.Context, msg *types.MsgSetCommitStatus) (*types.MsgSetCommitStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.CommitStatusPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	// Reporting on an existing context replaces its state
+	commitStatus, found := k.GetRepositoryCommitStatus(ctx, repository.Id, msg.Sha, msg.Context)
+	if found {
+		commitStatus.State = msg.State
+		commitStatus.TargetUrl = msg.TargetUrl
+		commitStatus.Description = msg.Description
+		commitStatus.Creator = msg.Creator
+		commitStatus.UpdatedAt = blockTime
+
+		k.SetRepositoryCommitStatus(ctx, commitStatus)
+	} else {
+		commitStatus = types.CommitStatus{
+			RepositoryId: repository.Id,
+			Sha:          msg.Sha,
+			Context:      msg.Context,
+			State:        msg.State,
+			TargetUrl:    msg.TargetUrl,
+			Description:  msg.Description,
+			Creator:      msg.Creator,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+		}
+
+		commitStatus.Id = k.AppendCommitStatus(ctx, commitStatus)
+	}
+
+	commitStatusJson, _ := json.Marshal(commitStatus)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetCommitStatusEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeCommitStatusKey, string(commitStatusJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(commitStatus.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetCommitStatusResponse{Id: commitStatus.Id}, nil
+}
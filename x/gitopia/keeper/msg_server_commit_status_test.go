@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func TestCommitStatusMsgServerSet(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	users := setupPreRepository(ctx, t, srv)
+	repositoryId := types.RepositoryId{
+		Id:   users[0],
+		Name: "repository",
+	}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: repositoryId.Id, Name: repositoryId.Name, Owner: repositoryId.Id})
+	require.NoError(t, err)
+	sha := strings.Repeat("a", 40)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSetCommitStatus
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgSetCommitStatus{Creator: "X", RepositoryId: repositoryId, Sha: sha, Context: "ci/build"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Repository Not Exists",
+			request: &types.MsgSetCommitStatus{Creator: users[0], RepositoryId: types.RepositoryId{Id: users[0], Name: "name"}, Sha: sha, Context: "ci/build"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgSetCommitStatus{Creator: users[1], RepositoryId: repositoryId, Sha: sha, Context: "ci/build"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgSetCommitStatus{Creator: users[0], RepositoryId: repositoryId, Sha: sha, Context: "ci/build", State: types.CommitStatus_PENDING},
+		},
+		{
+			desc:    "Update Existing Context",
+			request: &types.MsgSetCommitStatus{Creator: users[0], RepositoryId: repositoryId, Sha: sha, Context: "ci/build", State: types.CommitStatus_SUCCESS},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SetCommitStatus(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	res, err := keepers.GitopiaKeeper.RepositoryCommitStatusAll(ctx, &types.QueryAllRepositoryCommitStatusRequest{Id: repositoryId.Id, RepositoryName: repositoryId.Name, Sha: sha})
+	require.NoError(t, err)
+	require.Len(t, res.CommitStatus, 1)
+	require.Equal(t, types.CommitStatus_SUCCESS, res.CommitStatus[0].State)
+}
+
+func TestCommitStatusCombinedStatus(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	users := setupPreRepository(ctx, t, srv)
+	repositoryId := types.RepositoryId{
+		Id:   users[0],
+		Name: "repository",
+	}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: repositoryId.Id, Name: repositoryId.Name, Owner: repositoryId.Id})
+	require.NoError(t, err)
+	sha := strings.Repeat("a", 40)
+	_, err = srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[0], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: "main", Sha: sha}})
+	require.NoError(t, err)
+
+	combinedStatus := func(ref string) types.CommitStatus_State {
+		res, err := keepers.GitopiaKeeper.RepositoryCombinedCommitStatus(ctx, &types.QueryGetRepositoryCombinedCommitStatusRequest{Id: repositoryId.Id, RepositoryName: repositoryId.Name, Ref: ref})
+		require.NoError(t, err)
+		require.Equal(t, sha, res.Sha)
+		return res.State
+	}
+	setStatus := func(context string, state types.CommitStatus_State) {
+		_, err := srv.SetCommitStatus(ctx, &types.MsgSetCommitStatus{Creator: users[0], RepositoryId: repositoryId, Sha: sha, Context: context, State: state})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, types.CommitStatus_PENDING, combinedStatus("main"))
+	setStatus("ci/build", types.CommitStatus_SUCCESS)
+	require.Equal(t, types.CommitStatus_SUCCESS, combinedStatus("main"))
+	setStatus("ci/test", types.CommitStatus_PENDING)
+	require.Equal(t, types.CommitStatus_PENDING, combinedStatus(sha))
+	setStatus("ci/lint", types.CommitStatus_ERROR)
+	require.Equal(t, types.CommitStatus_FAILURE, combinedStatus("main"))
+}
+
+func TestCommitStatusMergeGating(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	headSha := strings.Repeat("a", 40)
+	_, err := srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[0], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: branches[0], Sha: headSha}})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	_, err = srv.CreateBranchProtectionRule(ctx, &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: branches[1], RequiredStatusChecks: []string{"ci/build"}})
+	require.NoError(t, err)
+
+	invokeMerge := &types.MsgInvokeMergePullRequest{Creator: users[0], RepositoryId: 0, Iid: 1, Provider: users[0]}
+
+	t.Run("Status Check Missing", func(t *testing.T) {
+		_, err := srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Status Check Failing", func(t *testing.T) {
+		_, err := srv.SetCommitStatus(ctx, &types.MsgSetCommitStatus{Creator: users[0], RepositoryId: repositoryId, Sha: headSha, Context: "ci/build", State: types.CommitStatus_FAILURE})
+		require.NoError(t, err)
+		_, err = srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Status Check Passing", func(t *testing.T) {
+		_, err := srv.SetCommitStatus(ctx, &types.MsgSetCommitStatus{Creator: users[0], RepositoryId: repositoryId, Sha: headSha, Context: "ci/build", State: types.CommitStatus_SUCCESS})
+		require.NoError(t, err)
+		_, err = srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.NoError(t, err)
+	})
+	t.Run("Status Check On Outdated Head", func(t *testing.T) {
+		_, err := srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[0], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: branches[0], Sha: strings.Repeat("b", 40)}})
+		require.NoError(t, err)
+		_, err = srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+}
+
+func TestCommitStatusCiProviderAuthorization(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	user := sample.AccAddress()
+	provider := sample.AccAddress()
+	_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: user, Username: "user"})
+	require.NoError(t, err)
+
+	checkAuthorization := func() bool {
+		res, err := keepers.GitopiaKeeper.CheckCiProviderAuthorization(ctx, &types.QueryCheckCiProviderAuthorizationRequest{UserAddress: user, ProviderAddress: provider})
+		require.NoError(t, err)
+		return res.HaveAuthorization
+	}
+
+	require.False(t, checkAuthorization())
+	_, err = srv.AuthorizeProvider(ctx, &types.MsgAuthorizeProvider{Creator: user, Granter: user, Provider: provider, Permission: types.ProviderPermission_CI})
+	require.NoError(t, err)
+	require.True(t, checkAuthorization())
+	_, err = srv.RevokeProviderPermission(ctx, &types.MsgRevokeProviderPermission{Creator: user, Granter: user, Provider: provider, Permission: types.ProviderPermission_CI})
+	require.NoError(t, err)
+	require.False(t, checkAuthorization())
+}
+
+func TestCommitStatusMergeGatingFork(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	forkId := types.RepositoryId{Id: users[1], Name: "fork"}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: users[1], Name: forkId.Name, Owner: users[1]})
+	require.NoError(t, err)
+	fork, found := keepers.GitopiaKeeper.GetRepositoryById(sdk.UnwrapSDKContext(ctx), 1)
+	require.True(t, found)
+	fork.Fork = true
+	fork.Parent = 0
+	keepers.GitopiaKeeper.SetRepository(sdk.UnwrapSDKContext(ctx), fork)
+
+	headSha := strings.Repeat("a", 40)
+	_, err = srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[1], RepositoryId: forkId, Branch: types.MsgSetBranch_Branch{Name: "feature", Sha: headSha}})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[1], HeadRepositoryId: forkId, HeadBranch: "feature", BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	_, err = srv.CreateBranchProtectionRule(ctx, &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: branches[1], RequiredStatusChecks: []string{"ci/build"}})
+	require.NoError(t, err)
+
+	invokeMerge := &types.MsgInvokeMergePullRequest{Creator: users[0], RepositoryId: 0, Iid: 1, Provider: users[0]}
+
+	t.Run("Status Check Reported On Fork", func(t *testing.T) {
+		_, err := srv.SetCommitStatus(ctx, &types.MsgSetCommitStatus{Creator: users[1], RepositoryId: forkId, Sha: headSha, Context: "ci/build", State: types.CommitStatus_SUCCESS})
+		require.NoError(t, err)
+		_, err = srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Status Check Reported On Base", func(t *testing.T) {
+		_, err := srv.SetCommitStatus(ctx, &types.MsgSetCommitStatus{Creator: users[0], RepositoryId: repositoryId, Sha: headSha, Context: "ci/build", State: types.CommitStatus_SUCCESS})
+		require.NoError(t, err)
+		_, err = srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.NoError(t, err)
+	})
+}
@@ -413,5 +413,7 @@ func DoRemoveDao(ctx sdk.Context, k msgServer, user types.User, dao types.Dao) {
 		k.RemoveDaoMember(ctx, dao.Address, member.Address)
 	}
 
+	k.RemoveAllFollow(ctx, dao.Address)
+
 	k.RemoveDao(ctx, dao.Address)
 }
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func (k msgServer) Follow(goCtx context.Context, msg *types.MsgFollow) (*types.MsgFollowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	user, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.Id)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, err.Error())
+	}
+
+	if address.Address == msg.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "user can't follow themselves")
+	}
+
+	if _, found := k.GetFollow(ctx, msg.Creator, address.Address); found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("user (%v) is already following (%v)", msg.Creator, msg.Id))
+	}
+
+	k.SetFollow(ctx, types.Follow{
+		Follower:  msg.Creator,
+		Following: address.Address,
+		CreatedAt: ctx.BlockTime().Unix(),
+	})
+
+	user.FollowingCount++
+	k.SetUser(ctx, user)
+
+	followersCount := k.IncreaseFollowersCount(ctx, address.Address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.FollowEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeFollowingKey, address.Address),
+			sdk.NewAttribute(types.EventAttributeFollowersCount, strconv.FormatUint(followersCount, 10)),
+		),
+	)
+
+	return &types.MsgFollowResponse{}, nil
+}
+
+func (k msgServer) Unfollow(goCtx context.Context, msg *types.MsgUnfollow) (*types.MsgUnfollowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	user, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.Id)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, err.Error())
+	}
+
+	if _, found := k.GetFollow(ctx, msg.Creator, address.Address); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("user (%v) isn't following (%v)", msg.Creator, msg.Id))
+	}
+
+	k.RemoveFollow(ctx, msg.Creator, address.Address)
+
+	if user.FollowingCount > 0 {
+		user.FollowingCount--
+	}
+	k.SetUser(ctx, user)
+
+	followersCount := k.DecreaseFollowersCount(ctx, address.Address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UnfollowEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeFollowingKey, address.Address),
+			sdk.NewAttribute(types.EventAttributeFollowersCount, strconv.FormatUint(followersCount, 10)),
+		),
+	)
+
+	return &types.MsgUnfollowResponse{}, nil
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func TestFollowMsgServerFollow(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+	users := setupPreFollow(ctx, t, srv)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgFollow
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgFollow{Creator: "D", Id: users[1]},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "User Not Exists",
+			request: &types.MsgFollow{Creator: users[0], Id: "unknown"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Follow Self",
+			request: &types.MsgFollow{Creator: users[0], Id: users[0]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgFollow{Creator: users[0], Id: users[1]},
+		},
+		{
+			desc:    "Already Following",
+			request: &types.MsgFollow{Creator: users[0], Id: users[1]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.Follow(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFollowMsgServerUnfollow(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users := setupPreFollow(ctx, t, srv)
+	for _, user := range users[1:] {
+		_, err := srv.Follow(ctx, &types.MsgFollow{Creator: users[0], Id: user})
+		require.NoError(t, err)
+		_, err = srv.Follow(ctx, &types.MsgFollow{Creator: user, Id: users[0]})
+		require.NoError(t, err)
+	}
+
+	user, found := keepers.GitopiaKeeper.GetUser(sdkCtx, users[0])
+	require.True(t, found)
+	require.Equal(t, uint64(2), user.FollowersCount)
+	require.Equal(t, uint64(2), user.FollowingCount)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgUnfollow
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgUnfollow{Creator: "D", Id: users[1]},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgUnfollow{Creator: users[0], Id: users[1]},
+		},
+		{
+			desc:    "Not Following",
+			request: &types.MsgUnfollow{Creator: users[0], Id: users[1]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.Unfollow(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	following, err := keepers.GitopiaKeeper.UserFollowing(ctx, &types.QueryUserFollowingRequest{Id: users[0]})
+	require.NoError(t, err)
+	require.Len(t, following.Follow, 1)
+	require.Equal(t, users[2], following.Follow[0].Following)
+
+	followers, err := keepers.GitopiaKeeper.UserFollowers(ctx, &types.QueryUserFollowersRequest{Id: users[0]})
+	require.NoError(t, err)
+	require.Len(t, followers.Follow, 2)
+
+	user, found = keepers.GitopiaKeeper.GetUser(sdkCtx, users[1])
+	require.True(t, found)
+	require.Equal(t, uint64(0), user.FollowersCount)
+	require.Equal(t, uint64(1), user.FollowingCount)
+}
+
+func setupPreFollow(ctx context.Context, t *testing.T, srv types.MsgServer) (users []string) {
+	users = append(users, "A", "B", "C")
+
+	for _, user := range users {
+		_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: user, Username: user})
+		require.NoError(t, err)
+	}
+
+	return users
+}
@@ -31,6 +31,10 @@ func (k msgServer) CreateIssue(goCtx context.Context, msg *types.MsgCreateIssue)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	repository.IssuesCount += 1
 
 	var issue = types.Issue{
@@ -45,8 +49,25 @@ func (k msgServer) CreateIssue(goCtx context.Context, msg *types.MsgCreateIssue)
 		ClosedAt:     time.Time{}.Unix(),
 	}
 
+	var issueTemplate *types.RepositoryIssueTemplate
+	if msg.TemplateId != 0 {
+		i, exists := utils.RepositoryIssueTemplateIdExists(repository.IssueTemplates, msg.TemplateId)
+		if !exists {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue template (%d) doesn't exist", msg.TemplateId))
+		}
+		issueTemplate = repository.IssueTemplates[i]
+	}
+
+	// Labels of the required label groups of the template can be set by anyone
+	requiresAssignPermission := len(msg.Assignees) > 0
+	for _, labelId := range msg.LabelIds {
+		if issueTemplate == nil || !isRequiredLabel(issueTemplate, labelId) {
+			requiresAssignPermission = true
+		}
+	}
+
 	if len(msg.Assignees) > 0 || len(msg.LabelIds) > 0 {
-		if !k.HavePermission(ctx, msg.Creator, repository, types.AssignPermission) {
+		if requiresAssignPermission && !k.HavePermission(ctx, msg.Creator, repository, types.AssignPermission) {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 		}
 
@@ -66,6 +87,12 @@ func (k msgServer) CreateIssue(goCtx context.Context, msg *types.MsgCreateIssue)
 		}
 	}
 
+	if issueTemplate != nil {
+		if err := applyIssueTemplate(&issue, issueTemplate); err != nil {
+			return nil, err
+		}
+	}
+
 	var bountyId uint64
 	if len(msg.BountyAmount) > 0 {
 		if msg.BountyExpiry < ctx.BlockTime().Unix() {
@@ -101,6 +128,7 @@ func (k msgServer) CreateIssue(goCtx context.Context, msg *types.MsgCreateIssue)
 			ctx,
 			bounty,
 		)
+		k.AddBountyFunder(ctx, bountyId, msg.Creator, msg.BountyAmount)
 
 		issue.Bounties = append(issue.Bounties, bountyId)
 	}
@@ -112,6 +140,17 @@ func (k msgServer) CreateIssue(goCtx context.Context, msg *types.MsgCreateIssue)
 
 	k.SetRepository(ctx, repository)
 
+	notified := make(map[string]bool)
+	notification := types.Notification{
+		Actor:        msg.Creator,
+		RepositoryId: repository.Id,
+		Parent:       types.CommentParentIssue,
+		ParentIid:    issue.Iid,
+	}
+	k.Notify(ctx, notified, types.Notification_ASSIGN, issue.Assignees, notification)
+	k.Notify(ctx, notified, types.Notification_MENTION, k.GetMentionedUsers(ctx, issue.Description, ""), notification)
+	k.Notify(ctx, notified, types.Notification_SUBSCRIBED, k.GetRepositoryWatchers(ctx, repository.Id), notification)
+
 	assigneesJson, _ := json.Marshal(issue.Assignees)
 	labelsJson, _ := json.Marshal(issue.Labels)
 	bountyAmountJson, _ := json.Marshal(msg.BountyAmount)
@@ -161,6 +200,19 @@ func (k msgServer) UpdateIssueTitle(goCtx context.Context, msg *types.MsgUpdateI
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.Iid))
 	}
 
+	if err := CheckIssueNotTransferred(issue); err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if issue.Title == msg.Title {
 		return &types.MsgUpdateIssueTitleResponse{}, nil
 	}
@@ -223,6 +275,19 @@ func (k msgServer) UpdateIssueDescription(goCtx context.Context, msg *types.MsgU
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.Iid))
 	}
 
+	if err := CheckIssueNotTransferred(issue); err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if issue.Description == msg.Description {
 		return &types.MsgUpdateIssueDescriptionResponse{}, nil
 	}
@@ -231,6 +296,11 @@ func (k msgServer) UpdateIssueDescription(goCtx context.Context, msg *types.MsgU
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	if issue.Description != msg.Description {
+		k.AppendCommentRevision(ctx, issue.RepositoryId, types.CommentParentIssue, issue.Iid, 0, msg.Creator, issue.Description)
+	}
+
+	previousDescription := issue.Description
 	issue.Description = msg.Description
 	issue.UpdatedAt = ctx.BlockTime().Unix()
 	issue.CommentsCount += 1
@@ -254,6 +324,13 @@ func (k msgServer) UpdateIssueDescription(goCtx context.Context, msg *types.MsgU
 	)
 	k.SetIssue(ctx, issue)
 
+	k.Notify(ctx, make(map[string]bool), types.Notification_MENTION, k.GetMentionedUsers(ctx, issue.Description, previousDescription), types.Notification{
+		Actor:        msg.Creator,
+		RepositoryId: issue.RepositoryId,
+		Parent:       types.CommentParentIssue,
+		ParentIid:    issue.Iid,
+	})
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -283,11 +360,19 @@ func (k msgServer) ToggleIssueState(goCtx context.Context, msg *types.MsgToggleI
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.Iid))
 	}
 
+	if err := CheckIssueNotTransferred(issue); err != nil {
+		return nil, err
+	}
+
 	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
 	if !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if msg.Creator != issue.Creator {
 		if !k.HavePermission(ctx, msg.Creator, repository, types.ToggleIssueStatePermission) {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
@@ -337,21 +422,7 @@ func (k msgServer) ToggleIssueState(goCtx context.Context, msg *types.MsgToggleI
 			if bounty.State != types.BountyStateSRCDEBITTED {
 				continue
 			}
-			creatorAccAddress, err := sdk.AccAddressFromBech32(bounty.Creator)
-			if err != nil {
-				continue
-			}
-
-			if err := k.bankKeeper.IsSendEnabledCoins(ctx, bounty.Amount...); err != nil {
-				continue
-			}
-			if k.bankKeeper.BlockedAddr(creatorAccAddress) {
-				continue
-			}
-			bountyAddress := GetBountyAddress(bounty.Id)
-			if err := k.bankKeeper.SendCoins(
-				ctx, bountyAddress, creatorAccAddress, bounty.Amount,
-			); err != nil {
+			if err := k.RefundBounty(ctx, bounty); err != nil {
 				continue
 			}
 
@@ -424,21 +495,25 @@ func (k msgServer) AddIssueAssignees(goCtx context.Context, msg *types.MsgAddIss
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.Iid))
 	}
 
+	if err := CheckIssueNotTransferred(issue); err != nil {
+		return nil, err
+	}
+
 	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
 	if !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.AssignPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
 
 	totalAssignees := len(issue.Assignees) + len(msg.Assignees)
 
-	if len(issue.Bounties) > 0 && totalAssignees > 1 {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issue with bounty can't have more then 1 assignee")
-	}
-
 	if totalAssignees > 10 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issue can't have more than 10 assignees")
 	}
@@ -476,6 +551,13 @@ func (k msgServer) AddIssueAssignees(goCtx context.Context, msg *types.MsgAddIss
 	)
 	k.SetIssue(ctx, issue)
 
+	k.Notify(ctx, make(map[string]bool), types.Notification_ASSIGN, msg.Assignees, types.Notification{
+		Actor:        msg.Creator,
+		RepositoryId: issue.RepositoryId,
+		Parent:       types.CommentParentIssue,
+		ParentIid:    issue.Iid,
+	})
+
 	assigneesJson, _ := json.Marshal(msg.Assignees)
 
 	ctx.EventManager().EmitEvent(
@@ -507,11 +589,19 @@ func (k msgServer) RemoveIssueAssignees(goCtx context.Context, msg *types.MsgRem
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.Iid))
 	}
 
+	if err := CheckIssueNotTransferred(issue); err != nil {
+		return nil, err
+	}
+
 	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
 	if !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.AssignPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
@@ -581,11 +671,19 @@ func (k msgServer) AddIssueLabels(goCtx context.Context, msg *types.MsgAddIssueL
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.Iid))
 	}
 
+	if err := CheckIssueNotTransferred(issue); err != nil {
+		return nil, err
+	}
+
 	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
 	if !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.LabelPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
@@ -662,11 +760,19 @@ func (k msgServer) RemoveIssueLabels(goCtx context.Context, msg *types.MsgRemove
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.Iid))
 	}
 
+	if err := CheckIssueNotTransferred(issue); err != nil {
+		return nil, err
+	}
+
 	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
 	if !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.LabelPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
@@ -749,6 +855,10 @@ func (k msgServer) DeleteIssue(goCtx context.Context, msg *types.MsgDeleteIssue)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.DeleteIssuePermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
@@ -782,6 +892,224 @@ func (k msgServer) DeleteIssue(goCtx context.Context, msg *types.MsgDeleteIssue)
 	return &types.MsgDeleteIssueResponse{}, nil
 }
 
+func (k msgServer) TransferIssue(goCtx context.Context, msg *types.MsgTransferIssue) (*types.MsgTransferIssueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	issue, found := k.GetRepositoryIssue(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	if err := CheckIssueNotTransferred(issue); err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.TargetRepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	targetRepository, found := k.GetAddressRepository(ctx, address.Address, msg.TargetRepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.TargetRepositoryId.Id, msg.TargetRepositoryId.Name))
+	}
+
+	if targetRepository.Id == repository.Id {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issue can't be transferred to the same repository")
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+	if err := CheckRepositoryNotArchived(targetRepository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.IssueTransferPermission) ||
+		!k.HavePermission(ctx, msg.Creator, targetRepository, types.IssueTransferPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	for _, pullRequestIid := range issue.PullRequests {
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, repository.Id, pullRequestIid.Iid)
+		if !found {
+			continue
+		}
+		if pullRequest.State == types.PullRequest_OPEN {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't transfer issue having OPEN linked pull request")
+		}
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	targetRepository.IssuesCount += 1
+	var targetIssue = types.Issue{
+		Creator:        issue.Creator,
+		Iid:            targetRepository.IssuesCount,
+		Title:          issue.Title,
+		State:          issue.State,
+		Description:    issue.Description,
+		CommentsCount:  issue.CommentsCount,
+		RepositoryId:   targetRepository.Id,
+		Weight:         issue.Weight,
+		Bounties:       issue.Bounties,
+		CreatedAt:      issue.CreatedAt,
+		UpdatedAt:      blockTime,
+		ClosedAt:       issue.ClosedAt,
+		ClosedBy:       issue.ClosedBy,
+		Reactions:      issue.Reactions,
+		ReactionCounts: issue.ReactionCounts,
+		Locked:         issue.Locked,
+	}
+
+	// Assignees who aren't collaborators of the target repository are dropped
+	for _, assignee := range issue.Assignees {
+		if k.HavePermission(ctx, assignee, targetRepository, types.IssueTransferAssigneePermission) {
+			targetIssue.Assignees = append(targetIssue.Assignees, assignee)
+		}
+	}
+
+	// Labels are remapped by name, labels missing in the target repository are dropped
+	for _, labelId := range issue.Labels {
+		i, exists := utils.RepositoryLabelIdExists(repository.Labels, labelId)
+		if !exists {
+			continue
+		}
+		if j, exists := utils.RepositoryLabelExists(targetRepository.Labels, repository.Labels[i].Name); exists {
+			targetIssue.Labels = append(targetIssue.Labels, targetRepository.Labels[j].Id)
+		}
+	}
+
+	// Move the comments and the edit history
+	for _, comment := range k.GetAllIssueComment(ctx, repository.Id, issue.Iid) {
+		k.RemoveIssueComment(ctx, repository.Id, issue.Iid, comment.CommentIid)
+		comment.RepositoryId = targetRepository.Id
+		comment.ParentIid = targetIssue.Iid
+		k.SetComment(ctx, comment)
+	}
+	for _, commentRevision := range k.GetAllCommentRevisionForParent(ctx, repository.Id, types.CommentParentIssue, issue.Iid) {
+		commentRevision.RepositoryId = targetRepository.Id
+		commentRevision.ParentIid = targetIssue.Iid
+		k.SetCommentRevision(ctx, commentRevision)
+	}
+	k.RemoveAllCommentRevisionForParent(ctx, repository.Id, types.CommentParentIssue, issue.Iid)
+
+	// The bounties keep their escrow accounts, which are derived from the bounty id
+	for _, bountyId := range issue.Bounties {
+		bounty, found := k.GetBounty(ctx, bountyId)
+		if !found {
+			continue
+		}
+		bounty.RepositoryId = targetRepository.Id
+		bounty.ParentIid = targetIssue.Iid
+		bounty.UpdatedAt = blockTime
+		k.SetBounty(ctx, bounty)
+	}
+
+	for _, pullRequestIid := range issue.PullRequests {
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, repository.Id, pullRequestIid.Iid)
+		if !found {
+			continue
+		}
+		if i, exists := utils.IssueIidExists(pullRequest.Issues, issue.Iid); exists {
+			pullRequest.Issues = append(pullRequest.Issues[:i], pullRequest.Issues[i+1:]...)
+			pullRequest.UpdatedAt = blockTime
+			k.SetPullRequest(ctx, pullRequest)
+		}
+	}
+
+	sourceReference := fmt.Sprintf("%v/%v#%v", repository.Owner.Id, repository.Name, issue.Iid)
+	targetReference := fmt.Sprintf("%v/%v#%v", targetRepository.Owner.Id, targetRepository.Name, targetIssue.Iid)
+
+	targetIssue.CommentsCount += 1
+	k.AppendComment(ctx, types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: targetRepository.Id,
+		ParentIid:    targetIssue.Iid,
+		Parent:       types.CommentParentIssue,
+		CommentIid:   targetIssue.CommentsCount,
+		Body:         utils.IssueTransferredFromCommentBody(msg.Creator, sourceReference),
+		System:       true,
+		CreatedAt:    blockTime,
+		UpdatedAt:    blockTime,
+		CommentType:  types.CommentTypeIssueTransferred,
+	})
+
+	targetIssueId := k.AppendIssue(ctx, targetIssue)
+
+	// Leave a closed and locked tombstone redirecting to the transferred issue
+	tombstone := types.Issue{
+		Creator:       issue.Creator,
+		Id:            issue.Id,
+		Iid:           issue.Iid,
+		Title:         issue.Title,
+		State:         types.Issue_CLOSED,
+		CommentsCount: 1,
+		RepositoryId:  repository.Id,
+		CreatedAt:     issue.CreatedAt,
+		UpdatedAt:     blockTime,
+		ClosedAt:      issue.ClosedAt,
+		ClosedBy:      issue.ClosedBy,
+		Locked:        true,
+		TransferredTo: &types.IssueTransfer{
+			RepositoryId: targetRepository.Id,
+			Iid:          targetIssue.Iid,
+		},
+	}
+	if issue.State == types.Issue_OPEN {
+		tombstone.ClosedAt = blockTime
+		tombstone.ClosedBy = msg.Creator
+	}
+	k.AppendComment(ctx, types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: repository.Id,
+		ParentIid:    issue.Iid,
+		Parent:       types.CommentParentIssue,
+		CommentIid:   tombstone.CommentsCount,
+		Body:         utils.IssueTransferredToCommentBody(msg.Creator, targetReference),
+		System:       true,
+		CreatedAt:    blockTime,
+		UpdatedAt:    blockTime,
+		CommentType:  types.CommentTypeIssueTransferred,
+	})
+	k.SetIssue(ctx, tombstone)
+
+	repository.UpdatedAt = blockTime
+	k.SetRepository(ctx, repository)
+	targetRepository.UpdatedAt = blockTime
+	k.SetRepository(ctx, targetRepository)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.TransferIssueEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIdKey, strconv.FormatUint(issue.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueTransferredToRepoIdKey, strconv.FormatUint(targetRepository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueTransferredToIdKey, strconv.FormatUint(targetIssueId, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueTransferredToIidKey, strconv.FormatUint(targetIssue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(blockTime, 10)),
+		),
+	)
+
+	return &types.MsgTransferIssueResponse{
+		Id:  targetIssueId,
+		Iid: targetIssue.Iid,
+	}, nil
+}
+
 func DoRemoveIssue(ctx sdk.Context, k msgServer, issue types.Issue, repository types.Repository) {
 	blockTime := ctx.BlockTime().Unix()
 
@@ -789,6 +1117,7 @@ func DoRemoveIssue(ctx sdk.Context, k msgServer, issue types.Issue, repository t
 	for _, comment := range comments {
 		k.RemoveIssueComment(ctx, repository.Id, issue.Iid, comment.CommentIid)
 	}
+	k.RemoveAllCommentRevisionForParent(ctx, repository.Id, types.CommentParentIssue, issue.Iid)
 
 	for _, pullRequestIid := range issue.PullRequests {
 		pullRequest, found := k.GetRepositoryPullRequest(ctx, repository.Id, pullRequestIid.Iid)
@@ -813,21 +1142,7 @@ func DoRemoveIssue(ctx sdk.Context, k msgServer, issue types.Issue, repository t
 		if bounty.State != types.BountyStateSRCDEBITTED {
 			continue
 		}
-		creatorAccAddress, err := sdk.AccAddressFromBech32(bounty.Creator)
-		if err != nil {
-			continue
-		}
-
-		if err := k.bankKeeper.IsSendEnabledCoins(ctx, bounty.Amount...); err != nil {
-			continue
-		}
-		if k.bankKeeper.BlockedAddr(creatorAccAddress) {
-			continue
-		}
-		bountyAddress := GetBountyAddress(bounty.Id)
-		if err := k.bankKeeper.SendCoins(
-			ctx, bountyAddress, creatorAccAddress, bounty.Amount,
-		); err != nil {
+		if err := k.RefundBounty(ctx, bounty); err != nil {
 			continue
 		}
 
@@ -838,5 +1153,6 @@ func DoRemoveIssue(ctx sdk.Context, k msgServer, issue types.Issue, repository t
 		k.SetBounty(ctx, bounty)
 	}
 
+	k.MoveIssueToMilestone(ctx, &issue, 0)
 	k.RemoveRepositoryIssue(ctx, repository.Id, issue.Iid)
 }
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+func TestParseIssueReferences(t *testing.T) {
+	for _, tc := range []struct {
+		text       string
+		references []utils.IssueReference
+	}{
+		{text: "no references"},
+		{text: "see #12", references: []utils.IssueReference{{Iid: 12}}},
+		{text: "closes #12", references: []utils.IssueReference{{Iid: 12, Closing: true}}},
+		{text: "Fixes: #3, resolved #4", references: []utils.IssueReference{{Iid: 3, Closing: true}, {Iid: 4, Closing: true}}},
+		{text: "fixes owner/repo#3", references: []utils.IssueReference{{Owner: "owner", Repository: "repo", Iid: 3, Closing: true}}},
+		{text: "#1 and closes #1", references: []utils.IssueReference{{Iid: 1, Closing: true}}},
+		{text: "prefixes #5", references: []utils.IssueReference{{Iid: 5}}},
+		{text: "issue#5, &#39; and #0 are not references"},
+	} {
+		t.Run(tc.text, func(t *testing.T) {
+			require.Equal(t, tc.references, utils.ParseIssueReferences(tc.text))
+		})
+	}
+}
+
+func countCrossReferenceComments(ctx sdk.Context, k keeper.Keeper, repositoryId uint64, issueIid uint64) (count int) {
+	for _, comment := range k.GetAllIssueComment(ctx, repositoryId, issueIid) {
+		if comment.CommentType == types.CommentTypeCrossReference {
+			count++
+		}
+	}
+	return count
+}
+
+func TestPullRequestIssueReferences(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	for i := 0; i < 2; i++ {
+		_, err := srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId, Title: "title", Description: "description"})
+		require.NoError(t, err)
+	}
+	otherRepositoryId := types.RepositoryId{Id: users[1], Name: "other"}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: users[1], Name: otherRepositoryId.Name, Owner: users[1]})
+	require.NoError(t, err)
+	_, err = srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: users[1], RepositoryId: otherRepositoryId, Title: "title", Description: "description"})
+	require.NoError(t, err)
+
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{
+		Creator:          users[0],
+		Title:            "title",
+		Description:      "Closes #1, see #2, fixes B/other#1 and #99",
+		HeadRepositoryId: repositoryId,
+		HeadBranch:       branches[0],
+		BaseRepositoryId: repositoryId,
+		BaseBranch:       branches[1],
+	})
+	require.NoError(t, err)
+
+	t.Run("Create", func(t *testing.T) {
+		pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(sdkCtx, 0, 1)
+		require.True(t, found)
+		// closing references in other repositories aren't linked
+		require.Len(t, pullRequest.Issues, 1)
+		require.Equal(t, uint64(1), pullRequest.Issues[0].Iid)
+		require.Len(t, pullRequest.CrossReferences, 3)
+
+		issue, found := keepers.GitopiaKeeper.GetRepositoryIssue(sdkCtx, 0, 1)
+		require.True(t, found)
+		require.Len(t, issue.PullRequests, 1)
+		require.Equal(t, uint64(1), issue.PullRequests[0].Iid)
+		require.Equal(t, 1, countCrossReferenceComments(sdkCtx, keepers.GitopiaKeeper, 0, 1))
+
+		issue, found = keepers.GitopiaKeeper.GetRepositoryIssue(sdkCtx, 0, 2)
+		require.True(t, found)
+		require.Empty(t, issue.PullRequests)
+		require.Equal(t, 1, countCrossReferenceComments(sdkCtx, keepers.GitopiaKeeper, 0, 2))
+
+		comment, found := keepers.GitopiaKeeper.GetIssueComment(sdkCtx, 1, 1, 1)
+		require.True(t, found)
+		require.Equal(t, types.CommentTypeCrossReference, comment.CommentType)
+		require.Contains(t, comment.Body, "A/repository#1")
+	})
+	t.Run("Update Description", func(t *testing.T) {
+		_, err := srv.UpdatePullRequestDescription(ctx, &types.MsgUpdatePullRequestDescription{Creator: users[0], RepositoryId: 0, Iid: 1, Description: "Fixes #2"})
+		require.NoError(t, err)
+
+		pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(sdkCtx, 0, 1)
+		require.True(t, found)
+		require.Len(t, pullRequest.Issues, 2)
+		require.Len(t, pullRequest.CrossReferences, 3)
+		// already cross-referenced issues don't get another comment
+		require.Equal(t, 1, countCrossReferenceComments(sdkCtx, keepers.GitopiaKeeper, 0, 2))
+	})
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSetPullRequestCommitMessages
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgSetPullRequestCommitMessages{Creator: "X", RepositoryId: 0, Iid: 1, CommitMessages: []string{"resolves #3"}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "PullRequest Not Exists",
+			request: &types.MsgSetPullRequestCommitMessages{Creator: users[0], RepositoryId: 0, Iid: 10, CommitMessages: []string{"resolves #3"}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgSetPullRequestCommitMessages{Creator: users[1], RepositoryId: 0, Iid: 1, CommitMessages: []string{"resolves #3"}},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgSetPullRequestCommitMessages{Creator: users[0], RepositoryId: 0, Iid: 1, CommitMessages: []string{"refactor parser", "resolves #3"}},
+		},
+		{
+			desc:    "Reported Again",
+			request: &types.MsgSetPullRequestCommitMessages{Creator: users[0], RepositoryId: 0, Iid: 1, CommitMessages: []string{"refactor parser", "resolves #3"}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SetPullRequestCommitMessages(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("Commit Messages", func(t *testing.T) {
+		pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(sdkCtx, 0, 1)
+		require.True(t, found)
+		require.Len(t, pullRequest.Issues, 3)
+		require.Len(t, pullRequest.CrossReferences, 4)
+		require.Equal(t, 1, countCrossReferenceComments(sdkCtx, keepers.GitopiaKeeper, 0, 3))
+
+		issue, found := keepers.GitopiaKeeper.GetRepositoryIssue(sdkCtx, 0, 3)
+		require.True(t, found)
+		require.Len(t, issue.PullRequests, 1)
+	})
+}
@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+func (k msgServer) CreateRepositoryIssueTemplate(goCtx context.Context, msg *types.MsgCreateRepositoryIssueTemplate) (*types.MsgCreateRepositoryIssueTemplateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.RepositoryIssueTemplatePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if err := k.validateIssueTemplate(ctx, repository, msg.LabelIds, msg.Assignees, msg.RequiredLabelGroups); err != nil {
+		return nil, err
+	}
+
+	repository.IssueTemplatesCount += 1
+	var issueTemplate = types.RepositoryIssueTemplate{
+		Id:                  repository.IssueTemplatesCount,
+		Name:                msg.Name,
+		Body:                msg.Body,
+		Labels:              msg.LabelIds,
+		Assignees:           msg.Assignees,
+		RequiredLabelGroups: msg.RequiredLabelGroups,
+		CreatedAt:           ctx.BlockTime().Unix(),
+		UpdatedAt:           ctx.BlockTime().Unix(),
+	}
+
+	repository.IssueTemplates = append(repository.IssueTemplates, &issueTemplate)
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+
+	k.SetRepository(ctx, repository)
+
+	issueTemplateJson, _ := json.Marshal(issueTemplate)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.CreateRepositoryIssueTemplateEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeRepoIssueTemplateKey, string(issueTemplateJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgCreateRepositoryIssueTemplateResponse{Id: issueTemplate.Id}, nil
+}
+
+func (k msgServer) UpdateRepositoryIssueTemplate(goCtx context.Context, msg *types.MsgUpdateRepositoryIssueTemplate) (*types.MsgUpdateRepositoryIssueTemplateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.RepositoryIssueTemplatePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	i, exists := utils.RepositoryIssueTemplateIdExists(repository.IssueTemplates, msg.TemplateId)
+	if !exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue template (%d) doesn't exist", msg.TemplateId))
+	}
+
+	if err := k.validateIssueTemplate(ctx, repository, msg.LabelIds, msg.Assignees, msg.RequiredLabelGroups); err != nil {
+		return nil, err
+	}
+
+	issueTemplate := repository.IssueTemplates[i]
+	issueTemplate.Name = msg.Name
+	issueTemplate.Body = msg.Body
+	issueTemplate.Labels = msg.LabelIds
+	issueTemplate.Assignees = msg.Assignees
+	issueTemplate.RequiredLabelGroups = msg.RequiredLabelGroups
+	issueTemplate.UpdatedAt = ctx.BlockTime().Unix()
+
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetRepository(ctx, repository)
+
+	issueTemplateJson, _ := json.Marshal(issueTemplate)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UpdateRepositoryIssueTemplateEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeRepoIssueTemplateKey, string(issueTemplateJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgUpdateRepositoryIssueTemplateResponse{}, nil
+}
+
+func (k msgServer) DeleteRepositoryIssueTemplate(goCtx context.Context, msg *types.MsgDeleteRepositoryIssueTemplate) (*types.MsgDeleteRepositoryIssueTemplateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.RepositoryIssueTemplatePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	i, exists := utils.RepositoryIssueTemplateIdExists(repository.IssueTemplates, msg.TemplateId)
+	if !exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue template (%d) doesn't exist", msg.TemplateId))
+	}
+
+	repository.IssueTemplates = append(repository.IssueTemplates[:i], repository.IssueTemplates[i+1:]...)
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetRepository(ctx, repository)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.DeleteRepositoryIssueTemplateEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeRepoIssueTemplateIdKey, strconv.FormatUint(msg.TemplateId, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgDeleteRepositoryIssueTemplateResponse{}, nil
+}
+
+// validateIssueTemplate checks that the labels of an issue template exist in the repository
+// and that its default assignees are users
+func (k msgServer) validateIssueTemplate(ctx sdk.Context, repository types.Repository, labelIds []uint64, assignees []string, requiredLabelGroups []*types.IssueTemplateLabelGroup) error {
+	for _, labelId := range labelIds {
+		if _, exists := utils.RepositoryLabelIdExists(repository.Labels, labelId); !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("label id (%v) doesn't exists in repository", labelId))
+		}
+	}
+
+	for _, group := range requiredLabelGroups {
+		for _, labelId := range group.Labels {
+			if _, exists := utils.RepositoryLabelIdExists(repository.Labels, labelId); !exists {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("label id (%v) doesn't exists in repository", labelId))
+			}
+		}
+	}
+
+	for _, assignee := range assignees {
+		if _, found := k.GetUser(ctx, assignee); !found {
+			return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("assignee (%v) doesn't exist", assignee))
+		}
+	}
+
+	return nil
+}
+
+// removeIssueTemplatesLabel removes a deleted label from the issue templates of a repository.
+// Required label groups left without labels are dropped.
+func removeIssueTemplatesLabel(repository *types.Repository, labelId uint64) {
+	removeLabel := func(labels []uint64) []uint64 {
+		var remaining []uint64
+		for _, id := range labels {
+			if id != labelId {
+				remaining = append(remaining, id)
+			}
+		}
+		return remaining
+	}
+
+	for _, issueTemplate := range repository.IssueTemplates {
+		issueTemplate.Labels = removeLabel(issueTemplate.Labels)
+
+		var requiredLabelGroups []*types.IssueTemplateLabelGroup
+		for _, group := range issueTemplate.RequiredLabelGroups {
+			group.Labels = removeLabel(group.Labels)
+			if len(group.Labels) > 0 {
+				requiredLabelGroups = append(requiredLabelGroups, group)
+			}
+		}
+		issueTemplate.RequiredLabelGroups = requiredLabelGroups
+	}
+}
+
+// applyIssueTemplate applies the defaults of an issue template to an issue and checks that the
+// issue has a label of each required label group of the template
+func applyIssueTemplate(issue *types.Issue, issueTemplate *types.RepositoryIssueTemplate) error {
+	if issue.Description == "" {
+		issue.Description = issueTemplate.Body
+	}
+
+	for _, labelId := range issueTemplate.Labels {
+		if _, exists := utils.LabelIdExists(issue.Labels, labelId); !exists {
+			issue.Labels = append(issue.Labels, labelId)
+		}
+	}
+
+	if len(issue.Assignees) == 0 {
+		issue.Assignees = issueTemplate.Assignees
+	}
+
+	for _, group := range issueTemplate.RequiredLabelGroups {
+		satisfied := false
+		for _, labelId := range group.Labels {
+			if _, exists := utils.LabelIdExists(issue.Labels, labelId); exists {
+				satisfied = true
+				break
+			}
+		}
+		if !satisfied {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue template requires a label of group (%v)", group.Name))
+		}
+	}
+
+	return nil
+}
+
+// isRequiredLabel returns true if labelId belongs to a required label group of an issue template
+func isRequiredLabel(issueTemplate *types.RepositoryIssueTemplate, labelId uint64) bool {
+	for _, group := range issueTemplate.RequiredLabelGroups {
+		if _, exists := utils.LabelIdExists(group.Labels, labelId); exists {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func setupPreIssueTemplate(ctx context.Context, t *testing.T, srv types.MsgServer) ([]string, types.RepositoryId) {
+	users, repositoryId := setupPreIssue(ctx, t, srv)
+	for _, name := range []string{"bug", "feature", "docs"} {
+		_, err := srv.CreateRepositoryLabel(ctx, &types.MsgCreateRepositoryLabel{Creator: users[0], RepositoryId: repositoryId, Name: name})
+		require.NoError(t, err)
+	}
+	return users, repositoryId
+}
+
+func TestRepositoryIssueTemplateMsgServerCreate(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+
+	users, repositoryId := setupPreIssueTemplate(ctx, t, srv)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgCreateRepositoryIssueTemplate
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgCreateRepositoryIssueTemplate{Creator: "C", RepositoryId: repositoryId, Name: "bug report"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Repository Not Exists",
+			request: &types.MsgCreateRepositoryIssueTemplate{Creator: users[0], RepositoryId: types.RepositoryId{Id: users[0], Name: "missing"}, Name: "bug report"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgCreateRepositoryIssueTemplate{Creator: users[1], RepositoryId: repositoryId, Name: "bug report"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Label Not Exists",
+			request: &types.MsgCreateRepositoryIssueTemplate{Creator: users[0], RepositoryId: repositoryId, Name: "bug report", LabelIds: []uint64{10}},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Required Label Not Exists",
+			request: &types.MsgCreateRepositoryIssueTemplate{Creator: users[0], RepositoryId: repositoryId, Name: "bug report", RequiredLabelGroups: []*types.IssueTemplateLabelGroup{{Name: "kind", Labels: []uint64{10}}}},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Assignee Not Exists",
+			request: &types.MsgCreateRepositoryIssueTemplate{Creator: users[0], RepositoryId: repositoryId, Name: "bug report", Assignees: []string{"C"}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgCreateRepositoryIssueTemplate{Creator: users[0], RepositoryId: repositoryId, Name: "bug report", Body: "steps to reproduce", LabelIds: []uint64{1}, Assignees: []string{users[1]}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.CreateRepositoryIssueTemplate(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepositoryIssueTemplateMsgServerUpdateDelete(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId := setupPreIssueTemplate(ctx, t, srv)
+	_, err := srv.CreateRepositoryIssueTemplate(ctx, &types.MsgCreateRepositoryIssueTemplate{Creator: users[0], RepositoryId: repositoryId, Name: "bug report"})
+	require.NoError(t, err)
+
+	t.Run("Update", func(t *testing.T) {
+		_, err := srv.UpdateRepositoryIssueTemplate(ctx, &types.MsgUpdateRepositoryIssueTemplate{Creator: users[0], RepositoryId: repositoryId, TemplateId: 10, Name: "bug report"})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		_, err = srv.UpdateRepositoryIssueTemplate(ctx, &types.MsgUpdateRepositoryIssueTemplate{Creator: users[1], RepositoryId: repositoryId, TemplateId: 1, Name: "bug report"})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+		_, err = srv.UpdateRepositoryIssueTemplate(ctx, &types.MsgUpdateRepositoryIssueTemplate{
+			Creator:             users[0],
+			RepositoryId:        repositoryId,
+			TemplateId:          1,
+			Name:                "feature request",
+			LabelIds:            []uint64{3},
+			RequiredLabelGroups: []*types.IssueTemplateLabelGroup{{Name: "kind", Labels: []uint64{1, 2}}, {Name: "area", Labels: []uint64{3}}},
+		})
+		require.NoError(t, err)
+
+		repository, found := keepers.GitopiaKeeper.GetRepositoryById(sdkCtx, 0)
+		require.True(t, found)
+		require.Equal(t, "feature request", repository.IssueTemplates[0].Name)
+		require.Len(t, repository.IssueTemplates[0].RequiredLabelGroups, 2)
+	})
+	t.Run("Delete Label", func(t *testing.T) {
+		_, err := srv.DeleteRepositoryLabel(ctx, &types.MsgDeleteRepositoryLabel{Creator: users[0], RepositoryId: repositoryId, LabelId: 3})
+		require.NoError(t, err)
+
+		// the deleted label is removed from the template and its emptied group dropped
+		repository, found := keepers.GitopiaKeeper.GetRepositoryById(sdkCtx, 0)
+		require.True(t, found)
+		require.Empty(t, repository.IssueTemplates[0].Labels)
+		require.Len(t, repository.IssueTemplates[0].RequiredLabelGroups, 1)
+		require.Equal(t, "kind", repository.IssueTemplates[0].RequiredLabelGroups[0].Name)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		_, err := srv.DeleteRepositoryIssueTemplate(ctx, &types.MsgDeleteRepositoryIssueTemplate{Creator: users[1], RepositoryId: repositoryId, TemplateId: 1})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		_, err = srv.DeleteRepositoryIssueTemplate(ctx, &types.MsgDeleteRepositoryIssueTemplate{Creator: users[0], RepositoryId: repositoryId, TemplateId: 1})
+		require.NoError(t, err)
+		_, err = srv.DeleteRepositoryIssueTemplate(ctx, &types.MsgDeleteRepositoryIssueTemplate{Creator: users[0], RepositoryId: repositoryId, TemplateId: 1})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+}
+
+func TestIssueMsgServerCreateFromTemplate(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId := setupPreIssueTemplate(ctx, t, srv)
+	_, err := srv.CreateRepositoryIssueTemplate(ctx, &types.MsgCreateRepositoryIssueTemplate{
+		Creator:             users[0],
+		RepositoryId:        repositoryId,
+		Name:                "bug report",
+		Body:                "steps to reproduce",
+		LabelIds:            []uint64{1},
+		Assignees:           []string{users[0]},
+		RequiredLabelGroups: []*types.IssueTemplateLabelGroup{{Name: "area", Labels: []uint64{2, 3}}},
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgCreateIssue
+		err     error
+	}{
+		{
+			desc:    "Template Not Exists",
+			request: &types.MsgCreateIssue{Creator: users[1], RepositoryId: repositoryId, Title: "title", LabelIds: []uint64{2}, TemplateId: 10},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Required Label Missing",
+			request: &types.MsgCreateIssue{Creator: users[1], RepositoryId: repositoryId, Title: "title", TemplateId: 1},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Label Outside Required Groups Unauthorized",
+			request: &types.MsgCreateIssue{Creator: users[1], RepositoryId: repositoryId, Title: "title", LabelIds: []uint64{1, 2}, TemplateId: 1},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgCreateIssue{Creator: users[1], RepositoryId: repositoryId, Title: "title", LabelIds: []uint64{2}, TemplateId: 1},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.CreateIssue(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("Template Defaults", func(t *testing.T) {
+		issue, found := keepers.GitopiaKeeper.GetRepositoryIssue(sdkCtx, 0, 1)
+		require.True(t, found)
+		require.Equal(t, "steps to reproduce", issue.Description)
+		require.ElementsMatch(t, []uint64{1, 2}, issue.Labels)
+		require.Equal(t, []string{users[0]}, issue.Assignees)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
@@ -428,6 +429,105 @@ func TestIssueMsgServerDelete(t *testing.T) {
 	}
 }
 
+func TestIssueMsgServerTransfer(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId := setupPreIssue(ctx, t, srv)
+	targetRepositoryId := types.RepositoryId{Id: users[1], Name: "target"}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: users[1], Name: targetRepositoryId.Name, Owner: users[1]})
+	require.NoError(t, err)
+
+	for _, name := range []string{"bug", "docs"} {
+		_, err = srv.CreateRepositoryLabel(ctx, &types.MsgCreateRepositoryLabel{Creator: users[0], RepositoryId: repositoryId, Name: name})
+		require.NoError(t, err)
+	}
+	for _, name := range []string{"feature", "bug"} {
+		_, err = srv.CreateRepositoryLabel(ctx, &types.MsgCreateRepositoryLabel{Creator: users[1], RepositoryId: targetRepositoryId, Name: name})
+		require.NoError(t, err)
+	}
+
+	_, err = srv.CreateUser(ctx, &types.MsgCreateUser{Creator: "C", Username: "C"})
+	require.NoError(t, err)
+	_, err = srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId, Title: "title", Description: "description", LabelIds: []uint64{1, 2}, Assignees: []string{users[1], "C"}})
+	require.NoError(t, err)
+	_, err = srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[1], RepositoryId: 0, Parent: types.CommentParentIssue, ParentIid: 1, Body: "comment"})
+	require.NoError(t, err)
+	_, err = srv.UpdateComment(ctx, &types.MsgUpdateComment{Creator: users[1], RepositoryId: 0, Parent: types.CommentParentIssue, ParentIid: 1, CommentIid: 1, Body: "edited comment"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgTransferIssue
+		err     error
+	}{
+		{
+			desc:    "Issue Not Exists",
+			request: &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 10, TargetRepositoryId: targetRepositoryId},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Same Repository",
+			request: &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 1, TargetRepositoryId: repositoryId},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Unauthorized In Source",
+			request: &types.MsgTransferIssue{Creator: users[1], RepositoryId: 0, Iid: 1, TargetRepositoryId: targetRepositoryId},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Unauthorized In Target",
+			request: &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 1, TargetRepositoryId: targetRepositoryId},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.TransferIssue(ctx, tc.request)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	_, err = srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: users[1], RepositoryId: targetRepositoryId, User: users[0], Role: "TRIAGE"})
+	require.NoError(t, err)
+	res, err := srv.TransferIssue(ctx, &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 1, TargetRepositoryId: targetRepositoryId})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Iid)
+
+	t.Run("Target", func(t *testing.T) {
+		issue, found := keepers.GitopiaKeeper.GetRepositoryIssue(sdkCtx, 1, 1)
+		require.True(t, found)
+		require.Equal(t, res.Id, issue.Id)
+		require.Equal(t, "description", issue.Description)
+		// labels are remapped by name
+		require.Equal(t, []uint64{2}, issue.Labels)
+		// assignees who aren't collaborators of the target are dropped
+		require.Equal(t, []string{users[1]}, issue.Assignees)
+
+		comments := keepers.GitopiaKeeper.GetAllIssueComment(sdkCtx, 1, 1)
+		require.Len(t, comments, 2)
+		require.Equal(t, "edited comment", comments[0].Body)
+		require.Equal(t, types.CommentTypeIssueTransferred, comments[1].CommentType)
+		require.Len(t, keepers.GitopiaKeeper.GetAllCommentRevisionForComment(sdkCtx, 1, types.CommentParentIssue, 1, 1), 1)
+	})
+	t.Run("Tombstone", func(t *testing.T) {
+		issue, found := keepers.GitopiaKeeper.GetRepositoryIssue(sdkCtx, 0, 1)
+		require.True(t, found)
+		require.Equal(t, types.Issue_CLOSED, issue.State)
+		require.Equal(t, &types.IssueTransfer{RepositoryId: 1, Iid: 1}, issue.TransferredTo)
+
+		comments := keepers.GitopiaKeeper.GetAllIssueComment(sdkCtx, 0, 1)
+		require.Len(t, comments, 1)
+		require.Contains(t, comments[0].Body, "B/target#1")
+		require.Empty(t, keepers.GitopiaKeeper.GetAllCommentRevisionForParent(sdkCtx, 0, types.CommentParentIssue, 1))
+
+		_, err := srv.ToggleIssueState(ctx, &types.MsgToggleIssueState{Creator: users[0], RepositoryId: 0, Iid: 1})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		_, err = srv.TransferIssue(ctx, &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 1, TargetRepositoryId: targetRepositoryId})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+}
+
 func setupPreIssue(ctx context.Context, t *testing.T, srv types.MsgServer) (users []string, repositoryId types.RepositoryId) {
 	users = append(users, "A", "B")
 	repositoryId = types.RepositoryId{
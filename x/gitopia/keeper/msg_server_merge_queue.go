@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func (k msgServer) AddPullRequestToMergeQueue(goCtx context.Context, msg *types.MsgAddPullRequestToMergeQueue) (*types.MsgAddPullRequestToMergeQueueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	baseRepository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(baseRepository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, baseRepository, types.PullRequestMergeQueuePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if pullRequest.State != types.PullRequest_OPEN {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is not open", msg.Iid))
+	}
+
+	if pullRequest.Draft {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is a draft", msg.Iid))
+	}
+
+	if err := CheckPullRequestMergeMethodAllowed(baseRepository, pullRequest, msg.MergeMethod); err != nil {
+		return nil, err
+	}
+
+	if err := k.CheckPullRequestMergeAllowed(ctx, msg.Creator, baseRepository, pullRequest); err != nil {
+		return nil, err
+	}
+
+	mergeQueue, found := k.GetRepositoryMergeQueue(ctx, baseRepository.Id, pullRequest.Base.Branch)
+	if !found {
+		mergeQueue = types.MergeQueue{
+			RepositoryId: baseRepository.Id,
+			Branch:       pullRequest.Base.Branch,
+		}
+	}
+
+	if _, exists := GetMergeQueueEntryPosition(mergeQueue, pullRequest.Iid); exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is already in the merge queue", msg.Iid))
+	}
+
+	position := uint64(len(mergeQueue.Entries))
+	mergeQueue.Entries = append(mergeQueue.Entries, types.MergeQueueEntry{
+		PullRequestIid: pullRequest.Iid,
+		Creator:        msg.Creator,
+		Provider:       msg.Provider,
+		MergeMethod:    msg.MergeMethod,
+		CreatedAt:      ctx.BlockTime().Unix(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AddPullRequestToMergeQueueEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(baseRepository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoBranchKey, pullRequest.Base.Branch),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestMergeMethodKey, msg.MergeMethod.String()),
+			sdk.NewAttribute(types.EventAttributeMergeQueuePositionKey, strconv.FormatUint(position, 10)),
+		),
+	)
+
+	k.AdvanceMergeQueue(ctx, &mergeQueue)
+	k.SetRepositoryMergeQueue(ctx, mergeQueue)
+
+	return &types.MsgAddPullRequestToMergeQueueResponse{
+		Position: position,
+	}, nil
+}
+
+func (k msgServer) RemovePullRequestFromMergeQueue(goCtx context.Context, msg *types.MsgRemovePullRequestFromMergeQueue) (*types.MsgRemovePullRequestFromMergeQueueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	baseRepository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	mergeQueue, _ := k.GetRepositoryMergeQueue(ctx, baseRepository.Id, pullRequest.Base.Branch)
+	i, exists := GetMergeQueueEntryPosition(mergeQueue, pullRequest.Iid)
+	if !exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) is not in the merge queue", msg.Iid))
+	}
+
+	if msg.Creator != mergeQueue.Entries[i].Creator && !k.HavePermission(ctx, msg.Creator, baseRepository, types.PullRequestMergeQueuePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	k.DropPullRequestFromMergeQueue(ctx, pullRequest)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.RemovePullRequestFromMergeQueueEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(baseRepository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoBranchKey, pullRequest.Base.Branch),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+		),
+	)
+
+	return &types.MsgRemovePullRequestFromMergeQueueResponse{}, nil
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func TestMergeQueueMsgServerAdd(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[2], Draft: true})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgAddPullRequestToMergeQueue
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgAddPullRequestToMergeQueue{Creator: "C", RepositoryId: 0, Iid: 1, Provider: users[0]},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "PullRequest Not Exists",
+			request: &types.MsgAddPullRequestToMergeQueue{Creator: users[0], RepositoryId: 0, Iid: 10, Provider: users[0]},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgAddPullRequestToMergeQueue{Creator: users[1], RepositoryId: 0, Iid: 1, Provider: users[0]},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Draft PullRequest",
+			request: &types.MsgAddPullRequestToMergeQueue{Creator: users[0], RepositoryId: 0, Iid: 2, Provider: users[0]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgAddPullRequestToMergeQueue{Creator: users[0], RepositoryId: 0, Iid: 1, Provider: users[0]},
+		},
+		{
+			desc:    "Already Queued",
+			request: &types.MsgAddPullRequestToMergeQueue{Creator: users[0], RepositoryId: 0, Iid: 1, Provider: users[0]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.AddPullRequestToMergeQueue(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMergeQueueMsgServerRemove(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[2], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	for _, iid := range []uint64{1, 2} {
+		_, err = srv.AddPullRequestToMergeQueue(ctx, &types.MsgAddPullRequestToMergeQueue{Creator: users[0], RepositoryId: 0, Iid: iid, Provider: users[0]})
+		require.NoError(t, err)
+	}
+
+	t.Run("Not Queued", func(t *testing.T) {
+		_, err := srv.RemovePullRequestFromMergeQueue(ctx, &types.MsgRemovePullRequestFromMergeQueue{Creator: users[0], RepositoryId: 0, Iid: 10})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	})
+	t.Run("Unauthorized", func(t *testing.T) {
+		_, err := srv.RemovePullRequestFromMergeQueue(ctx, &types.MsgRemovePullRequestFromMergeQueue{Creator: users[1], RepositoryId: 0, Iid: 1})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Completed", func(t *testing.T) {
+		_, err := srv.RemovePullRequestFromMergeQueue(ctx, &types.MsgRemovePullRequestFromMergeQueue{Creator: users[0], RepositoryId: 0, Iid: 1})
+		require.NoError(t, err)
+
+		// the next pull request is handed to the git server
+		mergeQueue, found := keepers.GitopiaKeeper.GetRepositoryMergeQueue(sdkCtx, 0, branches[1])
+		require.True(t, found)
+		require.Len(t, mergeQueue.Entries, 1)
+		require.Equal(t, uint64(2), mergeQueue.Entries[0].PullRequestIid)
+		require.True(t, mergeQueue.Entries[0].Testing)
+	})
+	t.Run("Closed PullRequest", func(t *testing.T) {
+		_, err := srv.SetPullRequestState(ctx, &types.MsgSetPullRequestState{Creator: users[0], RepositoryId: 0, Iid: 2, State: "CLOSED"})
+		require.NoError(t, err)
+
+		_, found := keepers.GitopiaKeeper.GetRepositoryMergeQueue(sdkCtx, 0, branches[1])
+		require.False(t, found)
+	})
+}
+
+func TestMergeQueueTaskResult(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[2], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	for i, iid := range []uint64{1, 2} {
+		res, err := srv.AddPullRequestToMergeQueue(ctx, &types.MsgAddPullRequestToMergeQueue{Creator: users[0], RepositoryId: 0, Iid: iid, Provider: users[0], MergeMethod: types.MergeMethod_SQUASH})
+		require.NoError(t, err)
+		require.Equal(t, uint64(i), res.Position)
+	}
+
+	getHead := func() types.MergeQueueEntry {
+		res, err := keepers.GitopiaKeeper.RepositoryMergeQueue(ctx, &types.QueryGetRepositoryMergeQueueRequest{Id: users[0], RepositoryName: "repository", BranchName: branches[1]})
+		require.NoError(t, err)
+		require.NotEmpty(t, res.MergeQueue.Entries)
+		return res.MergeQueue.Entries[0]
+	}
+
+	t.Run("Test Failed", func(t *testing.T) {
+		head := getHead()
+		require.Equal(t, uint64(1), head.PullRequestIid)
+		require.True(t, head.Testing)
+		task, found := keepers.GitopiaKeeper.GetTask(sdkCtx, head.TaskId)
+		require.True(t, found)
+		require.Equal(t, types.TypeTestMergeQueueEntry, task.Type)
+
+		_, err := srv.UpdateTask(ctx, &types.MsgUpdateTask{Creator: users[0], Id: head.TaskId, State: types.StateFailure, Message: "conflict"})
+		require.NoError(t, err)
+
+		head = getHead()
+		require.Equal(t, uint64(2), head.PullRequestIid)
+		require.True(t, head.Testing)
+	})
+	t.Run("Test Passed", func(t *testing.T) {
+		head := getHead()
+		taskCount := keepers.GitopiaKeeper.GetTaskCount(sdkCtx)
+
+		_, err := srv.UpdateTask(ctx, &types.MsgUpdateTask{Creator: users[0], Id: head.TaskId, State: types.StateSuccess})
+		require.NoError(t, err)
+
+		task, found := keepers.GitopiaKeeper.GetTask(sdkCtx, taskCount)
+		require.True(t, found)
+		require.Equal(t, types.TypeSetPullRequestState, task.Type)
+		pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(sdkCtx, 0, 2)
+		require.True(t, found)
+		require.Equal(t, types.MergeMethod_SQUASH, pullRequest.MergeMethod)
+
+		// the entry stays at the front of the queue until the merge is recorded
+		head = getHead()
+		require.Equal(t, uint64(2), head.PullRequestIid)
+		require.True(t, head.Testing)
+		require.Equal(t, taskCount, head.TaskId)
+	})
+	t.Run("Merge Failed", func(t *testing.T) {
+		_, err := srv.AddPullRequestToMergeQueue(ctx, &types.MsgAddPullRequestToMergeQueue{Creator: users[0], RepositoryId: 0, Iid: 1, Provider: users[0], MergeMethod: types.MergeMethod_SQUASH})
+		require.NoError(t, err)
+		head := getHead()
+
+		_, err = srv.UpdateTask(ctx, &types.MsgUpdateTask{Creator: users[0], Id: head.TaskId, State: types.StateFailure, Message: "conflict"})
+		require.NoError(t, err)
+
+		pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(sdkCtx, 0, 2)
+		require.True(t, found)
+		require.Equal(t, types.PullRequest_OPEN, pullRequest.State)
+		head = getHead()
+		require.Equal(t, uint64(1), head.PullRequestIid)
+		require.True(t, head.Testing)
+	})
+	t.Run("Merged", func(t *testing.T) {
+		head := getHead()
+		_, err := srv.UpdateTask(ctx, &types.MsgUpdateTask{Creator: users[0], Id: head.TaskId, State: types.StateSuccess})
+		require.NoError(t, err)
+		head = getHead()
+		require.Equal(t, uint64(1), head.PullRequestIid)
+
+		_, err = srv.SetPullRequestState(ctx, &types.MsgSetPullRequestState{Creator: users[0], RepositoryId: 0, Iid: 1, State: "MERGED", MergeCommitSha: strings.Repeat("c", 40), TaskId: head.TaskId, MergeMethod: types.MergeMethod_SQUASH})
+		require.NoError(t, err)
+
+		_, found := keepers.GitopiaKeeper.GetRepositoryMergeQueue(sdkCtx, 0, branches[1])
+		require.False(t, found)
+		_, _, found = keepers.GitopiaKeeper.GetMergeQueueTask(sdkCtx, head.TaskId)
+		require.False(t, found)
+	})
+}
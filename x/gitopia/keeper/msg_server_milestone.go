@@ -0,0 +1,412 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+func (k msgServer) CreateRepositoryMilestone(goCtx context.Context, msg *types.MsgCreateRepositoryMilestone) (*types.MsgCreateRepositoryMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.RepositoryMilestonePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	repository.MilestonesCount += 1
+	var milestone = types.RepositoryMilestone{
+		Id:          repository.MilestonesCount,
+		Title:       msg.Title,
+		Description: msg.Description,
+		DueDate:     msg.DueDate,
+		State:       types.RepositoryMilestone_OPEN,
+		CreatedAt:   ctx.BlockTime().Unix(),
+		UpdatedAt:   ctx.BlockTime().Unix(),
+	}
+
+	repository.Milestones = append(repository.Milestones, &milestone)
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+
+	k.SetRepository(ctx, repository)
+
+	milestoneJson, _ := json.Marshal(milestone)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.CreateRepositoryMilestoneEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeRepoMilestoneKey, string(milestoneJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgCreateRepositoryMilestoneResponse{Id: milestone.Id}, nil
+}
+
+func (k msgServer) UpdateRepositoryMilestone(goCtx context.Context, msg *types.MsgUpdateRepositoryMilestone) (*types.MsgUpdateRepositoryMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.RepositoryMilestonePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	i, exists := utils.RepositoryMilestoneIdExists(repository.Milestones, msg.MilestoneId)
+	if !exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("milestone (%d) doesn't exist", msg.MilestoneId))
+	}
+
+	milestone := repository.Milestones[i]
+	milestone.Title = msg.Title
+	milestone.Description = msg.Description
+	milestone.DueDate = msg.DueDate
+	milestone.UpdatedAt = ctx.BlockTime().Unix()
+
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetRepository(ctx, repository)
+
+	milestoneJson, _ := json.Marshal(milestone)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UpdateRepositoryMilestoneEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeRepoMilestoneKey, string(milestoneJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgUpdateRepositoryMilestoneResponse{}, nil
+}
+
+func (k msgServer) ToggleRepositoryMilestoneState(goCtx context.Context, msg *types.MsgToggleRepositoryMilestoneState) (*types.MsgToggleRepositoryMilestoneStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.RepositoryMilestonePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	i, exists := utils.RepositoryMilestoneIdExists(repository.Milestones, msg.MilestoneId)
+	if !exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("milestone (%d) doesn't exist", msg.MilestoneId))
+	}
+
+	milestone := repository.Milestones[i]
+	if milestone.State == types.RepositoryMilestone_OPEN {
+		milestone.State = types.RepositoryMilestone_CLOSED
+		milestone.ClosedAt = ctx.BlockTime().Unix()
+	} else {
+		milestone.State = types.RepositoryMilestone_OPEN
+		milestone.ClosedAt = 0
+	}
+	milestone.UpdatedAt = ctx.BlockTime().Unix()
+
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetRepository(ctx, repository)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ToggleRepositoryMilestoneStateEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeRepoMilestoneIdKey, strconv.FormatUint(milestone.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoMilestoneStateKey, milestone.State.String()),
+			sdk.NewAttribute(types.EventAttributeClosedAtKey, strconv.FormatInt(milestone.ClosedAt, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgToggleRepositoryMilestoneStateResponse{State: milestone.State.String()}, nil
+}
+
+func (k msgServer) DeleteRepositoryMilestone(goCtx context.Context, msg *types.MsgDeleteRepositoryMilestone) (*types.MsgDeleteRepositoryMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.RepositoryMilestonePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	i, exists := utils.RepositoryMilestoneIdExists(repository.Milestones, msg.MilestoneId)
+	if !exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("milestone (%d) doesn't exist", msg.MilestoneId))
+	}
+
+	repository.Milestones = append(repository.Milestones[:i], repository.Milestones[i+1:]...)
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetRepository(ctx, repository)
+
+	k.RemoveRepositoryMilestoneFromAll(ctx, repository.Id, msg.MilestoneId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.DeleteRepositoryMilestoneEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeRepoMilestoneIdKey, strconv.FormatUint(msg.MilestoneId, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgDeleteRepositoryMilestoneResponse{}, nil
+}
+
+// getMilestoneChangeComment validates a milestone change of an issue or pull request and
+// returns the body and type of the system comment recording it. A zero milestone id clears the milestone.
+func getMilestoneChangeComment(creator string, repository types.Repository, currentMilestoneId uint64, milestoneId uint64) (string, types.CommentType, error) {
+	if milestoneId == currentMilestoneId {
+		if milestoneId == 0 {
+			return "", types.CommentTypeNone, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "milestone is not set")
+		}
+		return "", types.CommentTypeNone, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("milestone (%d) is already set", milestoneId))
+	}
+
+	if milestoneId == 0 {
+		var title string
+		if i, exists := utils.RepositoryMilestoneIdExists(repository.Milestones, currentMilestoneId); exists {
+			title = repository.Milestones[i].Title
+		}
+		return utils.RemoveMilestoneCommentBody(creator, title), types.CommentTypeMilestoneRemoved, nil
+	}
+
+	i, exists := utils.RepositoryMilestoneIdExists(repository.Milestones, milestoneId)
+	if !exists {
+		return "", types.CommentTypeNone, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("milestone (%d) doesn't exist in repository", milestoneId))
+	}
+
+	return utils.AddMilestoneCommentBody(creator, repository.Milestones[i].Title), types.CommentTypeMilestoneAdded, nil
+}
+
+func (k msgServer) SetIssueMilestone(goCtx context.Context, msg *types.MsgSetIssueMilestone) (*types.MsgSetIssueMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	issue, found := k.GetRepositoryIssue(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	if err := CheckIssueNotTransferred(issue); err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.MilestonePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	commentBody, commentType, err := getMilestoneChangeComment(msg.Creator, repository, issue.Milestone, msg.MilestoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	k.MoveIssueToMilestone(ctx, &issue, msg.MilestoneId)
+	issue.CommentsCount += 1
+	issue.UpdatedAt = ctx.BlockTime().Unix()
+
+	var comment = types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: issue.RepositoryId,
+		ParentIid:    msg.Iid,
+		Parent:       types.CommentParentIssue,
+		CommentIid:   issue.CommentsCount,
+		Body:         commentBody,
+		System:       true,
+		CreatedAt:    issue.UpdatedAt,
+		UpdatedAt:    issue.UpdatedAt,
+		CommentType:  commentType,
+	}
+
+	k.AppendComment(
+		ctx,
+		comment,
+	)
+	k.SetIssue(ctx, issue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetIssueMilestoneEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIdKey, strconv.FormatUint(issue.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoMilestoneIdKey, strconv.FormatUint(issue.Milestone, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(issue.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetIssueMilestoneResponse{}, nil
+}
+
+func (k msgServer) SetPullRequestMilestone(goCtx context.Context, msg *types.MsgSetPullRequestMilestone) (*types.MsgSetPullRequestMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.MilestonePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	commentBody, commentType, err := getMilestoneChangeComment(msg.Creator, repository, pullRequest.Milestone, msg.MilestoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	k.MovePullRequestToMilestone(ctx, &pullRequest, msg.MilestoneId)
+	pullRequest.CommentsCount += 1
+	pullRequest.UpdatedAt = ctx.BlockTime().Unix()
+
+	var comment = types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: pullRequest.Base.RepositoryId,
+		ParentIid:    pullRequest.Iid,
+		Parent:       types.CommentParentPullRequest,
+		CommentIid:   pullRequest.CommentsCount,
+		Body:         commentBody,
+		System:       true,
+		CreatedAt:    pullRequest.UpdatedAt,
+		UpdatedAt:    pullRequest.UpdatedAt,
+		CommentType:  commentType,
+	}
+
+	k.AppendComment(
+		ctx,
+		comment,
+	)
+	k.SetPullRequest(ctx, pullRequest)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetPullRequestMilestoneEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoMilestoneIdKey, strconv.FormatUint(pullRequest.Milestone, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetPullRequestMilestoneResponse{}, nil
+}
@@ -0,0 +1,321 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func TestRepositoryMilestoneMsgServerCreate(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+
+	users, repositoryId, _, _ := setupPreComment(ctx, t, srv)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgCreateRepositoryMilestone
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgCreateRepositoryMilestone{Creator: "C", RepositoryId: repositoryId, Title: "v1.0"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Repository Not Exists",
+			request: &types.MsgCreateRepositoryMilestone{Creator: users[0], RepositoryId: types.RepositoryId{Id: users[0], Name: "missing"}, Title: "v1.0"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgCreateRepositoryMilestone{Creator: users[1], RepositoryId: repositoryId, Title: "v1.0"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgCreateRepositoryMilestone{Creator: users[0], RepositoryId: repositoryId, Title: "v1.0", DueDate: 1700000000},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.CreateRepositoryMilestone(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepositoryMilestoneMsgServerUpdate(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.CreateRepositoryMilestone(ctx, &types.MsgCreateRepositoryMilestone{Creator: users[0], RepositoryId: repositoryId, Title: "v1.0"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgUpdateRepositoryMilestone
+		err     error
+	}{
+		{
+			desc:    "Milestone Not Exists",
+			request: &types.MsgUpdateRepositoryMilestone{Creator: users[0], RepositoryId: repositoryId, MilestoneId: 10, Title: "v1.1"},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgUpdateRepositoryMilestone{Creator: users[1], RepositoryId: repositoryId, MilestoneId: 1, Title: "v1.1"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgUpdateRepositoryMilestone{Creator: users[0], RepositoryId: repositoryId, MilestoneId: 1, Title: "v1.1", Description: "next release", DueDate: 1700000000},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.UpdateRepositoryMilestone(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	repository, found := keepers.GitopiaKeeper.GetRepositoryById(sdkCtx, 0)
+	require.True(t, found)
+	require.Len(t, repository.Milestones, 1)
+	require.Equal(t, "v1.1", repository.Milestones[0].Title)
+	require.Equal(t, "next release", repository.Milestones[0].Description)
+	require.Equal(t, int64(1700000000), repository.Milestones[0].DueDate)
+}
+
+func TestRepositoryMilestoneMsgServerToggleState(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+
+	users, repositoryId, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.CreateRepositoryMilestone(ctx, &types.MsgCreateRepositoryMilestone{Creator: users[0], RepositoryId: repositoryId, Title: "v1.0"})
+	require.NoError(t, err)
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		_, err := srv.ToggleRepositoryMilestoneState(ctx, &types.MsgToggleRepositoryMilestoneState{Creator: users[1], RepositoryId: repositoryId, MilestoneId: 1})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Close", func(t *testing.T) {
+		res, err := srv.ToggleRepositoryMilestoneState(ctx, &types.MsgToggleRepositoryMilestoneState{Creator: users[0], RepositoryId: repositoryId, MilestoneId: 1})
+		require.NoError(t, err)
+		require.Equal(t, types.RepositoryMilestone_CLOSED.String(), res.State)
+	})
+	t.Run("Reopen", func(t *testing.T) {
+		res, err := srv.ToggleRepositoryMilestoneState(ctx, &types.MsgToggleRepositoryMilestoneState{Creator: users[0], RepositoryId: repositoryId, MilestoneId: 1})
+		require.NoError(t, err)
+		require.Equal(t, types.RepositoryMilestone_OPEN.String(), res.State)
+	})
+}
+
+func TestRepositoryMilestoneMsgServerSetIssueMilestone(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.CreateRepositoryMilestone(ctx, &types.MsgCreateRepositoryMilestone{Creator: users[0], RepositoryId: repositoryId, Title: "v1.0"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSetIssueMilestone
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgSetIssueMilestone{Creator: "C", RepositoryId: 0, Iid: 1, MilestoneId: 1},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Issue Not Exists",
+			request: &types.MsgSetIssueMilestone{Creator: users[0], RepositoryId: 0, Iid: 10, MilestoneId: 1},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgSetIssueMilestone{Creator: users[1], RepositoryId: 0, Iid: 1, MilestoneId: 1},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Milestone Not Exists",
+			request: &types.MsgSetIssueMilestone{Creator: users[0], RepositoryId: 0, Iid: 1, MilestoneId: 10},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Milestone Not Set",
+			request: &types.MsgSetIssueMilestone{Creator: users[0], RepositoryId: 0, Iid: 1, MilestoneId: 0},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgSetIssueMilestone{Creator: users[0], RepositoryId: 0, Iid: 1, MilestoneId: 1},
+		},
+		{
+			desc:    "Milestone Already Set",
+			request: &types.MsgSetIssueMilestone{Creator: users[0], RepositoryId: 0, Iid: 1, MilestoneId: 1},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SetIssueMilestone(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	issue, found := keepers.GitopiaKeeper.GetRepositoryIssue(sdkCtx, 0, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), issue.Milestone)
+	comment, found := keepers.GitopiaKeeper.GetIssueComment(sdkCtx, 0, 1, issue.CommentsCount)
+	require.True(t, found)
+	require.True(t, comment.System)
+	require.Equal(t, types.CommentTypeMilestoneAdded, comment.CommentType)
+
+	t.Run("Clear Milestone", func(t *testing.T) {
+		_, err := srv.SetIssueMilestone(ctx, &types.MsgSetIssueMilestone{Creator: users[0], RepositoryId: 0, Iid: 1, MilestoneId: 0})
+		require.NoError(t, err)
+
+		issue, found := keepers.GitopiaKeeper.GetRepositoryIssue(sdkCtx, 0, 1)
+		require.True(t, found)
+		require.Zero(t, issue.Milestone)
+		comment, found := keepers.GitopiaKeeper.GetIssueComment(sdkCtx, 0, 1, issue.CommentsCount)
+		require.True(t, found)
+		require.Equal(t, types.CommentTypeMilestoneRemoved, comment.CommentType)
+	})
+}
+
+func TestRepositoryMilestoneMsgServerSetPullRequestMilestone(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.CreateRepositoryMilestone(ctx, &types.MsgCreateRepositoryMilestone{Creator: users[0], RepositoryId: repositoryId, Title: "v1.0"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSetPullRequestMilestone
+		err     error
+	}{
+		{
+			desc:    "PullRequest Not Exists",
+			request: &types.MsgSetPullRequestMilestone{Creator: users[0], RepositoryId: 0, Iid: 10, MilestoneId: 1},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgSetPullRequestMilestone{Creator: users[1], RepositoryId: 0, Iid: 1, MilestoneId: 1},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgSetPullRequestMilestone{Creator: users[0], RepositoryId: 0, Iid: 1, MilestoneId: 1},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SetPullRequestMilestone(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(sdkCtx, 0, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), pullRequest.Milestone)
+}
+
+func TestRepositoryMilestoneProgress(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.CreateRepositoryMilestone(ctx, &types.MsgCreateRepositoryMilestone{Creator: users[0], RepositoryId: repositoryId, Title: "v1.0"})
+	require.NoError(t, err)
+	_, err = srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId})
+	require.NoError(t, err)
+	_, err = srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId})
+	require.NoError(t, err)
+
+	for _, iid := range []uint64{1, 2} {
+		_, err = srv.SetIssueMilestone(ctx, &types.MsgSetIssueMilestone{Creator: users[0], RepositoryId: 0, Iid: iid, MilestoneId: 1})
+		require.NoError(t, err)
+	}
+	_, err = srv.ToggleIssueState(ctx, &types.MsgToggleIssueState{Creator: users[0], RepositoryId: 0, Iid: 2})
+	require.NoError(t, err)
+	_, err = srv.SetPullRequestMilestone(ctx, &types.MsgSetPullRequestMilestone{Creator: users[0], RepositoryId: 0, Iid: 1, MilestoneId: 1})
+	require.NoError(t, err)
+
+	t.Run("Progress", func(t *testing.T) {
+		res, err := keepers.GitopiaKeeper.RepositoryMilestone(ctx, &types.QueryGetRepositoryMilestoneRequest{Id: users[0], RepositoryName: repositoryId.Name, MilestoneId: 1})
+		require.NoError(t, err)
+		require.Equal(t, "v1.0", res.Milestone.Title)
+		require.Equal(t, uint64(1), res.OpenIssuesCount)
+		require.Equal(t, uint64(1), res.ClosedIssuesCount)
+		require.Equal(t, uint64(1), res.OpenPullRequestsCount)
+		require.Zero(t, res.ClosedPullRequestsCount)
+	})
+	t.Run("Issue Filter", func(t *testing.T) {
+		res, err := keepers.GitopiaKeeper.RepositoryIssueAll(ctx, &types.QueryAllRepositoryIssueRequest{Id: users[0], RepositoryName: repositoryId.Name, Option: &types.IssueOptions{MilestoneId: 1}})
+		require.NoError(t, err)
+		require.Len(t, res.Issue, 2)
+
+		res, err = keepers.GitopiaKeeper.RepositoryIssueAll(ctx, &types.QueryAllRepositoryIssueRequest{Id: users[0], RepositoryName: repositoryId.Name, Option: &types.IssueOptions{Milestone: "NONE"}})
+		require.NoError(t, err)
+		require.Len(t, res.Issue, 1)
+		require.Equal(t, uint64(3), res.Issue[0].Iid)
+	})
+	t.Run("PullRequest Filter", func(t *testing.T) {
+		res, err := keepers.GitopiaKeeper.RepositoryPullRequestAll(ctx, &types.QueryAllRepositoryPullRequestRequest{Id: users[0], RepositoryName: repositoryId.Name, Option: &types.PullRequestOptions{Milestone: "ANY"}})
+		require.NoError(t, err)
+		require.Len(t, res.PullRequest, 1)
+	})
+	t.Run("Move Issue", func(t *testing.T) {
+		_, err := srv.CreateRepositoryMilestone(ctx, &types.MsgCreateRepositoryMilestone{Creator: users[0], RepositoryId: repositoryId, Title: "v2.0"})
+		require.NoError(t, err)
+		_, err = srv.SetIssueMilestone(ctx, &types.MsgSetIssueMilestone{Creator: users[0], RepositoryId: 0, Iid: 1, MilestoneId: 2})
+		require.NoError(t, err)
+
+		openIssues, closedIssues, _, _ := keepers.GitopiaKeeper.GetRepositoryMilestoneProgress(sdkCtx, 0, 1)
+		require.Zero(t, openIssues)
+		require.Equal(t, uint64(1), closedIssues)
+		openIssues, closedIssues, _, _ = keepers.GitopiaKeeper.GetRepositoryMilestoneProgress(sdkCtx, 0, 2)
+		require.Equal(t, uint64(1), openIssues)
+		require.Zero(t, closedIssues)
+	})
+	t.Run("Delete Milestone", func(t *testing.T) {
+		_, err := srv.DeleteRepositoryMilestone(ctx, &types.MsgDeleteRepositoryMilestone{Creator: users[0], RepositoryId: repositoryId, MilestoneId: 1})
+		require.NoError(t, err)
+
+		// only the members of the deleted milestone are cleared
+		issue, found := keepers.GitopiaKeeper.GetRepositoryIssue(sdkCtx, 0, 1)
+		require.True(t, found)
+		require.Equal(t, uint64(2), issue.Milestone)
+		issue, found = keepers.GitopiaKeeper.GetRepositoryIssue(sdkCtx, 0, 2)
+		require.True(t, found)
+		require.Zero(t, issue.Milestone)
+		pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(sdkCtx, 0, 1)
+		require.True(t, found)
+		require.Zero(t, pullRequest.Milestone)
+
+		_, err = keepers.GitopiaKeeper.RepositoryMilestone(ctx, &types.QueryGetRepositoryMilestoneRequest{Id: users[0], RepositoryName: repositoryId.Name, MilestoneId: 1})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	})
+}
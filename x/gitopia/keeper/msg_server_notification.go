@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func (k msgServer) MarkNotificationsRead(goCtx context.Context, msg *types.MsgMarkNotificationsRead) (*types.MsgMarkNotificationsReadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	var notifications []types.Notification
+	if len(msg.Ids) == 0 {
+		notifications = k.GetAllUserNotification(ctx, msg.Creator)
+	} else {
+		for _, id := range msg.Ids {
+			notification, found := k.GetNotification(ctx, msg.Creator, id)
+			if !found {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("notification (%d) doesn't exist", id))
+			}
+			notifications = append(notifications, notification)
+		}
+	}
+
+	ids := []uint64{}
+	for _, notification := range notifications {
+		if notification.Read {
+			continue
+		}
+		notification.Read = true
+		k.SetNotification(ctx, notification)
+		ids = append(ids, notification.Id)
+	}
+
+	idsJson, _ := json.Marshal(ids)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.MarkNotificationsReadEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeNotificationIdsKey, string(idsJson)),
+		),
+	)
+
+	return &types.MsgMarkNotificationsReadResponse{}, nil
+}
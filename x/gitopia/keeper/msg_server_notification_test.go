@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+func TestParseMentions(t *testing.T) {
+	require.Equal(t, []string{"alice", "bob.smith", "carol"}, utils.ParseMentions("@alice and @Bob.Smith, ask @carol. @alice again"))
+	require.Empty(t, utils.ParseMentions("mail dave@example.com or see a/@b"))
+}
+
+func TestNotificationMsgServerNotify(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: "C", Username: "C"})
+	require.NoError(t, err)
+	_, err = srv.WatchRepository(ctx, &types.MsgWatchRepository{Creator: users[1], RepositoryId: repositoryId})
+	require.NoError(t, err)
+	_, err = srv.WatchRepository(ctx, &types.MsgWatchRepository{Creator: "C", RepositoryId: repositoryId})
+	require.NoError(t, err)
+
+	inbox := func(address string) []types.Notification {
+		return keepers.GitopiaKeeper.GetAllUserNotification(sdkCtx, address)
+	}
+
+	t.Run("Create Issue", func(t *testing.T) {
+		_, err := srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId, Title: "title", Description: "cc @B", Assignees: []string{"C"}})
+		require.NoError(t, err)
+
+		// the actor isn't notified and a user is notified once for the first reason
+		require.Empty(t, inbox(users[0]))
+		require.Len(t, inbox(users[1]), 1)
+		require.Equal(t, types.Notification_MENTION, inbox(users[1])[0].Reason)
+		require.Equal(t, types.CommentParentIssue, inbox(users[1])[0].Parent)
+		require.Equal(t, uint64(2), inbox(users[1])[0].ParentIid)
+		require.Len(t, inbox("C"), 1)
+		require.Equal(t, types.Notification_ASSIGN, inbox("C")[0].Reason)
+	})
+	t.Run("Create Comment", func(t *testing.T) {
+		_, err := srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[1], RepositoryId: 0, Parent: types.CommentParentIssue, ParentIid: 2, Body: "thanks @A"})
+		require.NoError(t, err)
+
+		require.Len(t, inbox(users[0]), 1)
+		require.Equal(t, types.Notification_MENTION, inbox(users[0])[0].Reason)
+		require.Equal(t, uint64(1), inbox(users[0])[0].CommentIid)
+		require.Len(t, inbox("C"), 2)
+		require.Equal(t, types.Notification_SUBSCRIBED, inbox("C")[1].Reason)
+	})
+	t.Run("Update Comment", func(t *testing.T) {
+		_, err := srv.UpdateComment(ctx, &types.MsgUpdateComment{Creator: users[1], RepositoryId: 0, Parent: types.CommentParentIssue, ParentIid: 2, CommentIid: 1, Body: "thanks @A and @C"})
+		require.NoError(t, err)
+
+		// only new mentions are notified
+		require.Len(t, inbox(users[0]), 1)
+		require.Len(t, inbox("C"), 3)
+		require.Equal(t, types.Notification_MENTION, inbox("C")[2].Reason)
+	})
+	t.Run("Create PullRequest", func(t *testing.T) {
+		_, err := srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], Title: "title", HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1], Reviewers: []string{users[1]}})
+		require.NoError(t, err)
+
+		require.Len(t, inbox(users[1]), 2)
+		require.Equal(t, types.Notification_REVIEW_REQUESTED, inbox(users[1])[1].Reason)
+		require.Equal(t, types.CommentParentPullRequest, inbox(users[1])[1].Parent)
+		require.Len(t, inbox("C"), 4)
+		require.Equal(t, types.Notification_SUBSCRIBED, inbox("C")[3].Reason)
+	})
+	t.Run("Add Assignees", func(t *testing.T) {
+		_, err := srv.AddPullRequestAssignees(ctx, &types.MsgAddPullRequestAssignees{Creator: users[0], RepositoryId: 0, Iid: 1, Assignees: []string{"C"}})
+		require.NoError(t, err)
+
+		require.Len(t, inbox("C"), 5)
+		require.Equal(t, types.Notification_ASSIGN, inbox("C")[4].Reason)
+	})
+	t.Run("Query Unread", func(t *testing.T) {
+		res, err := keepers.GitopiaKeeper.NotificationAll(ctx, &types.QueryAllNotificationRequest{Id: "C", Unread: true, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+		require.NoError(t, err)
+		require.Len(t, res.Notification, 2)
+		require.Equal(t, uint64(5), res.Pagination.Total)
+
+		_, err = keepers.GitopiaKeeper.NotificationAll(ctx, &types.QueryAllNotificationRequest{Id: "unknown"})
+		require.Error(t, err)
+	})
+}
+
+func TestNotificationMsgServerMarkNotificationsRead(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, _ := setupPrePullRequest(ctx, t, srv)
+	for i := 0; i < 3; i++ {
+		_, err := srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId, Title: "title", Description: "@B"})
+		require.NoError(t, err)
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgMarkNotificationsRead
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgMarkNotificationsRead{Creator: "C", Ids: []uint64{1}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Notification Not Exists",
+			request: &types.MsgMarkNotificationsRead{Creator: users[1], Ids: []uint64{10}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgMarkNotificationsRead{Creator: users[1], Ids: []uint64{1}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.MarkNotificationsRead(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	res, err := keepers.GitopiaKeeper.NotificationAll(ctx, &types.QueryAllNotificationRequest{Id: users[1], Unread: true})
+	require.NoError(t, err)
+	require.Len(t, res.Notification, 2)
+
+	t.Run("Mark All", func(t *testing.T) {
+		_, err := srv.MarkNotificationsRead(ctx, &types.MsgMarkNotificationsRead{Creator: users[1]})
+		require.NoError(t, err)
+
+		res, err := keepers.GitopiaKeeper.NotificationAll(ctx, &types.QueryAllNotificationRequest{Id: users[1], Unread: true})
+		require.NoError(t, err)
+		require.Empty(t, res.Notification)
+		require.Len(t, keepers.GitopiaKeeper.GetAllUserNotification(sdkCtx, users[1]), 3)
+	})
+}
+
+func TestNotificationPruning(t *testing.T) {
+	_, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for i := 0; i < types.MaxNotifications+2; i++ {
+		keepers.GitopiaKeeper.AppendNotification(sdkCtx, types.Notification{Address: "A"})
+	}
+
+	notifications := keepers.GitopiaKeeper.GetAllUserNotification(sdkCtx, "A")
+	require.Len(t, notifications, types.MaxNotifications)
+	require.Equal(t, uint64(3), notifications[0].Id)
+	require.Equal(t, uint64(types.MaxNotifications+2), notifications[len(notifications)-1].Id)
+}
+
+func TestNotificationMaxNotifiedWatchers(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, _ := setupPrePullRequest(ctx, t, srv)
+	for i := 0; i < types.MaxNotifiedWatchers+5; i++ {
+		watcher := sample.AccAddress()
+		_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: watcher, Username: watcher})
+		require.NoError(t, err)
+		_, err = srv.WatchRepository(ctx, &types.MsgWatchRepository{Creator: watcher, RepositoryId: repositoryId})
+		require.NoError(t, err)
+	}
+	require.Len(t, keepers.GitopiaKeeper.GetRepositoryWatchers(sdkCtx, 0), types.MaxNotifiedWatchers)
+
+	_, err := srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId, Title: "title"})
+	require.NoError(t, err)
+
+	var notified int
+	for _, watch := range keepers.GitopiaKeeper.GetAllRepositoryWatch(sdkCtx, 0) {
+		notified += len(keepers.GitopiaKeeper.GetAllUserNotification(sdkCtx, watch.Address))
+	}
+	require.Equal(t, types.MaxNotifiedWatchers, notified)
+}
@@ -21,6 +21,10 @@ var StorageTypeUrls = [2]string{
 	sdk.MsgTypeURL(&types.MsgUpdateRepositoryBackupRef{}),
 }
 
+var CiTypeUrls = [1]string{
+	sdk.MsgTypeURL(&types.MsgSetCommitStatus{}),
+}
+
 func (k msgServer) AuthorizeProvider(goCtx context.Context, msg *types.MsgAuthorizeProvider) (*types.MsgAuthorizeProviderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -95,6 +99,13 @@ func (k msgServer) RevokeProviderPermission(goCtx context.Context, msg *types.Ms
 				k.authzKeeper.DeleteGrant(ctx, grantee, granter, t)
 			}
 		}
+	case types.ProviderPermission_CI:
+		for _, t := range CiTypeUrls {
+			authorization, _ := k.authzKeeper.GetAuthorization(ctx, grantee, granter, t)
+			if authorization != nil {
+				k.authzKeeper.DeleteGrant(ctx, grantee, granter, t)
+			}
+		}
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid permission (%v)", msg.Permission))
 	}
@@ -45,6 +45,10 @@ func (k msgServer) CreatePullRequest(goCtx context.Context, msg *types.MsgCreate
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("base-repository (%v/%v) doesn't exist", msg.BaseRepositoryId.Id, msg.BaseRepositoryId.Name))
 	}
 
+	if err := CheckRepositoryNotArchived(baseRepository); err != nil {
+		return nil, err
+	}
+
 	if _, found := k.GetRepositoryBranch(ctx, baseRepository.Id, msg.BaseBranch); !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("base-branch (%v) doesn't exist", msg.BaseBranch))
 	}
@@ -87,7 +91,7 @@ func (k msgServer) CreatePullRequest(goCtx context.Context, msg *types.MsgCreate
 		Description:         msg.Description,
 		CommentsCount:       0,
 		Locked:              false,
-		Draft:               false,
+		Draft:               msg.Draft,
 		CreatedAt:           createdAt,
 		UpdatedAt:           createdAt,
 		ClosedAt:            zeroTime,
@@ -133,6 +137,9 @@ func (k msgServer) CreatePullRequest(goCtx context.Context, msg *types.MsgCreate
 		if !found {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", issueIid))
 		}
+		if err := CheckIssueNotTransferred(issue); err != nil {
+			return nil, err
+		}
 
 		if _, exists := utils.IssueIidExists(pullRequest.Issues, issueIid); exists {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue (%v) already linked", issueIid))
@@ -159,8 +166,25 @@ func (k msgServer) CreatePullRequest(goCtx context.Context, msg *types.MsgCreate
 		pullRequest,
 	)
 
+	// Cross-reference the issues mentioned in the description
+	pullRequest.Id = id
+	k.AddPullRequestIssueReferences(ctx, msg.Creator, baseRepository, &pullRequest, pullRequest.Description)
+	k.SetPullRequest(ctx, pullRequest)
+
 	k.SetRepository(ctx, baseRepository)
 
+	notified := make(map[string]bool)
+	notification := types.Notification{
+		Actor:        msg.Creator,
+		RepositoryId: baseRepository.Id,
+		Parent:       types.CommentParentPullRequest,
+		ParentIid:    pullRequest.Iid,
+	}
+	k.Notify(ctx, notified, types.Notification_REVIEW_REQUESTED, pullRequest.Reviewers, notification)
+	k.Notify(ctx, notified, types.Notification_ASSIGN, pullRequest.Assignees, notification)
+	k.Notify(ctx, notified, types.Notification_MENTION, k.GetMentionedUsers(ctx, pullRequest.Description, ""), notification)
+	k.Notify(ctx, notified, types.Notification_SUBSCRIBED, k.GetRepositoryWatchers(ctx, baseRepository.Id), notification)
+
 	headJson, _ := json.Marshal(head)
 	baseJson, _ := json.Marshal(base)
 
@@ -204,6 +228,15 @@ func (k msgServer) UpdatePullRequestTitle(goCtx context.Context, msg *types.MsgU
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
 	}
 
+	repository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if pullRequest.Title == msg.Title {
 		return &types.MsgUpdatePullRequestTitleResponse{}, nil
 	}
@@ -266,6 +299,15 @@ func (k msgServer) UpdatePullRequestDescription(goCtx context.Context, msg *type
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
 	}
 
+	repository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if pullRequest.Description == msg.Description {
 		return &types.MsgUpdatePullRequestDescriptionResponse{}, nil
 	}
@@ -274,6 +316,11 @@ func (k msgServer) UpdatePullRequestDescription(goCtx context.Context, msg *type
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	if pullRequest.Description != msg.Description {
+		k.AppendCommentRevision(ctx, pullRequest.Base.RepositoryId, types.CommentParentPullRequest, pullRequest.Iid, 0, msg.Creator, pullRequest.Description)
+	}
+
+	previousDescription := pullRequest.Description
 	pullRequest.Description = msg.Description
 	pullRequest.UpdatedAt = ctx.BlockTime().Unix()
 	pullRequest.CommentsCount += 1
@@ -295,8 +342,17 @@ func (k msgServer) UpdatePullRequestDescription(goCtx context.Context, msg *type
 		ctx,
 		comment,
 	)
+
+	k.AddPullRequestIssueReferences(ctx, msg.Creator, repository, &pullRequest, pullRequest.Description)
 	k.SetPullRequest(ctx, pullRequest)
 
+	k.Notify(ctx, make(map[string]bool), types.Notification_MENTION, k.GetMentionedUsers(ctx, pullRequest.Description, previousDescription), types.Notification{
+		Actor:        msg.Creator,
+		RepositoryId: pullRequest.Base.RepositoryId,
+		Parent:       types.CommentParentPullRequest,
+		ParentIid:    pullRequest.Iid,
+	})
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -331,28 +387,28 @@ func (k msgServer) InvokeMergePullRequest(goCtx context.Context, msg *types.MsgI
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(baseRepository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, baseRepository, types.RepositoryCollaborator_ADMIN) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
 
-	id := k.AppendTask(ctx, types.Task{
-		Type:     types.TaskType(types.TypeSetPullRequestState),
-		State:    types.TaskState(types.StatePending),
-		Creator:  msg.Creator,
-		Provider: msg.Provider,
-	})
+	if pullRequest.Draft {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is a draft", msg.Iid))
+	}
+
+	if err := CheckPullRequestMergeMethodAllowed(baseRepository, pullRequest, msg.MergeMethod); err != nil {
+		return nil, err
+	}
+
+	if err := k.CheckPullRequestMergeAllowed(ctx, msg.Creator, baseRepository, pullRequest); err != nil {
+		return nil, err
+	}
+
+	k.QueuePullRequestMerge(ctx, msg.Creator, msg.Provider, pullRequest, msg.MergeMethod)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeyAction, types.InvokeMergePullRequestEventKey),
-			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
-			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
-			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
-			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
-			sdk.NewAttribute(types.EventAttributeTaskIdKey, strconv.FormatUint(id, 10)),
-		),
-	)
 	return &types.MsgInvokeMergePullRequestResponse{}, nil
 }
 
@@ -381,6 +437,10 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(baseRepository); err != nil {
+		return nil, err
+	}
+
 	var havePermission bool = false
 
 	if baseRepository.Owner.Type == types.OwnerType_USER {
@@ -449,6 +509,18 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't merge (%v) pullRequest", pullRequest.State.String()))
 		}
 
+		if msg.MergeMethod != pullRequest.MergeMethod {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("merge method (%v) doesn't match the requested merge method (%v)", msg.MergeMethod.String(), pullRequest.MergeMethod.String()))
+		}
+
+		if err := CheckPullRequestMergeMethodAllowed(baseRepository, pullRequest, msg.MergeMethod); err != nil {
+			return nil, err
+		}
+
+		if err := k.CheckPullRequestMergeAllowed(ctx, msg.Creator, baseRepository, pullRequest); err != nil {
+			return nil, err
+		}
+
 		// Update the branch ref in the base repository
 		var found bool
 		baseBranch, found = k.GetRepositoryBranch(ctx, baseRepository.Id, pullRequest.Base.Branch)
@@ -470,6 +542,11 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("headBranch (%v) doesn't exist", pullRequest.Head.Branch))
 		}
 
+		// A fast-forward merge moves the base branch to the head commit
+		if msg.MergeMethod == types.MergeMethod_FAST_FORWARD_ONLY && msg.MergeCommitSha != pullRequest.Head.CommitSha {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("fast-forward merge commit (%v) is not the head commit (%v)", msg.MergeCommitSha, pullRequest.Head.CommitSha))
+		}
+
 		pullRequest.MergedAt = blockTime
 		pullRequest.MergedBy = msg.Creator
 		pullRequest.MergeCommitSha = msg.MergeCommitSha
@@ -491,7 +568,7 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 			if issue.State != types.Issue_OPEN {
 				continue
 			}
-			if len(issue.Assignees) != 1 || pullRequest.Creator != issue.Assignees[0] { // continue when pull request creator is not the only assignee
+			if _, exists := utils.AssigneeExists(issue.Assignees, pullRequest.Creator); !exists { // continue when pull request creator is not an assignee
 				continue
 			}
 
@@ -511,31 +588,24 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 				if bounty.State != types.BountyStateSRCDEBITTED {
 					continue
 				}
-				rewardAccAddress, err := sdk.AccAddressFromBech32(pullRequest.Creator)
-				if err != nil {
-					continue
-				}
-
-				if err := k.bankKeeper.IsSendEnabledCoins(ctx, bounty.Amount...); err != nil {
-					continue
-				}
-				if k.bankKeeper.BlockedAddr(rewardAccAddress) {
-					continue
+				// bounties without splits are shared equally among the assignees
+				if len(bounty.Splits) == 0 {
+					bounty.Splits = AssigneeBountySplits(issue.Assignees, pullRequest.Creator)
 				}
-				bountyAddress := GetBountyAddress(bounty.Id)
-				if err := k.bankKeeper.SendCoins(
-					ctx, bountyAddress, rewardAccAddress, bounty.Amount,
-				); err != nil {
-					continue
-				}
-
-				bounty.State = types.BountyStateDESTCREDITED
-				bounty.RewardedTo = pullRequest.Creator
-				bounty.ExpireAt = time.Time{}.Unix()
-				bounty.UpdatedAt = blockTime
+				k.rewardMergedBounty(ctx, bounty, pullRequest.Creator)
+			}
+		}
 
-				k.SetBounty(ctx, bounty)
+		// reward bounties of the pull request
+		for _, bountyId := range pullRequest.Bounties {
+			bounty, found := k.GetBounty(ctx, bountyId)
+			if !found {
+				continue
+			}
+			if bounty.State != types.BountyStateSRCDEBITTED {
+				continue
 			}
+			k.rewardMergedBounty(ctx, bounty, pullRequest.Creator)
 		}
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid state (%v)", msg.State))
@@ -579,6 +649,15 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 	k.SetRepository(ctx, baseRepository)
 	k.SetPullRequest(ctx, pullRequest)
 
+	// A closed or merged pull request leaves the merge queue of its base branch
+	if pullRequest.State != types.PullRequest_OPEN {
+		k.DropPullRequestFromMergeQueue(ctx, pullRequest)
+	}
+
+	if pullRequest.State == types.PullRequest_CLOSED {
+		k.ResumeBountyExpiry(ctx, pullRequest.Bounties)
+	}
+
 	isGitRefUpdated := false
 	if pullRequest.State == types.PullRequest_MERGED {
 		isGitRefUpdated = true
@@ -596,6 +675,7 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
 			sdk.NewAttribute(types.EventAttributePullRequestStateKey, msg.State),
 			sdk.NewAttribute(types.EventAttributePullRequestMergeCommitShaKey, msg.MergeCommitSha),
+			sdk.NewAttribute(types.EventAttributePullRequestMergeMethodKey, pullRequest.MergeMethod.String()),
 			sdk.NewAttribute(types.EventAttributeTaskIdKey, strconv.FormatUint(msg.TaskId, 10)),
 			sdk.NewAttribute(types.EventAttributeTaskStateKey, task.State.String()),
 			sdk.NewAttribute(types.EventAttributeRepoNameKey, baseRepository.Name),
@@ -637,6 +717,10 @@ func (k msgServer) AddPullRequestReviewers(goCtx context.Context, msg *types.Msg
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.AssignPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to assign reviewers, assignees or labels", msg.Creator))
 	}
@@ -678,6 +762,13 @@ func (k msgServer) AddPullRequestReviewers(goCtx context.Context, msg *types.Msg
 	)
 	k.SetPullRequest(ctx, pullRequest)
 
+	k.Notify(ctx, make(map[string]bool), types.Notification_REVIEW_REQUESTED, msg.Reviewers, types.Notification{
+		Actor:        msg.Creator,
+		RepositoryId: pullRequest.Base.RepositoryId,
+		Parent:       types.CommentParentPullRequest,
+		ParentIid:    pullRequest.Iid,
+	})
+
 	reviewersJson, _ := json.Marshal(msg.Reviewers)
 
 	ctx.EventManager().EmitEvent(
@@ -714,6 +805,10 @@ func (k msgServer) RemovePullRequestReviewers(goCtx context.Context, msg *types.
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.AssignPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to assign reviewers, assignees or labels", msg.Creator))
 	}
@@ -788,6 +883,10 @@ func (k msgServer) AddPullRequestAssignees(goCtx context.Context, msg *types.Msg
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.AssignPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to assign reviewers, assignees or labels", msg.Creator))
 	}
@@ -829,6 +928,13 @@ func (k msgServer) AddPullRequestAssignees(goCtx context.Context, msg *types.Msg
 	)
 	k.SetPullRequest(ctx, pullRequest)
 
+	k.Notify(ctx, make(map[string]bool), types.Notification_ASSIGN, msg.Assignees, types.Notification{
+		Actor:        msg.Creator,
+		RepositoryId: pullRequest.Base.RepositoryId,
+		Parent:       types.CommentParentPullRequest,
+		ParentIid:    pullRequest.Iid,
+	})
+
 	assigneesJson, _ := json.Marshal(msg.Assignees)
 
 	ctx.EventManager().EmitEvent(
@@ -865,6 +971,10 @@ func (k msgServer) RemovePullRequestAssignees(goCtx context.Context, msg *types.
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.AssignPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to assign reviewers, assignees or labels", msg.Creator))
 	}
@@ -939,6 +1049,10 @@ func (k msgServer) LinkPullRequestIssueByIid(goCtx context.Context, msg *types.M
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(baseRepository); err != nil {
+		return nil, err
+	}
+
 	if msg.Creator != pullRequest.Creator {
 		if !k.HavePermission(ctx, msg.Creator, baseRepository, types.LinkPullRequestIssuePermission) {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
@@ -954,6 +1068,10 @@ func (k msgServer) LinkPullRequestIssueByIid(goCtx context.Context, msg *types.M
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.IssueIid))
 	}
 
+	if err := CheckIssueNotTransferred(issue); err != nil {
+		return nil, err
+	}
+
 	if _, exists := utils.IssueIidExists(pullRequest.Issues, msg.IssueIid); exists {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue (%v) already linked", msg.IssueIid))
 	}
@@ -1051,6 +1169,10 @@ func (k msgServer) UnlinkPullRequestIssueByIid(goCtx context.Context, msg *types
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(baseRepository); err != nil {
+		return nil, err
+	}
+
 	if msg.Creator != pullRequest.Creator {
 		if !k.HavePermission(ctx, msg.Creator, baseRepository, types.LinkPullRequestIssuePermission) {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
@@ -1122,6 +1244,10 @@ func (k msgServer) UnlinkPullRequestIssueByIid(goCtx context.Context, msg *types
 	k.SetPullRequest(ctx, pullRequest)
 	k.SetIssue(ctx, issue)
 
+	if len(issue.PullRequests) == 0 {
+		k.ResumeBountyExpiry(ctx, issue.Bounties)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -1157,6 +1283,10 @@ func (k msgServer) AddPullRequestLabels(goCtx context.Context, msg *types.MsgAdd
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.AssignPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to assign reviewers, assignees or labels", msg.Creator))
 	}
@@ -1238,6 +1368,10 @@ func (k msgServer) RemovePullRequestLabels(goCtx context.Context, msg *types.Msg
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.AssignPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to assign reviewers, assignees or labels", msg.Creator))
 	}
@@ -1319,6 +1453,10 @@ func (k msgServer) DeletePullRequest(goCtx context.Context, msg *types.MsgDelete
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.RepositoryId))
 	}
 
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
 	DoRemovePullRequest(ctx, k, pullRequest, repository)
 
 	repository.UpdatedAt = ctx.BlockTime().Unix()
@@ -1338,11 +1476,528 @@ func (k msgServer) DeletePullRequest(goCtx context.Context, msg *types.MsgDelete
 	return &types.MsgDeletePullRequestResponse{}, nil
 }
 
+func (k msgServer) SubmitPullRequestReview(goCtx context.Context, msg *types.MsgSubmitPullRequestReview) (*types.MsgSubmitPullRequestReviewResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if pullRequest.State != types.PullRequest_OPEN {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't review (%v) pullRequest", pullRequest.State.String()))
+	}
+
+	if msg.State != types.PullRequestReview_COMMENT {
+		if msg.Creator == pullRequest.Creator {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pullRequest author can't approve or request changes on their own pullRequest")
+		}
+		if !k.HavePermission(ctx, msg.Creator, repository, types.PullRequestReviewPermission) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to approve or request changes", msg.Creator))
+		}
+	}
+
+	// Reviews are tied to the head commit, reject reviews of an outdated head
+	headSha := k.GetPullRequestHeadSha(ctx, pullRequest)
+	if msg.CommitSha != headSha {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("commit (%v) is not the head of pullRequest", msg.CommitSha))
+	}
+
+	pullRequest.CommentsCount += 1
+	pullRequest.UpdatedAt = ctx.BlockTime().Unix()
+
+	review := types.PullRequestReview{
+		RepositoryId:   pullRequest.Base.RepositoryId,
+		PullRequestIid: pullRequest.Iid,
+		Creator:        msg.Creator,
+		State:          msg.State,
+		Body:           msg.Body,
+		CommitSha:      msg.CommitSha,
+		CreatedAt:      pullRequest.UpdatedAt,
+	}
+	review.Id = k.AppendPullRequestReview(ctx, review)
+
+	var comment = types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: pullRequest.Base.RepositoryId,
+		ParentIid:    pullRequest.Iid,
+		Parent:       types.CommentParentPullRequest,
+		CommentIid:   pullRequest.CommentsCount,
+		Body:         utils.SubmitPullRequestReviewCommentBody(msg.Creator, msg.State),
+		System:       true,
+		CreatedAt:    pullRequest.UpdatedAt,
+		UpdatedAt:    pullRequest.UpdatedAt,
+		CommentType:  types.CommentTypeReview,
+	}
+
+	k.AppendComment(
+		ctx,
+		comment,
+	)
+	k.SetPullRequest(ctx, pullRequest)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SubmitPullRequestReviewEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestReviewIdKey, strconv.FormatUint(review.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestReviewStateKey, review.State.String()),
+			sdk.NewAttribute(types.EventAttributePullRequestReviewShaKey, review.CommitSha),
+			sdk.NewAttribute(types.EventAttributeCreatedAtKey, strconv.FormatInt(review.CreatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSubmitPullRequestReviewResponse{Id: review.Id}, nil
+}
+
+func (k msgServer) MarkPullRequestReadyForReview(goCtx context.Context, msg *types.MsgMarkPullRequestReadyForReview) (*types.MsgMarkPullRequestReadyForReviewResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	baseRepository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(baseRepository); err != nil {
+		return nil, err
+	}
+
+	if msg.Creator != pullRequest.Creator && !k.HavePermission(ctx, msg.Creator, baseRepository, types.PullRequestDraftPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if pullRequest.State != types.PullRequest_OPEN {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is not open", msg.Iid))
+	}
+
+	if !pullRequest.Draft {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is not a draft", msg.Iid))
+	}
+
+	pullRequest.Draft = false
+	pullRequest.UpdatedAt = ctx.BlockTime().Unix()
+	pullRequest.CommentsCount += 1
+
+	var comment = types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: pullRequest.Base.RepositoryId,
+		ParentIid:    pullRequest.Iid,
+		Parent:       types.CommentParentPullRequest,
+		CommentIid:   pullRequest.CommentsCount,
+		Body:         utils.PullRequestReadyForReviewCommentBody(msg.Creator),
+		System:       true,
+		CreatedAt:    pullRequest.UpdatedAt,
+		UpdatedAt:    pullRequest.UpdatedAt,
+		CommentType:  types.CommentTypePullRequestReadyForReview,
+	}
+
+	k.AppendComment(
+		ctx,
+		comment,
+	)
+	k.SetPullRequest(ctx, pullRequest)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.MarkPullRequestReadyForReviewEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestDraftKey, strconv.FormatBool(pullRequest.Draft)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgMarkPullRequestReadyForReviewResponse{}, nil
+}
+
+func (k msgServer) ConvertPullRequestToDraft(goCtx context.Context, msg *types.MsgConvertPullRequestToDraft) (*types.MsgConvertPullRequestToDraftResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	baseRepository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(baseRepository); err != nil {
+		return nil, err
+	}
+
+	if msg.Creator != pullRequest.Creator && !k.HavePermission(ctx, msg.Creator, baseRepository, types.PullRequestDraftPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if pullRequest.State != types.PullRequest_OPEN {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is not open", msg.Iid))
+	}
+
+	if pullRequest.Draft {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is already a draft", msg.Iid))
+	}
+
+	pullRequest.Draft = true
+	pullRequest.UpdatedAt = ctx.BlockTime().Unix()
+	pullRequest.CommentsCount += 1
+
+	var comment = types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: pullRequest.Base.RepositoryId,
+		ParentIid:    pullRequest.Iid,
+		Parent:       types.CommentParentPullRequest,
+		CommentIid:   pullRequest.CommentsCount,
+		Body:         utils.PullRequestConvertedToDraftCommentBody(msg.Creator),
+		System:       true,
+		CreatedAt:    pullRequest.UpdatedAt,
+		UpdatedAt:    pullRequest.UpdatedAt,
+		CommentType:  types.CommentTypePullRequestConvertedToDraft,
+	}
+
+	k.AppendComment(
+		ctx,
+		comment,
+	)
+	k.SetPullRequest(ctx, pullRequest)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ConvertPullRequestToDraftEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestDraftKey, strconv.FormatBool(pullRequest.Draft)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgConvertPullRequestToDraftResponse{}, nil
+}
+
+func (k msgServer) EnablePullRequestAutoMerge(goCtx context.Context, msg *types.MsgEnablePullRequestAutoMerge) (*types.MsgEnablePullRequestAutoMergeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	baseRepository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(baseRepository); err != nil {
+		return nil, err
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, baseRepository, types.PullRequestAutoMergePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if pullRequest.State != types.PullRequest_OPEN {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is not open", msg.Iid))
+	}
+
+	if err := CheckPullRequestMergeMethodAllowed(baseRepository, pullRequest, msg.MergeMethod); err != nil {
+		return nil, err
+	}
+
+	autoMerge := types.PullRequestAutoMerge{
+		RepositoryId:   msg.RepositoryId,
+		PullRequestIid: msg.Iid,
+		Creator:        msg.Creator,
+		Provider:       msg.Provider,
+		MergeMethod:    msg.MergeMethod,
+		CreatedAt:      ctx.BlockTime().Unix(),
+	}
+
+	k.SetPullRequestAutoMerge(ctx, autoMerge)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.EnablePullRequestAutoMergeEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestMergeMethodKey, autoMerge.MergeMethod.String()),
+			sdk.NewAttribute(types.EventAttributeCreatedAtKey, strconv.FormatInt(autoMerge.CreatedAt, 10)),
+		),
+	)
+
+	return &types.MsgEnablePullRequestAutoMergeResponse{}, nil
+}
+
+func (k msgServer) DisablePullRequestAutoMerge(goCtx context.Context, msg *types.MsgDisablePullRequestAutoMerge) (*types.MsgDisablePullRequestAutoMergeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	autoMerge, found := k.GetPullRequestAutoMerge(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("auto-merge is not enabled for pullRequest (%d)", msg.Iid))
+	}
+
+	baseRepository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if msg.Creator != autoMerge.Creator && !k.HavePermission(ctx, msg.Creator, baseRepository, types.PullRequestAutoMergePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	k.RemovePullRequestAutoMerge(ctx, msg.RepositoryId, msg.Iid)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.DisablePullRequestAutoMergeEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+		),
+	)
+
+	return &types.MsgDisablePullRequestAutoMergeResponse{}, nil
+}
+
 func DoRemovePullRequest(ctx sdk.Context, k msgServer, pullRequest types.PullRequest, repository types.Repository) {
 	comments := k.GetAllPullRequestComment(ctx, repository.Id, pullRequest.Iid)
 	for _, comment := range comments {
 		k.RemovePullRequestComment(ctx, repository.Id, pullRequest.Iid, comment.CommentIid)
 	}
+	k.RemoveAllCommentRevisionForParent(ctx, repository.Id, types.CommentParentPullRequest, pullRequest.Iid)
 
+	reviews := k.GetAllPullRequestReviewForPullRequest(ctx, repository.Id, pullRequest.Iid)
+	for _, review := range reviews {
+		k.RemovePullRequestReview(ctx, repository.Id, pullRequest.Iid, review.Id)
+	}
+
+	for _, bountyId := range pullRequest.Bounties {
+		bounty, found := k.GetBounty(ctx, bountyId)
+		if !found {
+			continue
+		}
+		if bounty.State != types.BountyStateSRCDEBITTED {
+			continue
+		}
+		if err := k.RefundBounty(ctx, bounty); err != nil {
+			continue
+		}
+
+		bounty.State = types.BountyStateREVERTEDBACK
+		bounty.ExpireAt = time.Time{}.Unix()
+		bounty.UpdatedAt = ctx.BlockTime().Unix()
+
+		k.SetBounty(ctx, bounty)
+	}
+
+	k.MovePullRequestToMilestone(ctx, &pullRequest, 0)
+	k.RemovePullRequestAutoMerge(ctx, repository.Id, pullRequest.Iid)
 	k.RemoveRepositoryPullRequest(ctx, repository.Id, pullRequest.Iid)
+	k.DropPullRequestFromMergeQueue(ctx, pullRequest)
+}
+
+func (k msgServer) SetPullRequestChangedPaths(goCtx context.Context, msg *types.MsgSetPullRequestChangedPaths) (*types.MsgSetPullRequestChangedPathsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// changed paths are reported by the git server, which isn't required to be a gitopia user
+	gitServer := k.GetParams(ctx).GitServer
+	isGitServer := gitServer != "" && msg.Creator == gitServer
+
+	if !isGitServer {
+		if _, found := k.GetUser(ctx, msg.Creator); !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+		}
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	// the author can't report the paths of their own pull request, as code owner reviews
+	// are only required for the reported paths
+	if !isGitServer && (msg.Creator == pullRequest.Creator || !k.HavePermission(ctx, msg.Creator, repository, types.PullRequestChangedPathsPermission)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if pullRequest.State != types.PullRequest_OPEN {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is not open", msg.Iid))
+	}
+
+	pullRequest.ChangedPaths = msg.ChangedPaths
+	pullRequest.ChangedPathsSha = k.GetPullRequestHeadSha(ctx, pullRequest)
+	pullRequest.UpdatedAt = ctx.BlockTime().Unix()
+
+	reviewers := k.AssignPullRequestCodeOwners(ctx, repository, &pullRequest)
+	if len(reviewers) > 0 {
+		pullRequest.CommentsCount += 1
+
+		var comment = types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: pullRequest.Base.RepositoryId,
+			ParentIid:    pullRequest.Iid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         utils.AddReviewersCommentBody(msg.Creator, reviewers),
+			System:       true,
+			CreatedAt:    pullRequest.UpdatedAt,
+			UpdatedAt:    pullRequest.UpdatedAt,
+			CommentType:  types.CommentTypeAddReviewers,
+		}
+
+		k.AppendComment(
+			ctx,
+			comment,
+		)
+	}
+
+	k.SetPullRequest(ctx, pullRequest)
+
+	k.Notify(ctx, make(map[string]bool), types.Notification_REVIEW_REQUESTED, reviewers, types.Notification{
+		Actor:        msg.Creator,
+		RepositoryId: pullRequest.Base.RepositoryId,
+		Parent:       types.CommentParentPullRequest,
+		ParentIid:    pullRequest.Iid,
+	})
+
+	reviewersJson, _ := json.Marshal(reviewers)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetPullRequestChangedPathsEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestReviewersKey, string(reviewersJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetPullRequestChangedPathsResponse{}, nil
+}
+
+func (k msgServer) SetPullRequestCommitMessages(goCtx context.Context, msg *types.MsgSetPullRequestCommitMessages) (*types.MsgSetPullRequestCommitMessagesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	if msg.Creator != pullRequest.Creator && !k.HavePermission(ctx, msg.Creator, repository, types.PullRequestCommitMessagesPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if pullRequest.State != types.PullRequest_OPEN {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is not open", msg.Iid))
+	}
+
+	// The git server may report the same commits again, issues already
+	// cross-referenced by the pull request are skipped
+	for _, commitMessage := range msg.CommitMessages {
+		k.AddPullRequestIssueReferences(ctx, msg.Creator, repository, &pullRequest, commitMessage)
+	}
+
+	k.SetPullRequest(ctx, pullRequest)
+
+	issuesJson, _ := json.Marshal(pullRequest.Issues)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetPullRequestCommitMessagesEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIssuesKey, string(issuesJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetPullRequestCommitMessagesResponse{}, nil
 }
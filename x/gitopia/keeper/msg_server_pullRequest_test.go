@@ -2,11 +2,14 @@ package keeper_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
+	keepertest "github.com/gitopia/gitopia/testutil/keeper"
 	"github.com/gitopia/gitopia/x/gitopia/types"
 )
 
@@ -590,6 +593,365 @@ func TestPullRequestMsgServerDelete(t *testing.T) {
 	}
 }
 
+func TestPullRequestMsgServerSubmitReview(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: "C", Username: "C"})
+	require.NoError(t, err)
+	_, err = srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: users[0], RepositoryId: repositoryId, User: users[1], Role: "WRITE"})
+	require.NoError(t, err)
+	headSha := strings.Repeat("a", 40)
+	_, err = srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[0], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: branches[0], Sha: headSha}})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSubmitPullRequestReview
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgSubmitPullRequestReview{Creator: "X", RepositoryId: 0, Iid: 1, State: types.PullRequestReview_APPROVE, CommitSha: headSha},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "PullRequest Not Exists",
+			request: &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 10, State: types.PullRequestReview_APPROVE, CommitSha: headSha},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Author Approves",
+			request: &types.MsgSubmitPullRequestReview{Creator: users[0], RepositoryId: 0, Iid: 1, State: types.PullRequestReview_APPROVE, CommitSha: headSha},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgSubmitPullRequestReview{Creator: "C", RepositoryId: 0, Iid: 1, State: types.PullRequestReview_REQUEST_CHANGES, Body: "body", CommitSha: headSha},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Outdated Commit",
+			request: &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 1, State: types.PullRequestReview_APPROVE, CommitSha: strings.Repeat("b", 40)},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Comment",
+			request: &types.MsgSubmitPullRequestReview{Creator: "C", RepositoryId: 0, Iid: 1, State: types.PullRequestReview_COMMENT, Body: "body", CommitSha: headSha},
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 1, State: types.PullRequestReview_APPROVE, CommitSha: headSha},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.SubmitPullRequestReview(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPullRequestReviewMergeGating(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: users[0], RepositoryId: repositoryId, User: users[1], Role: "WRITE"})
+	require.NoError(t, err)
+	headSha := strings.Repeat("a", 40)
+	_, err = srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[0], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: branches[0], Sha: headSha}})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	_, err = srv.CreateBranchProtectionRule(ctx, &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: branches[1], RequiredApprovals: 1})
+	require.NoError(t, err)
+
+	invokeMerge := &types.MsgInvokeMergePullRequest{Creator: users[0], RepositoryId: 0, Iid: 1, Provider: users[0]}
+
+	t.Run("Missing Approvals", func(t *testing.T) {
+		_, err := srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Changes Requested", func(t *testing.T) {
+		_, err := srv.SubmitPullRequestReview(ctx, &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 1, State: types.PullRequestReview_REQUEST_CHANGES, Body: "body", CommitSha: headSha})
+		require.NoError(t, err)
+		_, err = srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Approved", func(t *testing.T) {
+		_, err := srv.SubmitPullRequestReview(ctx, &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 1, State: types.PullRequestReview_APPROVE, CommitSha: headSha})
+		require.NoError(t, err)
+		_, err = srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.NoError(t, err)
+	})
+	t.Run("Stale Approval", func(t *testing.T) {
+		_, err := srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[0], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: branches[0], Sha: strings.Repeat("b", 40)}})
+		require.NoError(t, err)
+		_, err = srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+		res, err := keepers.GitopiaKeeper.PullRequestReviewAll(ctx, &types.QueryAllPullRequestReviewRequest{RepositoryId: 0, PullRequestIid: 1})
+		require.NoError(t, err)
+		require.Len(t, res.PullRequestReview, 2)
+		for _, review := range res.PullRequestReview {
+			require.True(t, review.Stale)
+		}
+	})
+}
+
+func TestPullRequestMsgServerDraft(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1], Draft: true})
+	require.NoError(t, err)
+
+	invokeMerge := &types.MsgInvokeMergePullRequest{Creator: users[0], RepositoryId: 0, Iid: 1, Provider: users[0]}
+	isDraft := func() bool {
+		pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(sdk.UnwrapSDKContext(ctx), 0, 1)
+		require.True(t, found)
+		return pullRequest.Draft
+	}
+
+	require.True(t, isDraft())
+
+	t.Run("Merge Draft", func(t *testing.T) {
+		_, err := srv.InvokeMergePullRequest(ctx, invokeMerge)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Convert Draft To Draft", func(t *testing.T) {
+		_, err := srv.ConvertPullRequestToDraft(ctx, &types.MsgConvertPullRequestToDraft{Creator: users[0], RepositoryId: 0, Iid: 1})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Creator Not Exists", func(t *testing.T) {
+		_, err := srv.MarkPullRequestReadyForReview(ctx, &types.MsgMarkPullRequestReadyForReview{Creator: "C", RepositoryId: 0, Iid: 1})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	})
+	t.Run("PullRequest Not Exists", func(t *testing.T) {
+		_, err := srv.MarkPullRequestReadyForReview(ctx, &types.MsgMarkPullRequestReadyForReview{Creator: users[0], RepositoryId: 0, Iid: 10})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	})
+	t.Run("Unauthorized", func(t *testing.T) {
+		_, err := srv.MarkPullRequestReadyForReview(ctx, &types.MsgMarkPullRequestReadyForReview{Creator: users[1], RepositoryId: 0, Iid: 1})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Ready For Review", func(t *testing.T) {
+		_, err := srv.MarkPullRequestReadyForReview(ctx, &types.MsgMarkPullRequestReadyForReview{Creator: users[0], RepositoryId: 0, Iid: 1})
+		require.NoError(t, err)
+		require.False(t, isDraft())
+
+		_, err = srv.MarkPullRequestReadyForReview(ctx, &types.MsgMarkPullRequestReadyForReview{Creator: users[0], RepositoryId: 0, Iid: 1})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Convert To Draft By Collaborator", func(t *testing.T) {
+		_, err := srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: users[0], RepositoryId: repositoryId, User: users[1], Role: "WRITE"})
+		require.NoError(t, err)
+		_, err = srv.ConvertPullRequestToDraft(ctx, &types.MsgConvertPullRequestToDraft{Creator: users[1], RepositoryId: 0, Iid: 1})
+		require.NoError(t, err)
+		require.True(t, isDraft())
+	})
+	t.Run("Closed PullRequest", func(t *testing.T) {
+		_, err := srv.SetPullRequestState(ctx, &types.MsgSetPullRequestState{Creator: users[0], RepositoryId: 0, Iid: 1, State: "CLOSED"})
+		require.NoError(t, err)
+		_, err = srv.MarkPullRequestReadyForReview(ctx, &types.MsgMarkPullRequestReadyForReview{Creator: users[0], RepositoryId: 0, Iid: 1})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+}
+
+func TestPullRequestMergeMethod(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	headSha := strings.Repeat("a", 40)
+	_, err := srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[0], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: branches[0], Sha: headSha}})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	_, err = srv.UpdateRepositoryAllowedMergeMethods(ctx, &types.MsgUpdateRepositoryAllowedMergeMethods{Creator: users[0], RepositoryId: repositoryId, AllowedMergeMethods: []types.MergeMethod{types.MergeMethod_MERGE, types.MergeMethod_FAST_FORWARD_ONLY}})
+	require.NoError(t, err)
+
+	invokeMerge := func(mergeMethod types.MergeMethod) error {
+		_, err := srv.InvokeMergePullRequest(ctx, &types.MsgInvokeMergePullRequest{Creator: users[0], RepositoryId: 0, Iid: 1, Provider: users[0], MergeMethod: mergeMethod})
+		return err
+	}
+
+	t.Run("Merge Method Not Allowed", func(t *testing.T) {
+		require.ErrorIs(t, invokeMerge(types.MergeMethod_SQUASH), sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Linear History Required", func(t *testing.T) {
+		_, err := srv.CreateBranchProtectionRule(ctx, &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: branches[1], RequireLinearHistory: true})
+		require.NoError(t, err)
+		require.ErrorIs(t, invokeMerge(types.MergeMethod_MERGE), sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Merge Method Recorded", func(t *testing.T) {
+		require.NoError(t, invokeMerge(types.MergeMethod_FAST_FORWARD_ONLY))
+		pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(sdk.UnwrapSDKContext(ctx), 0, 1)
+		require.True(t, found)
+		require.Equal(t, types.MergeMethod_FAST_FORWARD_ONLY, pullRequest.MergeMethod)
+	})
+	t.Run("Merge Method Mismatch", func(t *testing.T) {
+		_, err := srv.SetPullRequestState(ctx, &types.MsgSetPullRequestState{Creator: users[0], RepositoryId: 0, Iid: 1, State: "MERGED", MergeCommitSha: headSha, TaskId: 0, MergeMethod: types.MergeMethod_REBASE})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Fast Forward To Another Commit", func(t *testing.T) {
+		_, err := srv.SetPullRequestState(ctx, &types.MsgSetPullRequestState{Creator: users[0], RepositoryId: 0, Iid: 1, State: "MERGED", MergeCommitSha: strings.Repeat("b", 40), TaskId: 0, MergeMethod: types.MergeMethod_FAST_FORWARD_ONLY})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("Completed", func(t *testing.T) {
+		_, err := srv.SetPullRequestState(ctx, &types.MsgSetPullRequestState{Creator: users[0], RepositoryId: 0, Iid: 1, State: "MERGED", MergeCommitSha: headSha, TaskId: 0, MergeMethod: types.MergeMethod_FAST_FORWARD_ONLY})
+		require.NoError(t, err)
+	})
+}
+
+func TestPullRequestMsgServerAutoMerge(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: users[0], RepositoryId: repositoryId, User: users[1], Role: "WRITE"})
+	require.NoError(t, err)
+	headSha := strings.Repeat("a", 40)
+	_, err = srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[0], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: branches[0], Sha: headSha}})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	_, err = srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[2], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	_, err = srv.CreateBranchProtectionRule(ctx, &types.MsgCreateBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: branches[1], RequiredApprovals: 1})
+	require.NoError(t, err)
+	_, err = srv.UpdateRepositoryAllowedMergeMethods(ctx, &types.MsgUpdateRepositoryAllowedMergeMethods{Creator: users[0], RepositoryId: repositoryId, AllowedMergeMethods: []types.MergeMethod{types.MergeMethod_SQUASH}})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgEnablePullRequestAutoMerge
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgEnablePullRequestAutoMerge{Creator: "C", RepositoryId: 0, Iid: 1, Provider: users[0], MergeMethod: types.MergeMethod_SQUASH},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "PullRequest Not Exists",
+			request: &types.MsgEnablePullRequestAutoMerge{Creator: users[0], RepositoryId: 0, Iid: 10, Provider: users[0], MergeMethod: types.MergeMethod_SQUASH},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgEnablePullRequestAutoMerge{Creator: users[1], RepositoryId: 0, Iid: 1, Provider: users[0], MergeMethod: types.MergeMethod_SQUASH},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Merge Method Not Allowed",
+			request: &types.MsgEnablePullRequestAutoMerge{Creator: users[0], RepositoryId: 0, Iid: 1, Provider: users[0], MergeMethod: types.MergeMethod_MERGE},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgEnablePullRequestAutoMerge{Creator: users[0], RepositoryId: 0, Iid: 1, Provider: users[0], MergeMethod: types.MergeMethod_SQUASH},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.EnablePullRequestAutoMerge(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("Pending Requirements", func(t *testing.T) {
+		taskCount := keepers.GitopiaKeeper.GetTaskCount(sdkCtx)
+		keepers.GitopiaKeeper.ProcessPullRequestAutoMerges(sdkCtx)
+		require.Equal(t, taskCount, keepers.GitopiaKeeper.GetTaskCount(sdkCtx))
+
+		res, err := keepers.GitopiaKeeper.PullRequestAutoMerge(ctx, &types.QueryGetPullRequestAutoMergeRequest{RepositoryId: 0, PullRequestIid: 1})
+		require.NoError(t, err)
+		require.Equal(t, users[0], res.PullRequestAutoMerge.Creator)
+	})
+	t.Run("Requirements Met", func(t *testing.T) {
+		_, err := srv.SubmitPullRequestReview(ctx, &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 1, State: types.PullRequestReview_APPROVE, CommitSha: headSha})
+		require.NoError(t, err)
+
+		taskCount := keepers.GitopiaKeeper.GetTaskCount(sdkCtx)
+		keepers.GitopiaKeeper.ProcessPullRequestAutoMerges(sdkCtx)
+		require.Equal(t, taskCount+1, keepers.GitopiaKeeper.GetTaskCount(sdkCtx))
+
+		task, found := keepers.GitopiaKeeper.GetTask(sdkCtx, taskCount)
+		require.True(t, found)
+		require.Equal(t, types.TaskType(types.TypeSetPullRequestState), task.Type)
+		pullRequest, found := keepers.GitopiaKeeper.GetRepositoryPullRequest(sdkCtx, 0, 1)
+		require.True(t, found)
+		require.Equal(t, types.MergeMethod_SQUASH, pullRequest.MergeMethod)
+		_, found = keepers.GitopiaKeeper.GetPullRequestAutoMerge(sdkCtx, 0, 1)
+		require.False(t, found)
+	})
+	t.Run("Disable", func(t *testing.T) {
+		_, err := srv.DisablePullRequestAutoMerge(ctx, &types.MsgDisablePullRequestAutoMerge{Creator: users[0], RepositoryId: 0, Iid: 2})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+		_, err = srv.EnablePullRequestAutoMerge(ctx, &types.MsgEnablePullRequestAutoMerge{Creator: users[0], RepositoryId: 0, Iid: 2, Provider: users[0], MergeMethod: types.MergeMethod_SQUASH})
+		require.NoError(t, err)
+		_, err = srv.DisablePullRequestAutoMerge(ctx, &types.MsgDisablePullRequestAutoMerge{Creator: users[1], RepositoryId: 0, Iid: 2})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		_, err = srv.DisablePullRequestAutoMerge(ctx, &types.MsgDisablePullRequestAutoMerge{Creator: users[0], RepositoryId: 0, Iid: 2})
+		require.NoError(t, err)
+		_, found := keepers.GitopiaKeeper.GetPullRequestAutoMerge(sdkCtx, 0, 2)
+		require.False(t, found)
+	})
+	t.Run("Closed PullRequest", func(t *testing.T) {
+		_, err := srv.EnablePullRequestAutoMerge(ctx, &types.MsgEnablePullRequestAutoMerge{Creator: users[0], RepositoryId: 0, Iid: 2, Provider: users[0], MergeMethod: types.MergeMethod_SQUASH})
+		require.NoError(t, err)
+		_, err = srv.SetPullRequestState(ctx, &types.MsgSetPullRequestState{Creator: users[0], RepositoryId: 0, Iid: 2, State: "CLOSED"})
+		require.NoError(t, err)
+
+		keepers.GitopiaKeeper.ProcessPullRequestAutoMerges(sdkCtx)
+		_, found := keepers.GitopiaKeeper.GetPullRequestAutoMerge(sdkCtx, 0, 2)
+		require.False(t, found)
+	})
+	t.Run("Expired", func(t *testing.T) {
+		keepers.GitopiaKeeper.SetPullRequestAutoMerge(sdkCtx, types.PullRequestAutoMerge{
+			RepositoryId:   0,
+			PullRequestIid: 1,
+			Creator:        users[0],
+			Provider:       users[0],
+			MergeMethod:    types.MergeMethod_SQUASH,
+			CreatedAt:      sdkCtx.BlockTime().Unix() - types.PullRequestAutoMergeExpiry,
+		})
+
+		taskCount := keepers.GitopiaKeeper.GetTaskCount(sdkCtx)
+		keepers.GitopiaKeeper.ProcessPullRequestAutoMerges(sdkCtx)
+		require.Equal(t, taskCount, keepers.GitopiaKeeper.GetTaskCount(sdkCtx))
+		_, found := keepers.GitopiaKeeper.GetPullRequestAutoMerge(sdkCtx, 0, 1)
+		require.False(t, found)
+	})
+}
+
+func TestProcessPullRequestAutoMergesLimit(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+
+	for i := 0; i < types.MaxPullRequestAutoMergesPerBlock+10; i++ {
+		keepers.GitopiaKeeper.SetPullRequestAutoMerge(ctx, types.PullRequestAutoMerge{
+			RepositoryId:   0,
+			PullRequestIid: uint64(i + 1),
+			CreatedAt:      ctx.BlockTime().Unix(),
+		})
+	}
+
+	// requests of missing pull requests are dropped when they are checked
+	keepers.GitopiaKeeper.ProcessPullRequestAutoMerges(ctx)
+	require.Len(t, keepers.GitopiaKeeper.GetAllPullRequestAutoMerge(ctx), 10)
+	keepers.GitopiaKeeper.ProcessPullRequestAutoMerges(ctx)
+	require.Len(t, keepers.GitopiaKeeper.GetAllPullRequestAutoMerge(ctx), 0)
+}
+
 func setupPrePullRequest(ctx context.Context, t *testing.T, srv types.MsgServer) (users []string, repositoryId types.RepositoryId, branches []string) {
 	users = append(users, "A", "B")
 	repositoryId = types.RepositoryId{
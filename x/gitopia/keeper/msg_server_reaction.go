@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func (k msgServer) ToggleReaction(goCtx context.Context, msg *types.MsgToggleReaction) (*types.MsgToggleReactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, msg.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.RepositoryId))
+	}
+
+	if err := CheckRepositoryNotArchived(repository); err != nil {
+		return nil, err
+	}
+
+	var added bool
+	var reactionCounts []*types.ReactionCount
+
+	// A comment iid of 0 refers to the issue or pull request itself
+	if msg.CommentIid != 0 {
+		var comment types.Comment
+
+		switch msg.Parent {
+		case types.CommentParentIssue:
+			comment, found = k.GetIssueComment(ctx, msg.RepositoryId, msg.ParentIid, msg.CommentIid)
+		case types.CommentParentPullRequest:
+			comment, found = k.GetPullRequestComment(ctx, msg.RepositoryId, msg.ParentIid, msg.CommentIid)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid comment parent (%v)", msg.Parent))
+		}
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("comment (%d) doesn't exist", msg.CommentIid))
+		}
+
+		comment.Reactions, added = toggleReaction(comment.Reactions, msg.Creator, msg.Emoji)
+		comment.ReactionCounts = getReactionCounts(comment.Reactions)
+		reactionCounts = comment.ReactionCounts
+
+		k.SetComment(ctx, comment)
+	} else {
+		switch msg.Parent {
+		case types.CommentParentIssue:
+			issue, found := k.GetRepositoryIssue(ctx, msg.RepositoryId, msg.ParentIid)
+			if !found {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.ParentIid))
+			}
+			if err := CheckIssueNotTransferred(issue); err != nil {
+				return nil, err
+			}
+
+			issue.Reactions, added = toggleReaction(issue.Reactions, msg.Creator, msg.Emoji)
+			issue.ReactionCounts = getReactionCounts(issue.Reactions)
+			reactionCounts = issue.ReactionCounts
+
+			k.SetIssue(ctx, issue)
+		case types.CommentParentPullRequest:
+			pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.ParentIid)
+			if !found {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.ParentIid))
+			}
+
+			pullRequest.Reactions, added = toggleReaction(pullRequest.Reactions, msg.Creator, msg.Emoji)
+			pullRequest.ReactionCounts = getReactionCounts(pullRequest.Reactions)
+			reactionCounts = pullRequest.ReactionCounts
+
+			k.SetPullRequest(ctx, pullRequest)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid comment parent (%v)", msg.Parent))
+		}
+	}
+
+	reactionCountsJson, _ := json.Marshal(reactionCounts)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ToggleReactionEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(msg.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentParentKey, msg.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeCommentParentIidKey, strconv.FormatUint(msg.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentIidKey, strconv.FormatUint(msg.CommentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeReactionEmojiKey, msg.Emoji.String()),
+			sdk.NewAttribute(types.EventAttributeReactionAddedKey, strconv.FormatBool(added)),
+			sdk.NewAttribute(types.EventAttributeReactionCountsKey, string(reactionCountsJson)),
+		),
+	)
+
+	return &types.MsgToggleReactionResponse{Added: added}, nil
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func TestReactionMsgServerToggle(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+	users, _, _, _ := setupPreComment(ctx, t, srv)
+	_, err := srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[0], ParentIid: 1, Parent: types.CommentParentIssue})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgToggleReaction
+		added   bool
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgToggleReaction{Creator: "C", ParentIid: 1, Parent: types.CommentParentIssue, CommentIid: 1},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Comment Not Exists",
+			request: &types.MsgToggleReaction{Creator: users[1], ParentIid: 1, Parent: types.CommentParentIssue, CommentIid: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "PullRequest Not Exists",
+			request: &types.MsgToggleReaction{Creator: users[1], ParentIid: 10, Parent: types.CommentParentPullRequest},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Comment Reaction Added",
+			request: &types.MsgToggleReaction{Creator: users[1], ParentIid: 1, Parent: types.CommentParentIssue, CommentIid: 1, Emoji: types.EmojiHeart},
+			added:   true,
+		},
+		{
+			desc:    "Comment Reaction Removed",
+			request: &types.MsgToggleReaction{Creator: users[1], ParentIid: 1, Parent: types.CommentParentIssue, CommentIid: 1, Emoji: types.EmojiHeart},
+		},
+		{
+			desc:    "Issue Reaction Added",
+			request: &types.MsgToggleReaction{Creator: users[1], ParentIid: 1, Parent: types.CommentParentIssue, Emoji: types.EmojiRocket},
+			added:   true,
+		},
+		{
+			desc:    "PullRequest Reaction Added",
+			request: &types.MsgToggleReaction{Creator: users[1], ParentIid: 1, Parent: types.CommentParentPullRequest, Emoji: types.EmojiEyes},
+			added:   true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := srv.ToggleReaction(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.added, res.Added)
+			}
+		})
+	}
+}
+
+func TestReactionMsgServerCounts(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	users, _, _, _ := setupPreComment(ctx, t, srv)
+	for _, user := range users {
+		for _, emoji := range []types.Emoji{types.EmojiRocket, types.EmojiThumbsUp} {
+			_, err := srv.ToggleReaction(ctx, &types.MsgToggleReaction{Creator: user, ParentIid: 1, Parent: types.CommentParentIssue, Emoji: emoji})
+			require.NoError(t, err)
+		}
+	}
+	_, err := srv.ToggleReaction(ctx, &types.MsgToggleReaction{Creator: users[1], ParentIid: 1, Parent: types.CommentParentIssue, Emoji: types.EmojiRocket})
+	require.NoError(t, err)
+
+	issue, found := keepers.GitopiaKeeper.GetRepositoryIssue(sdkCtx, 0, 1)
+	require.True(t, found)
+	require.Len(t, issue.Reactions, 2)
+	require.Equal(t, []*types.ReactionCount{
+		{Emoji: types.EmojiThumbsUp, Count: 2},
+		{Emoji: types.EmojiRocket, Count: 1},
+	}, issue.ReactionCounts)
+}
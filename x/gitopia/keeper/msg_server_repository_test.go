@@ -538,6 +538,254 @@ func TestRepositoryMsgToggleRepositoryForking(t *testing.T) {
 	}
 }
 
+func TestRepositoryMsgUpdateAllowedMergeMethods(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+	users := setupPreRepository(ctx, t, srv)
+	repositoryId := types.RepositoryId{
+		Id:   users[0],
+		Name: "repository",
+	}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: repositoryId.Id, Name: repositoryId.Name, Owner: repositoryId.Id})
+	require.NoError(t, err)
+
+	mergeMethods := []types.MergeMethod{types.MergeMethod_SQUASH, types.MergeMethod_REBASE}
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgUpdateRepositoryAllowedMergeMethods
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgUpdateRepositoryAllowedMergeMethods{Creator: "X", RepositoryId: repositoryId, AllowedMergeMethods: mergeMethods},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Repository Not Exists",
+			request: &types.MsgUpdateRepositoryAllowedMergeMethods{Creator: users[0], RepositoryId: types.RepositoryId{Id: users[0], Name: "name"}, AllowedMergeMethods: mergeMethods},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgUpdateRepositoryAllowedMergeMethods{Creator: users[1], RepositoryId: repositoryId, AllowedMergeMethods: mergeMethods},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgUpdateRepositoryAllowedMergeMethods{Creator: users[0], RepositoryId: repositoryId, AllowedMergeMethods: mergeMethods},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.UpdateRepositoryAllowedMergeMethods(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepositoryMsgServerArchive(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+	users := setupPreRepository(ctx, t, srv)
+	repositoryId := types.RepositoryId{
+		Id:   users[0],
+		Name: "repository",
+	}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: repositoryId.Id, Name: repositoryId.Name, Owner: repositoryId.Id})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgArchiveRepository
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgArchiveRepository{Creator: "X", RepositoryId: repositoryId},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Repository Not Exists",
+			request: &types.MsgArchiveRepository{Creator: users[0], RepositoryId: types.RepositoryId{Id: users[0], Name: "name"}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgArchiveRepository{Creator: users[1], RepositoryId: repositoryId},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgArchiveRepository{Creator: users[0], RepositoryId: repositoryId},
+		},
+		{
+			desc:    "Already Archived",
+			request: &types.MsgArchiveRepository{Creator: users[0], RepositoryId: repositoryId},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.ArchiveRepository(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepositoryMsgServerUnarchive(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+	users := setupPreRepository(ctx, t, srv)
+	repositoryId := types.RepositoryId{
+		Id:   users[0],
+		Name: "repository",
+	}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: repositoryId.Id, Name: repositoryId.Name, Owner: repositoryId.Id})
+	require.NoError(t, err)
+	_, err = srv.ArchiveRepository(ctx, &types.MsgArchiveRepository{Creator: users[0], RepositoryId: repositoryId})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgUnarchiveRepository
+		err     error
+	}{
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgUnarchiveRepository{Creator: users[1], RepositoryId: repositoryId},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgUnarchiveRepository{Creator: users[0], RepositoryId: repositoryId},
+		},
+		{
+			desc:    "Not Archived",
+			request: &types.MsgUnarchiveRepository{Creator: users[0], RepositoryId: repositoryId},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.UnarchiveRepository(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepositoryArchivedReadOnly(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+	_, err = srv.ArchiveRepository(ctx, &types.MsgArchiveRepository{Creator: users[0], RepositoryId: repositoryId})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc string
+		send func() error
+	}{
+		{
+			desc: "Push Branch",
+			send: func() error {
+				_, err := srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[0], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: "main"}})
+				return err
+			},
+		},
+		{
+			desc: "Push Tag",
+			send: func() error {
+				_, err := srv.SetTag(ctx, &types.MsgSetTag{Creator: users[0], RepositoryId: repositoryId, Tag: types.MsgSetTag_Tag{Name: "v1"}})
+				return err
+			},
+		},
+		{
+			desc: "Create Issue",
+			send: func() error {
+				_, err := srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId, Title: "title"})
+				return err
+			},
+		},
+		{
+			desc: "Update Issue",
+			send: func() error {
+				_, err := srv.UpdateIssueTitle(ctx, &types.MsgUpdateIssueTitle{Creator: users[0], RepositoryId: 0, Iid: 1, Title: "new title"})
+				return err
+			},
+		},
+		{
+			desc: "Create PullRequest",
+			send: func() error {
+				_, err := srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[2]})
+				return err
+			},
+		},
+		{
+			desc: "Close PullRequest",
+			send: func() error {
+				_, err := srv.SetPullRequestState(ctx, &types.MsgSetPullRequestState{Creator: users[0], RepositoryId: 0, Iid: 1, State: "CLOSED"})
+				return err
+			},
+		},
+		{
+			desc: "Create Comment",
+			send: func() error {
+				_, err := srv.CreateComment(ctx, &types.MsgCreateComment{Creator: users[0], RepositoryId: 0, ParentIid: 1, Parent: types.CommentParentIssue, Body: "body"})
+				return err
+			},
+		},
+		{
+			desc: "Create Label",
+			send: func() error {
+				_, err := srv.CreateRepositoryLabel(ctx, &types.MsgCreateRepositoryLabel{Creator: users[0], RepositoryId: repositoryId, Name: "label", Color: "#ffffff"})
+				return err
+			},
+		},
+		{
+			desc: "Create Release",
+			send: func() error {
+				_, err := srv.CreateRelease(ctx, &types.MsgCreateRelease{Creator: users[0], RepositoryId: repositoryId, TagName: "v1", Name: "v1"})
+				return err
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.ErrorIs(t, tc.send(), sdkerrors.ErrInvalidRequest)
+		})
+	}
+
+	t.Run("Filter Archived", func(t *testing.T) {
+		res, err := keepers.GitopiaKeeper.RepositoryAll(ctx, &types.QueryAllRepositoryRequest{Option: &types.RepositoryOptions{Archived: "true"}})
+		require.NoError(t, err)
+		require.Len(t, res.Repository, 1)
+
+		res, err = keepers.GitopiaKeeper.RepositoryAll(ctx, &types.QueryAllRepositoryRequest{Option: &types.RepositoryOptions{Archived: "false"}})
+		require.NoError(t, err)
+		require.Len(t, res.Repository, 0)
+
+		anyRes, err := keepers.GitopiaKeeper.AnyRepositoryAll(ctx, &types.QueryAllAnyRepositoryRequest{Id: users[0], Option: &types.RepositoryOptions{Archived: "true"}})
+		require.NoError(t, err)
+		require.Len(t, anyRes.Repository, 1)
+
+		_, err = keepers.GitopiaKeeper.RepositoryAll(ctx, &types.QueryAllRepositoryRequest{Option: &types.RepositoryOptions{Archived: "maybe"}})
+		require.Error(t, err)
+	})
+	t.Run("Unarchived", func(t *testing.T) {
+		_, err := srv.UnarchiveRepository(ctx, &types.MsgUnarchiveRepository{Creator: users[0], RepositoryId: repositoryId})
+		require.NoError(t, err)
+		_, err = srv.SetBranch(ctx, &types.MsgSetBranch{Creator: users[0], RepositoryId: repositoryId, Branch: types.MsgSetBranch_Branch{Name: "main"}})
+		require.NoError(t, err)
+	})
+}
+
 func TestRepositoryMsgServerDelete(t *testing.T) {
 	srv, ctx := setupMsgServer(t)
 	users := setupPreRepository(ctx, t, srv)
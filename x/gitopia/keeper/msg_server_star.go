@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func (k msgServer) StarRepository(goCtx context.Context, msg *types.MsgStarRepository) (*types.MsgStarRepositoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.subscribe(ctx, starSubscription, msg.Creator, msg.RepositoryId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgStarRepositoryResponse{}, nil
+}
+
+func (k msgServer) UnstarRepository(goCtx context.Context, msg *types.MsgUnstarRepository) (*types.MsgUnstarRepositoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.unsubscribe(ctx, starSubscription, msg.Creator, msg.RepositoryId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnstarRepositoryResponse{}, nil
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitopia/gitopia/app/keepers"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func TestSubscriptionMsgServer(t *testing.T) {
+	for _, kind := range []struct {
+		desc        string
+		subscribe   func(srv types.MsgServer, ctx context.Context, creator string, repositoryId types.RepositoryId) error
+		unsubscribe func(srv types.MsgServer, ctx context.Context, creator string, repositoryId types.RepositoryId) error
+		count       func(repository types.Repository) uint64
+		userCount   func(keepers keepers.AppKeepers, ctx sdk.Context, address string) int
+	}{
+		{
+			desc: "Star",
+			subscribe: func(srv types.MsgServer, ctx context.Context, creator string, repositoryId types.RepositoryId) error {
+				_, err := srv.StarRepository(ctx, &types.MsgStarRepository{Creator: creator, RepositoryId: repositoryId})
+				return err
+			},
+			unsubscribe: func(srv types.MsgServer, ctx context.Context, creator string, repositoryId types.RepositoryId) error {
+				_, err := srv.UnstarRepository(ctx, &types.MsgUnstarRepository{Creator: creator, RepositoryId: repositoryId})
+				return err
+			},
+			count: func(repository types.Repository) uint64 { return repository.StarsCount },
+			userCount: func(keepers keepers.AppKeepers, ctx sdk.Context, address string) int {
+				return len(keepers.GitopiaKeeper.GetAllUserStar(ctx, address))
+			},
+		},
+		{
+			desc: "Watch",
+			subscribe: func(srv types.MsgServer, ctx context.Context, creator string, repositoryId types.RepositoryId) error {
+				_, err := srv.WatchRepository(ctx, &types.MsgWatchRepository{Creator: creator, RepositoryId: repositoryId})
+				return err
+			},
+			unsubscribe: func(srv types.MsgServer, ctx context.Context, creator string, repositoryId types.RepositoryId) error {
+				_, err := srv.UnwatchRepository(ctx, &types.MsgUnwatchRepository{Creator: creator, RepositoryId: repositoryId})
+				return err
+			},
+			count: func(repository types.Repository) uint64 { return repository.WatchersCount },
+			userCount: func(keepers keepers.AppKeepers, ctx sdk.Context, address string) int {
+				return len(keepers.GitopiaKeeper.GetAllUserWatch(ctx, address))
+			},
+		},
+	} {
+		t.Run(kind.desc, func(t *testing.T) {
+			srv, ctx, keepers := setupMsgServerWithKeepers(t)
+			sdkCtx := sdk.UnwrapSDKContext(ctx)
+			users, repositoryId := setupPreIssue(ctx, t, srv)
+
+			for _, tc := range []struct {
+				desc         string
+				unsubscribe  bool
+				creator      string
+				repositoryId types.RepositoryId
+				err          error
+			}{
+				{
+					desc:         "Creator Not Exists",
+					creator:      "C",
+					repositoryId: repositoryId,
+					err:          sdkerrors.ErrKeyNotFound,
+				},
+				{
+					desc:         "Repository Not Exists",
+					creator:      users[1],
+					repositoryId: types.RepositoryId{Id: users[0], Name: "unknown"},
+					err:          sdkerrors.ErrKeyNotFound,
+				},
+				{
+					desc:         "Completed",
+					creator:      users[1],
+					repositoryId: repositoryId,
+				},
+				{
+					desc:         "Already Subscribed",
+					creator:      users[1],
+					repositoryId: repositoryId,
+					err:          sdkerrors.ErrInvalidRequest,
+				},
+				{
+					desc:         "Other User",
+					creator:      users[0],
+					repositoryId: repositoryId,
+				},
+				{
+					desc:         "Unsubscribe Creator Not Exists",
+					unsubscribe:  true,
+					creator:      "C",
+					repositoryId: repositoryId,
+					err:          sdkerrors.ErrKeyNotFound,
+				},
+				{
+					desc:         "Unsubscribe Completed",
+					unsubscribe:  true,
+					creator:      users[1],
+					repositoryId: repositoryId,
+				},
+				{
+					desc:         "Not Subscribed",
+					unsubscribe:  true,
+					creator:      users[1],
+					repositoryId: repositoryId,
+					err:          sdkerrors.ErrInvalidRequest,
+				},
+			} {
+				t.Run(tc.desc, func(t *testing.T) {
+					call := kind.subscribe
+					if tc.unsubscribe {
+						call = kind.unsubscribe
+					}
+					err := call(srv, ctx, tc.creator, tc.repositoryId)
+					if tc.err != nil {
+						require.ErrorIs(t, err, tc.err)
+					} else {
+						require.NoError(t, err)
+					}
+				})
+			}
+
+			repository, found := keepers.GitopiaKeeper.GetAddressRepository(sdkCtx, users[0], repositoryId.Name)
+			require.True(t, found)
+			require.Equal(t, uint64(1), kind.count(repository))
+			require.Equal(t, 1, kind.userCount(keepers, sdkCtx, users[0]))
+			require.Zero(t, kind.userCount(keepers, sdkCtx, users[1]))
+		})
+	}
+}
+
+func TestSubscriptionQueryRepositoryNotFound(t *testing.T) {
+	srv, ctx, keepers := setupMsgServerWithKeepers(t)
+	users, _ := setupPreIssue(ctx, t, srv)
+
+	for _, tc := range []struct {
+		desc string
+		id   string
+	}{
+		{desc: "Owner Not Exists", id: "unknown"},
+		{desc: "Repository Not Exists", id: users[0]},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := keepers.GitopiaKeeper.RepositoryStargazerAll(ctx, &types.QueryAllRepositoryStargazerRequest{Id: tc.id, RepositoryName: "unknown"})
+			require.Equal(t, codes.NotFound, status.Code(err))
+			_, err = keepers.GitopiaKeeper.RepositoryWatcherAll(ctx, &types.QueryAllRepositoryWatcherRequest{Id: tc.id, RepositoryName: "unknown"})
+			require.Equal(t, codes.NotFound, status.Code(err))
+		})
+	}
+}
@@ -53,6 +53,10 @@ func (k msgServer) UpdateTask(goCtx context.Context, msg *types.MsgUpdateTask) (
 
 	k.SetTask(ctx, task)
 
+	if task.Type == types.TypeTestMergeQueueEntry || task.Type == types.TypeSetPullRequestState {
+		k.HandleMergeQueueTaskResult(ctx, task)
+	}
+
 	if task.State == types.StateFailure {
 		switch task.Type {
 		case types.TypeForkRepository:
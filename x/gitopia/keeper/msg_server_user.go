@@ -263,6 +263,11 @@ func DoRemoveUser(ctx sdk.Context, k msgServer, user types.User) {
 		DoRemoveRepository(ctx, k, repository)
 	}
 
+	k.RemoveAllUserStar(ctx, user.Creator)
+	k.RemoveAllUserWatch(ctx, user.Creator)
+	k.RemoveAllFollow(ctx, user.Creator)
+	k.RemoveAllUserNotification(ctx, user.Creator)
+
 	k.RemoveUser(ctx, user.Creator)
 }
 
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func (k msgServer) WatchRepository(goCtx context.Context, msg *types.MsgWatchRepository) (*types.MsgWatchRepositoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.subscribe(ctx, watchSubscription, msg.Creator, msg.RepositoryId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWatchRepositoryResponse{}, nil
+}
+
+func (k msgServer) UnwatchRepository(goCtx context.Context, msg *types.MsgUnwatchRepository) (*types.MsgUnwatchRepositoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.unsubscribe(ctx, watchSubscription, msg.Creator, msg.RepositoryId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnwatchRepositoryResponse{}, nil
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+// SetNotification set a specific notification in the store
+func (k Keeper) SetNotification(ctx sdk.Context, notification types.Notification) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetNotificationKeyForAddress(notification.Address)),
+	)
+	b := k.cdc.MustMarshal(&notification)
+	store.Set(GetNotificationIDBytes(notification.Id), b)
+}
+
+// GetNotification returns a notification from the inbox of an address
+func (k Keeper) GetNotification(ctx sdk.Context, address string, id uint64) (val types.Notification, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetNotificationKeyForAddress(address)),
+	)
+	b := store.Get(GetNotificationIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// AppendNotification adds a notification to the inbox of its address.
+// Only the latest MaxNotifications notifications are kept.
+func (k Keeper) AppendNotification(ctx sdk.Context, notification types.Notification) uint64 {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetNotificationKeyForAddress(notification.Address)),
+	)
+
+	var id uint64 = 1
+	lastIterator := sdk.KVStoreReversePrefixIterator(store, []byte{})
+	if lastIterator.Valid() {
+		id = GetNotificationIDFromBytes(lastIterator.Key()) + 1
+	}
+	lastIterator.Close()
+
+	notification.Id = id
+	k.SetNotification(ctx, notification)
+
+	// Prune the oldest notifications
+	if id > types.MaxNotifications {
+		iterator := sdk.KVStorePrefixIterator(store, []byte{})
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			if GetNotificationIDFromBytes(iterator.Key()) > id-types.MaxNotifications {
+				break
+			}
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	return id
+}
+
+// GetAllUserNotification returns the notification inbox of an address
+func (k Keeper) GetAllUserNotification(ctx sdk.Context, address string) (list []types.Notification) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetNotificationKeyForAddress(address)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Notification
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllNotification returns all notification
+func (k Keeper) GetAllNotification(ctx sdk.Context) (list []types.Notification) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NotificationKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Notification
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveAllUserNotification removes the notification inbox of an address
+func (k Keeper) RemoveAllUserNotification(ctx sdk.Context, address string) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetNotificationKeyForAddress(address)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// Notify adds the notification to the inbox of every user in addresses. The actor, addresses
+// which aren't users and addresses in notified are skipped, so that an activity notifies a
+// user only once, for the first reason it's notified for.
+func (k Keeper) Notify(ctx sdk.Context, notified map[string]bool, reason types.Notification_Reason, addresses []string, notification types.Notification) {
+	notification.Reason = reason
+	for _, address := range addresses {
+		if address == notification.Actor || notified[address] {
+			continue
+		}
+		if _, found := k.GetUser(ctx, address); !found {
+			continue
+		}
+		notified[address] = true
+
+		notification.Address = address
+		notification.Read = false
+		notification.CreatedAt = ctx.BlockTime().Unix()
+		k.AppendNotification(ctx, notification)
+	}
+}
+
+// GetMentionedUsers returns the addresses of the users @mentioned in text and not already in previousText
+func (k Keeper) GetMentionedUsers(ctx sdk.Context, text string, previousText string) (addresses []string) {
+	previousMentions := make(map[string]bool)
+	for _, mention := range utils.ParseMentions(previousText) {
+		previousMentions[mention] = true
+	}
+
+	for _, mention := range utils.ParseMentions(text) {
+		if previousMentions[mention] {
+			continue
+		}
+		address, err := k.ResolveAddress(ctx, mention)
+		if err != nil || address.OwnerType != types.OwnerType_USER {
+			continue
+		}
+		addresses = append(addresses, address.Address)
+	}
+
+	return addresses
+}
+
+// GetRepositoryWatchers returns the addresses of at most MaxNotifiedWatchers watchers of a
+// repository, so that the cost of notifying them is bounded
+func (k Keeper) GetRepositoryWatchers(ctx sdk.Context, repositoryId uint64) (addresses []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GetRepositoryWatchKeyForRepositoryId(repositoryId)))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(addresses) < types.MaxNotifiedWatchers; iterator.Next() {
+		addresses = append(addresses, string(iterator.Key()))
+	}
+	return addresses
+}
+
+// GetNotificationIDBytes returns the byte representation of the ID
+func GetNotificationIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetNotificationIDFromBytes returns ID in uint64 format from a byte array
+func GetNotificationIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// SetPullRequestAutoMerge set a specific pullRequestAutoMerge in the store
+func (k Keeper) SetPullRequestAutoMerge(ctx sdk.Context, autoMerge types.PullRequestAutoMerge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PullRequestAutoMergeKey))
+	b := k.cdc.MustMarshal(&autoMerge)
+	store.Set(GetPullRequestAutoMergeKeyBytes(autoMerge.RepositoryId, autoMerge.PullRequestIid), b)
+}
+
+// GetPullRequestAutoMerge returns the pullRequestAutoMerge of a pull request
+func (k Keeper) GetPullRequestAutoMerge(ctx sdk.Context, repositoryId uint64, pullRequestIid uint64) (val types.PullRequestAutoMerge, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PullRequestAutoMergeKey))
+	b := store.Get(GetPullRequestAutoMergeKeyBytes(repositoryId, pullRequestIid))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePullRequestAutoMerge removes the pullRequestAutoMerge of a pull request from the store
+func (k Keeper) RemovePullRequestAutoMerge(ctx sdk.Context, repositoryId uint64, pullRequestIid uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PullRequestAutoMergeKey))
+	store.Delete(GetPullRequestAutoMergeKeyBytes(repositoryId, pullRequestIid))
+}
+
+// GetAllPullRequestAutoMerge returns all pullRequestAutoMerge
+func (k Keeper) GetAllPullRequestAutoMerge(ctx sdk.Context) (list []types.PullRequestAutoMerge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PullRequestAutoMergeKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PullRequestAutoMerge
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// QueuePullRequestMerge records the merge method on the pull request and creates the
+// merge task for the git server
+func (k Keeper) QueuePullRequestMerge(ctx sdk.Context, creator string, provider string, pullRequest types.PullRequest, mergeMethod types.MergeMethod) uint64 {
+	pullRequest.MergeMethod = mergeMethod
+	k.SetPullRequest(ctx, pullRequest)
+
+	id := k.AppendTask(ctx, types.Task{
+		Type:     types.TaskType(types.TypeSetPullRequestState),
+		State:    types.TaskState(types.StatePending),
+		Creator:  creator,
+		Provider: provider,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.InvokeMergePullRequestEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeTaskIdKey, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestMergeMethodKey, pullRequest.MergeMethod.String()),
+		),
+	)
+
+	return id
+}
+
+// ProcessPullRequestAutoMerges queues the merge of the auto-merge enabled pull requests which
+// satisfy the merge requirements of their base branch. At most MaxPullRequestAutoMergesPerBlock
+// requests are checked in a block, continuing in the next block from where the previous one
+// stopped. Auto-merge requests of pull requests that are no longer open, whose requester lost
+// the merge permission or which are older than PullRequestAutoMergeExpiry are dropped.
+func (k Keeper) ProcessPullRequestAutoMerges(ctx sdk.Context) {
+	blockTime := ctx.BlockTime().Unix()
+
+	for _, autoMerge := range k.getNextPullRequestAutoMerges(ctx, types.MaxPullRequestAutoMergesPerBlock) {
+		if blockTime >= autoMerge.CreatedAt+types.PullRequestAutoMergeExpiry {
+			k.RemovePullRequestAutoMerge(ctx, autoMerge.RepositoryId, autoMerge.PullRequestIid)
+			continue
+		}
+
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, autoMerge.RepositoryId, autoMerge.PullRequestIid)
+		if !found || pullRequest.State != types.PullRequest_OPEN {
+			k.RemovePullRequestAutoMerge(ctx, autoMerge.RepositoryId, autoMerge.PullRequestIid)
+			continue
+		}
+
+		baseRepository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+		if !found || !k.HavePermission(ctx, autoMerge.Creator, baseRepository, types.PullRequestAutoMergePermission) {
+			k.RemovePullRequestAutoMerge(ctx, autoMerge.RepositoryId, autoMerge.PullRequestIid)
+			continue
+		}
+
+		if pullRequest.Draft {
+			continue
+		}
+		if err := CheckPullRequestMergeMethodAllowed(baseRepository, pullRequest, autoMerge.MergeMethod); err != nil {
+			continue
+		}
+		if err := k.CheckPullRequestMergeAllowed(ctx, autoMerge.Creator, baseRepository, pullRequest); err != nil {
+			continue
+		}
+
+		k.QueuePullRequestMerge(ctx, autoMerge.Creator, autoMerge.Provider, pullRequest, autoMerge.MergeMethod)
+		k.RemovePullRequestAutoMerge(ctx, autoMerge.RepositoryId, autoMerge.PullRequestIid)
+	}
+}
+
+// getNextPullRequestAutoMerges returns at most limit auto-merge requests following the last one
+// returned in the previous call, wrapping around at the end of the store
+func (k Keeper) getNextPullRequestAutoMerges(ctx sdk.Context, limit int) (list []types.PullRequestAutoMerge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PullRequestAutoMergeKey))
+	cursorKey := types.KeyPrefix(types.PullRequestAutoMergeCursorKey)
+
+	// the cursor is the key of the last returned request, the zero byte makes the start exclusive
+	var start []byte
+	if cursor := ctx.KVStore(k.storeKey).Get(cursorKey); cursor != nil {
+		start = append(append([]byte{}, cursor...), 0)
+	}
+
+	collect := func(start []byte, end []byte) {
+		iterator := store.Iterator(start, end)
+		defer iterator.Close()
+
+		for ; iterator.Valid() && len(list) < limit; iterator.Next() {
+			var val types.PullRequestAutoMerge
+			k.cdc.MustUnmarshal(iterator.Value(), &val)
+			list = append(list, val)
+		}
+	}
+	collect(start, nil)
+	if start != nil {
+		collect(nil, start)
+	}
+
+	if len(list) == 0 {
+		ctx.KVStore(k.storeKey).Delete(cursorKey)
+	} else {
+		last := list[len(list)-1]
+		ctx.KVStore(k.storeKey).Set(cursorKey, GetPullRequestAutoMergeKeyBytes(last.RepositoryId, last.PullRequestIid))
+	}
+
+	return
+}
+
+// GetPullRequestAutoMergeKeyBytes returns the byte representation of the pull request key
+func GetPullRequestAutoMergeKeyBytes(repositoryId uint64, pullRequestIid uint64) []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, repositoryId)
+	binary.BigEndian.PutUint64(bz[8:], pullRequestIid)
+	return bz
+}
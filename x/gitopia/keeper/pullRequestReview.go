@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// GetPullRequestReviewCount get the total number of pullRequestReview
+func (k Keeper) GetPullRequestReviewCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.PullRequestReviewCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetPullRequestReviewCount set the total number of pullRequestReview
+func (k Keeper) SetPullRequestReviewCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.PullRequestReviewCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendPullRequestReview appends a pullRequestReview in the store with a new id and update the count
+func (k Keeper) AppendPullRequestReview(
+	ctx sdk.Context,
+	pullRequestReview types.PullRequestReview,
+) uint64 {
+	// Create the pullRequestReview
+	count := k.GetPullRequestReviewCount(ctx)
+
+	// Set the ID of the appended value
+	pullRequestReview.Id = count
+
+	k.SetPullRequestReview(ctx, pullRequestReview)
+
+	// Update pullRequestReview count
+	k.SetPullRequestReviewCount(ctx, count+1)
+
+	return count
+}
+
+// SetPullRequestReview set a specific pullRequestReview in the store
+func (k Keeper) SetPullRequestReview(ctx sdk.Context, pullRequestReview types.PullRequestReview) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetPullRequestReviewKeyForPullRequest(pullRequestReview.RepositoryId, pullRequestReview.PullRequestIid)),
+	)
+	b := k.cdc.MustMarshal(&pullRequestReview)
+	store.Set(GetPullRequestReviewIDBytes(pullRequestReview.Id), b)
+}
+
+// GetPullRequestReview returns a pullRequestReview from its id
+func (k Keeper) GetPullRequestReview(ctx sdk.Context, repositoryId uint64, pullRequestIid uint64, id uint64) (val types.PullRequestReview, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetPullRequestReviewKeyForPullRequest(repositoryId, pullRequestIid)),
+	)
+	b := store.Get(GetPullRequestReviewIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePullRequestReview removes a pullRequestReview from the store
+func (k Keeper) RemovePullRequestReview(ctx sdk.Context, repositoryId uint64, pullRequestIid uint64, id uint64) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetPullRequestReviewKeyForPullRequest(repositoryId, pullRequestIid)),
+	)
+	store.Delete(GetPullRequestReviewIDBytes(id))
+}
+
+// GetAllPullRequestReview returns all pullRequestReview
+func (k Keeper) GetAllPullRequestReview(ctx sdk.Context) (list []types.PullRequestReview) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PullRequestReviewKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PullRequestReview
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllPullRequestReviewForPullRequest returns all the reviews of a pull request in submission order
+func (k Keeper) GetAllPullRequestReviewForPullRequest(ctx sdk.Context, repositoryId uint64, pullRequestIid uint64) (list []types.PullRequestReview) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetPullRequestReviewKeyForPullRequest(repositoryId, pullRequestIid)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PullRequestReview
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetLatestPullRequestReviews returns the latest APPROVE / REQUEST_CHANGES review of every reviewer
+// ordered by the first review of each reviewer. COMMENT reviews don't change the verdict of a reviewer.
+func (k Keeper) GetLatestPullRequestReviews(ctx sdk.Context, repositoryId uint64, pullRequestIid uint64) (list []types.PullRequestReview) {
+	index := make(map[string]int)
+	for _, review := range k.GetAllPullRequestReviewForPullRequest(ctx, repositoryId, pullRequestIid) {
+		if review.State == types.PullRequestReview_COMMENT {
+			continue
+		}
+		if i, exists := index[review.Creator]; exists {
+			list[i] = review
+			continue
+		}
+		index[review.Creator] = len(list)
+		list = append(list, review)
+	}
+	return
+}
+
+// GetPullRequestHeadSha returns the current sha of the pull request head branch
+func (k Keeper) GetPullRequestHeadSha(ctx sdk.Context, pullRequest types.PullRequest) string {
+	branch, found := k.GetRepositoryBranch(ctx, pullRequest.Head.RepositoryId, pullRequest.Head.Branch)
+	if !found {
+		return pullRequest.Head.CommitSha
+	}
+	return branch.Sha
+}
+
+// GetPullRequestReviewIDBytes returns the byte representation of the ID
+func GetPullRequestReviewIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/gitopia/gitopia/testutil/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNPullRequestReview(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.PullRequestReview {
+	items := make([]types.PullRequestReview, n)
+	for i := range items {
+		items[i].RepositoryId = 0
+		items[i].PullRequestIid = 1
+		items[i].Id = keeper.AppendPullRequestReview(ctx, items[i])
+	}
+	return items
+}
+
+func TestPullRequestReviewGet(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	keeper := &keepers.GitopiaKeeper
+	items := createNPullRequestReview(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetPullRequestReview(ctx, 0, 1, item.Id)
+		require.True(t, found)
+		require.Equal(t, item, got)
+	}
+}
+
+func TestPullRequestReviewRemove(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	keeper := &keepers.GitopiaKeeper
+	items := createNPullRequestReview(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemovePullRequestReview(ctx, 0, 1, item.Id)
+		_, found := keeper.GetPullRequestReview(ctx, 0, 1, item.Id)
+		require.False(t, found)
+	}
+}
+
+func TestPullRequestReviewGetAll(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	keeper := &keepers.GitopiaKeeper
+	items := createNPullRequestReview(keeper, ctx, 10)
+	require.ElementsMatch(t, items, keeper.GetAllPullRequestReviewForPullRequest(ctx, 0, 1))
+	require.ElementsMatch(t, items, keeper.GetAllPullRequestReview(ctx))
+}
+
+func TestPullRequestReviewCount(t *testing.T) {
+	keepers, ctx := keepertest.AppKeepers(t)
+	keeper := &keepers.GitopiaKeeper
+	items := createNPullRequestReview(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetPullRequestReviewCount(ctx))
+}
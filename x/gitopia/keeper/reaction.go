@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// toggleReaction adds the emoji to the reaction of the address or removes it
+// if the address has already reacted with the emoji. It returns the updated
+// reactions and whether the emoji was added.
+func toggleReaction(reactions []*types.Reaction, address string, emoji types.Emoji) ([]*types.Reaction, bool) {
+	for i, reaction := range reactions {
+		if reaction.Address != address {
+			continue
+		}
+
+		for j, e := range reaction.Emojis {
+			if e == emoji {
+				reaction.Emojis = append(reaction.Emojis[:j], reaction.Emojis[j+1:]...)
+				if len(reaction.Emojis) == 0 {
+					reactions = append(reactions[:i], reactions[i+1:]...)
+				}
+				return reactions, false
+			}
+		}
+
+		reaction.Emojis = append(reaction.Emojis, emoji)
+		return reactions, true
+	}
+
+	return append(reactions, &types.Reaction{
+		Address: address,
+		Emojis:  []types.Emoji{emoji},
+	}), true
+}
+
+// getReactionCounts returns the number of reactions per emoji ordered by emoji
+func getReactionCounts(reactions []*types.Reaction) []*types.ReactionCount {
+	counts := make(map[types.Emoji]uint64)
+	for _, reaction := range reactions {
+		for _, emoji := range reaction.Emojis {
+			counts[emoji]++
+		}
+	}
+
+	var reactionCounts []*types.ReactionCount
+	for emoji, count := range counts {
+		reactionCounts = append(reactionCounts, &types.ReactionCount{
+			Emoji: emoji,
+			Count: count,
+		})
+	}
+
+	sort.Slice(reactionCounts, func(i, j int) bool {
+		return reactionCounts[i].Emoji < reactionCounts[j].Emoji
+	})
+
+	return reactionCounts
+}
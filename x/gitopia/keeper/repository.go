@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"encoding/binary"
+	"fmt"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gitopia/gitopia/x/gitopia/types"
 )
 
@@ -220,3 +222,12 @@ func GetRepositoryIDFromBytes(bz []byte) uint64 {
 func GetRepositoryKeyBytesFromBaseKey(repositoryId types.BaseRepositoryKey) []byte {
 	return []byte(repositoryId.Address + "-" + repositoryId.Name)
 }
+
+// CheckRepositoryNotArchived returns an error if the repository is archived. An archived
+// repository is read-only until it is unarchived.
+func CheckRepositoryNotArchived(repository types.Repository) error {
+	if repository.Archived {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository (%v) is archived", repository.Name))
+	}
+	return nil
+}
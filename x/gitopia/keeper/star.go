@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// SetRepositoryStar set a repository star in the store along with its user index
+func (k Keeper) SetRepositoryStar(ctx sdk.Context, star types.RepositoryStar) {
+	k.setSubscription(ctx, starSubscription, star.RepositoryId, star.Address, &star)
+}
+
+// GetRepositoryStar returns the star of a repository by the given address
+func (k Keeper) GetRepositoryStar(ctx sdk.Context, repositoryId uint64, address string) (val types.RepositoryStar, found bool) {
+	found = k.getSubscription(ctx, starSubscription, repositoryId, address, &val)
+	return val, found
+}
+
+// RemoveRepositoryStar removes a repository star and its user index from the store
+func (k Keeper) RemoveRepositoryStar(ctx sdk.Context, repositoryId uint64, address string) {
+	k.removeSubscription(ctx, starSubscription, repositoryId, address)
+}
+
+// GetAllRepositoryStar returns all stars of a repository
+func (k Keeper) GetAllRepositoryStar(ctx sdk.Context, repositoryId uint64) (list []types.RepositoryStar) {
+	k.iterateSubscriptions(ctx, types.GetRepositoryStarKeyForRepositoryId(repositoryId), func(b []byte) {
+		var val types.RepositoryStar
+		k.cdc.MustUnmarshal(b, &val)
+		list = append(list, val)
+	})
+	return
+}
+
+// GetAllUserStar returns all stars given by an address
+func (k Keeper) GetAllUserStar(ctx sdk.Context, address string) (list []types.RepositoryStar) {
+	k.iterateSubscriptions(ctx, types.GetUserStarKeyForAddress(address), func(b []byte) {
+		var val types.RepositoryStar
+		k.cdc.MustUnmarshal(b, &val)
+		list = append(list, val)
+	})
+	return
+}
+
+// GetAllStar returns all repository stars
+func (k Keeper) GetAllStar(ctx sdk.Context) (list []types.RepositoryStar) {
+	k.iterateSubscriptions(ctx, types.RepositoryStarKey, func(b []byte) {
+		var val types.RepositoryStar
+		k.cdc.MustUnmarshal(b, &val)
+		list = append(list, val)
+	})
+	return
+}
+
+// RemoveAllRepositoryStar removes every star of a repository from the store
+func (k Keeper) RemoveAllRepositoryStar(ctx sdk.Context, repositoryId uint64) {
+	k.removeAllRepositorySubscriptions(ctx, starSubscription, repositoryId)
+}
+
+// RemoveAllUserStar removes every star given by an address and updates the
+// stars count of the starred repositories
+func (k Keeper) RemoveAllUserStar(ctx sdk.Context, address string) {
+	k.removeAllUserSubscriptions(ctx, starSubscription, address)
+}
@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// repositorySubscription describes a subscription of a user to a repository, such as a star
+// or a watch. Subscriptions are stored by repository along with an index by user, and the
+// repository keeps the number of its subscribers.
+type repositorySubscription struct {
+	repositoryKey func(repositoryId uint64) string
+	userKey       func(address string) string
+	count         func(repository *types.Repository) *uint64
+	newValue      func(repositoryId uint64, address string, createdAt int64) codec.ProtoMarshaler
+
+	subscribeEventKey   string
+	unsubscribeEventKey string
+	countAttributeKey   string
+	subscribedError     string
+	notSubscribedError  string
+}
+
+var starSubscription = repositorySubscription{
+	repositoryKey: types.GetRepositoryStarKeyForRepositoryId,
+	userKey:       types.GetUserStarKeyForAddress,
+	count:         func(repository *types.Repository) *uint64 { return &repository.StarsCount },
+	newValue: func(repositoryId uint64, address string, createdAt int64) codec.ProtoMarshaler {
+		return &types.RepositoryStar{RepositoryId: repositoryId, Address: address, CreatedAt: createdAt}
+	},
+
+	subscribeEventKey:   types.StarRepositoryEventKey,
+	unsubscribeEventKey: types.UnstarRepositoryEventKey,
+	countAttributeKey:   types.EventAttributeRepoStarsCountKey,
+	subscribedError:     "user (%v) has already starred the repository",
+	notSubscribedError:  "user (%v) hasn't starred the repository",
+}
+
+var watchSubscription = repositorySubscription{
+	repositoryKey: types.GetRepositoryWatchKeyForRepositoryId,
+	userKey:       types.GetUserWatchKeyForAddress,
+	count:         func(repository *types.Repository) *uint64 { return &repository.WatchersCount },
+	newValue: func(repositoryId uint64, address string, createdAt int64) codec.ProtoMarshaler {
+		return &types.RepositoryWatch{RepositoryId: repositoryId, Address: address, CreatedAt: createdAt}
+	},
+
+	subscribeEventKey:   types.WatchRepositoryEventKey,
+	unsubscribeEventKey: types.UnwatchRepositoryEventKey,
+	countAttributeKey:   types.EventAttributeRepoWatchersCountKey,
+	subscribedError:     "user (%v) is already watching the repository",
+	notSubscribedError:  "user (%v) isn't watching the repository",
+}
+
+// setSubscription set a subscription in the store along with its user index
+func (k Keeper) setSubscription(ctx sdk.Context, s repositorySubscription, repositoryId uint64, address string, val codec.ProtoMarshaler) {
+	b := k.cdc.MustMarshal(val)
+
+	repositoryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(s.repositoryKey(repositoryId)))
+	repositoryStore.Set([]byte(address), b)
+
+	userStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(s.userKey(address)))
+	userStore.Set(GetRepositoryIDBytes(repositoryId), b)
+}
+
+// getSubscription reads the subscription of address to a repository into val
+func (k Keeper) getSubscription(ctx sdk.Context, s repositorySubscription, repositoryId uint64, address string, val codec.ProtoMarshaler) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(s.repositoryKey(repositoryId)))
+	b := store.Get([]byte(address))
+	if b == nil {
+		return false
+	}
+	k.cdc.MustUnmarshal(b, val)
+	return true
+}
+
+// removeSubscription removes a subscription and its user index from the store
+func (k Keeper) removeSubscription(ctx sdk.Context, s repositorySubscription, repositoryId uint64, address string) {
+	repositoryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(s.repositoryKey(repositoryId)))
+	repositoryStore.Delete([]byte(address))
+
+	userStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(s.userKey(address)))
+	userStore.Delete(GetRepositoryIDBytes(repositoryId))
+}
+
+// iterateSubscriptions calls cb with every subscription stored under keyPrefix
+func (k Keeper) iterateSubscriptions(ctx sdk.Context, keyPrefix string, cb func(b []byte)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		cb(iterator.Value())
+	}
+}
+
+// removeAllUserSubscriptions removes every subscription of an address and updates the
+// subscribers count of the repositories
+func (k Keeper) removeAllUserSubscriptions(ctx sdk.Context, s repositorySubscription, address string) {
+	var repositoryIds []uint64
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(s.userKey(address)))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		repositoryIds = append(repositoryIds, GetRepositoryIDFromBytes(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, repositoryId := range repositoryIds {
+		k.removeSubscription(ctx, s, repositoryId, address)
+
+		repository, found := k.GetRepositoryById(ctx, repositoryId)
+		if found && *s.count(&repository) > 0 {
+			*s.count(&repository)--
+			k.SetRepository(ctx, repository)
+		}
+	}
+}
+
+// removeAllRepositorySubscriptions removes every subscription to a repository from the store
+func (k Keeper) removeAllRepositorySubscriptions(ctx sdk.Context, s repositorySubscription, repositoryId uint64) {
+	var addresses []string
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(s.repositoryKey(repositoryId)))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, address := range addresses {
+		k.removeSubscription(ctx, s, repositoryId, address)
+	}
+}
+
+func (k Keeper) getSubscriptionRepository(ctx sdk.Context, creator string, repositoryId types.RepositoryId) (types.Repository, error) {
+	if _, found := k.GetUser(ctx, creator); !found {
+		return types.Repository{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, repositoryId.Id)
+	if err != nil {
+		return types.Repository{}, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, repositoryId.Name)
+	if !found {
+		return types.Repository{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", repositoryId.Id, repositoryId.Name))
+	}
+
+	return repository, nil
+}
+
+func (k Keeper) hasSubscription(ctx sdk.Context, s repositorySubscription, repositoryId uint64, address string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(s.repositoryKey(repositoryId)))
+	return store.Has([]byte(address))
+}
+
+// subscribe subscribes creator to a repository and increments its subscribers count
+func (k Keeper) subscribe(ctx sdk.Context, s repositorySubscription, creator string, repositoryId types.RepositoryId) error {
+	repository, err := k.getSubscriptionRepository(ctx, creator, repositoryId)
+	if err != nil {
+		return err
+	}
+
+	if k.hasSubscription(ctx, s, repository.Id, creator) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf(s.subscribedError, creator))
+	}
+
+	k.setSubscription(ctx, s, repository.Id, creator, s.newValue(repository.Id, creator, ctx.BlockTime().Unix()))
+
+	*s.count(&repository)++
+	k.SetRepository(ctx, repository)
+
+	k.emitSubscriptionEvent(ctx, s.subscribeEventKey, s, creator, repository)
+
+	return nil
+}
+
+// unsubscribe unsubscribes creator from a repository and decrements its subscribers count
+func (k Keeper) unsubscribe(ctx sdk.Context, s repositorySubscription, creator string, repositoryId types.RepositoryId) error {
+	repository, err := k.getSubscriptionRepository(ctx, creator, repositoryId)
+	if err != nil {
+		return err
+	}
+
+	if !k.hasSubscription(ctx, s, repository.Id, creator) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf(s.notSubscribedError, creator))
+	}
+
+	k.removeSubscription(ctx, s, repository.Id, creator)
+
+	if *s.count(&repository) > 0 {
+		*s.count(&repository)--
+	}
+	k.SetRepository(ctx, repository)
+
+	k.emitSubscriptionEvent(ctx, s.unsubscribeEventKey, s, creator, repository)
+
+	return nil
+}
+
+func (k Keeper) emitSubscriptionEvent(ctx sdk.Context, action string, s repositorySubscription, creator string, repository types.Repository) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, action),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(s.countAttributeKey, strconv.FormatUint(*s.count(&repository), 10)),
+		),
+	)
+}
+
+// paginateUserSubscriptions returns a page of the repositories an address is subscribed to
+func (k Keeper) paginateUserSubscriptions(ctx sdk.Context, s repositorySubscription, address string, pagination *query.PageRequest) ([]*types.Repository, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(s.userKey(address)))
+
+	var repositorys []*types.Repository
+	pageRes, err := query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		repository, found := k.GetRepositoryById(ctx, GetRepositoryIDFromBytes(key))
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "repository id (%d) doesn't exist", GetRepositoryIDFromBytes(key))
+		}
+
+		repositorys = append(repositorys, &repository)
+		return nil
+	})
+
+	return repositorys, pageRes, err
+}
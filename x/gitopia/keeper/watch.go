@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// SetRepositoryWatch set a repository watch in the store along with its user index
+func (k Keeper) SetRepositoryWatch(ctx sdk.Context, watch types.RepositoryWatch) {
+	k.setSubscription(ctx, watchSubscription, watch.RepositoryId, watch.Address, &watch)
+}
+
+// GetRepositoryWatch returns the watch of a repository by the given address
+func (k Keeper) GetRepositoryWatch(ctx sdk.Context, repositoryId uint64, address string) (val types.RepositoryWatch, found bool) {
+	found = k.getSubscription(ctx, watchSubscription, repositoryId, address, &val)
+	return val, found
+}
+
+// RemoveRepositoryWatch removes a repository watch and its user index from the store
+func (k Keeper) RemoveRepositoryWatch(ctx sdk.Context, repositoryId uint64, address string) {
+	k.removeSubscription(ctx, watchSubscription, repositoryId, address)
+}
+
+// GetAllRepositoryWatch returns all watches of a repository
+func (k Keeper) GetAllRepositoryWatch(ctx sdk.Context, repositoryId uint64) (list []types.RepositoryWatch) {
+	k.iterateSubscriptions(ctx, types.GetRepositoryWatchKeyForRepositoryId(repositoryId), func(b []byte) {
+		var val types.RepositoryWatch
+		k.cdc.MustUnmarshal(b, &val)
+		list = append(list, val)
+	})
+	return
+}
+
+// GetAllUserWatch returns all watches of an address
+func (k Keeper) GetAllUserWatch(ctx sdk.Context, address string) (list []types.RepositoryWatch) {
+	k.iterateSubscriptions(ctx, types.GetUserWatchKeyForAddress(address), func(b []byte) {
+		var val types.RepositoryWatch
+		k.cdc.MustUnmarshal(b, &val)
+		list = append(list, val)
+	})
+	return
+}
+
+// GetAllWatch returns all repository watches
+func (k Keeper) GetAllWatch(ctx sdk.Context) (list []types.RepositoryWatch) {
+	k.iterateSubscriptions(ctx, types.RepositoryWatchKey, func(b []byte) {
+		var val types.RepositoryWatch
+		k.cdc.MustUnmarshal(b, &val)
+		list = append(list, val)
+	})
+	return
+}
+
+// RemoveAllRepositoryWatch removes every watch of a repository from the store
+func (k Keeper) RemoveAllRepositoryWatch(ctx sdk.Context, repositoryId uint64) {
+	k.removeAllRepositorySubscriptions(ctx, watchSubscription, repositoryId)
+}
+
+// RemoveAllUserWatch removes every watch of an address and updates the
+// watchers count of the watched repositories
+func (k Keeper) RemoveAllUserWatch(ctx sdk.Context, address string) {
+	k.removeAllUserSubscriptions(ctx, watchSubscription, address)
+}
@@ -0,0 +1,66 @@
+package v4
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// getBountyExpiryKeyBytes returns the key of a bounty in the expiry index
+func getBountyExpiryKeyBytes(expireAt int64, id uint64) []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(expireAt))
+	binary.BigEndian.PutUint64(bz[8:], id)
+	return bz
+}
+
+// migrateBountyExpiry indexes the open bounties by their expiry time, so that
+// bounties created before the index existed are refunded once they expire.
+func migrateBountyExpiry(store sdk.KVStore, cdc codec.BinaryCodec) {
+	bountyStore := prefix.NewStore(store, types.KeyPrefix(types.BountyKey))
+	expiryStore := prefix.NewStore(store, types.KeyPrefix(types.BountyExpiryKey))
+
+	bountyStoreIter := bountyStore.Iterator(nil, nil)
+	defer bountyStoreIter.Close()
+
+	for ; bountyStoreIter.Valid(); bountyStoreIter.Next() {
+		var bounty types.Bounty
+		cdc.MustUnmarshal(bountyStoreIter.Value(), &bounty)
+
+		if bounty.State != types.BountyStateSRCDEBITTED || bounty.ExpireAt <= 0 {
+			continue
+		}
+		expiryStore.Set(getBountyExpiryKeyBytes(bounty.ExpireAt, bounty.Id), bountyStoreIter.Key())
+	}
+}
+
+// migrateParams sets the bounty dispute window, which didn't exist before version 4,
+// to its default value.
+func migrateParams(store sdk.KVStore, cdc codec.BinaryCodec) {
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	}
+
+	params.BountyDisputeWindow = types.DefaultParams().BountyDisputeWindow
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+}
+
+// MigrateStore performs in-place store migrations from consensus version 3 to 4. The
+// migration includes:
+//
+// - Index the open bounties by their expiry time.
+// - Set the bounty dispute window param.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	migrateBountyExpiry(store, cdc)
+	migrateParams(store, cdc)
+
+	return nil
+}
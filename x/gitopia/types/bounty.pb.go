@@ -31,18 +31,24 @@ const (
 	BountyStateSRCDEBITTED  BountyState = 0
 	BountyStateDESTCREDITED BountyState = 1
 	BountyStateREVERTEDBACK BountyState = 2
+	BountyStateAWARDED      BountyState = 3
+	BountyStateDISPUTED     BountyState = 4
 )
 
 var BountyState_name = map[int32]string{
 	0: "BOUNTY_STATE_SRCDEBITTED",
 	1: "BOUNTY_STATE_DESTCREDITED",
 	2: "BOUNTY_STATE_REVERTEDBACK",
+	3: "BOUNTY_STATE_AWARDED",
+	4: "BOUNTY_STATE_DISPUTED",
 }
 
 var BountyState_value = map[string]int32{
 	"BOUNTY_STATE_SRCDEBITTED":  0,
 	"BOUNTY_STATE_DESTCREDITED": 1,
 	"BOUNTY_STATE_REVERTEDBACK": 2,
+	"BOUNTY_STATE_AWARDED":      3,
+	"BOUNTY_STATE_DISPUTED":     4,
 }
 
 func (x BountyState) String() string {
@@ -56,15 +62,18 @@ func (BountyState) EnumDescriptor() ([]byte, []int) {
 type BountyParent int32
 
 const (
-	BountyParentIssue BountyParent = 0
+	BountyParentIssue       BountyParent = 0
+	BountyParentPullRequest BountyParent = 1
 )
 
 var BountyParent_name = map[int32]string{
 	0: "BOUNTY_PARENT_ISSUE",
+	1: "BOUNTY_PARENT_PULL_REQUEST",
 }
 
 var BountyParent_value = map[string]int32{
-	"BOUNTY_PARENT_ISSUE": 0,
+	"BOUNTY_PARENT_ISSUE":        0,
+	"BOUNTY_PARENT_PULL_REQUEST": 1,
 }
 
 func (x BountyParent) String() string {
@@ -87,6 +96,10 @@ type Bounty struct {
 	CreatedAt    int64                                    `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt    int64                                    `protobuf:"varint,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 	Creator      string                                   `protobuf:"bytes,11,opt,name=creator,proto3" json:"creator,omitempty"`
+	Splits       []*BountySplit                           `protobuf:"bytes,12,rep,name=splits,proto3" json:"splits,omitempty"`
+	// time at which an awarded bounty is paid to rewardedTo unless it is disputed
+	ReleaseAt int64          `protobuf:"varint,13,opt,name=releaseAt,proto3" json:"releaseAt,omitempty"`
+	Dispute   *BountyDispute `protobuf:"bytes,14,opt,name=dispute,proto3" json:"dispute,omitempty"`
 }
 
 func (m *Bounty) Reset()         { *m = Bounty{} }
@@ -199,50 +212,318 @@ func (m *Bounty) GetCreator() string {
 	return ""
 }
 
+func (m *Bounty) GetSplits() []*BountySplit {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+func (m *Bounty) GetReleaseAt() int64 {
+	if m != nil {
+		return m.ReleaseAt
+	}
+	return 0
+}
+
+func (m *Bounty) GetDispute() *BountyDispute {
+	if m != nil {
+		return m.Dispute
+	}
+	return nil
+}
+
+// BountyDispute is raised against an awarded bounty and resolved by the bounty arbiter
+type BountyDispute struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ResolvedBy string `protobuf:"bytes,4,opt,name=resolvedBy,proto3" json:"resolvedBy,omitempty"`
+	ResolvedAt int64  `protobuf:"varint,5,opt,name=resolvedAt,proto3" json:"resolvedAt,omitempty"`
+}
+
+func (m *BountyDispute) Reset()         { *m = BountyDispute{} }
+func (m *BountyDispute) String() string { return proto.CompactTextString(m) }
+func (*BountyDispute) ProtoMessage()    {}
+func (*BountyDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a698d5c16076fb, []int{1}
+}
+func (m *BountyDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BountyDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BountyDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BountyDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BountyDispute.Merge(m, src)
+}
+func (m *BountyDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *BountyDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_BountyDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BountyDispute proto.InternalMessageInfo
+
+func (m *BountyDispute) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *BountyDispute) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BountyDispute) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *BountyDispute) GetResolvedBy() string {
+	if m != nil {
+		return m.ResolvedBy
+	}
+	return ""
+}
+
+func (m *BountyDispute) GetResolvedAt() int64 {
+	if m != nil {
+		return m.ResolvedAt
+	}
+	return 0
+}
+
+// BountySplit is the share of a bounty paid to an address. The part of the bounty
+// not covered by the splits is paid to the pull request creator.
+type BountySplit struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// share in percent of the bounty amount
+	Percentage uint64 `protobuf:"varint,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// fixed amount, used when percentage is not set
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// amount paid to address
+	Rewarded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=rewarded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewarded"`
+}
+
+func (m *BountySplit) Reset()         { *m = BountySplit{} }
+func (m *BountySplit) String() string { return proto.CompactTextString(m) }
+func (*BountySplit) ProtoMessage()    {}
+func (*BountySplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a698d5c16076fb, []int{2}
+}
+func (m *BountySplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BountySplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BountySplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BountySplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BountySplit.Merge(m, src)
+}
+func (m *BountySplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *BountySplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BountySplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BountySplit proto.InternalMessageInfo
+
+func (m *BountySplit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BountySplit) GetPercentage() uint64 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
+func (m *BountySplit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *BountySplit) GetRewarded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewarded
+	}
+	return nil
+}
+
+// BountyFunder is the contribution of an address to a bounty
+type BountyFunder struct {
+	BountyId  uint64                                   `protobuf:"varint,1,opt,name=bountyId,proto3" json:"bountyId,omitempty"`
+	Address   string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	CreatedAt int64                                    `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt int64                                    `protobuf:"varint,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (m *BountyFunder) Reset()         { *m = BountyFunder{} }
+func (m *BountyFunder) String() string { return proto.CompactTextString(m) }
+func (*BountyFunder) ProtoMessage()    {}
+func (*BountyFunder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a698d5c16076fb, []int{3}
+}
+func (m *BountyFunder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BountyFunder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BountyFunder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BountyFunder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BountyFunder.Merge(m, src)
+}
+func (m *BountyFunder) XXX_Size() int {
+	return m.Size()
+}
+func (m *BountyFunder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BountyFunder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BountyFunder proto.InternalMessageInfo
+
+func (m *BountyFunder) GetBountyId() uint64 {
+	if m != nil {
+		return m.BountyId
+	}
+	return 0
+}
+
+func (m *BountyFunder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BountyFunder) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *BountyFunder) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *BountyFunder) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("gitopia.gitopia.gitopia.BountyState", BountyState_name, BountyState_value)
 	proto.RegisterEnum("gitopia.gitopia.gitopia.BountyParent", BountyParent_name, BountyParent_value)
 	proto.RegisterType((*Bounty)(nil), "gitopia.gitopia.gitopia.Bounty")
+	proto.RegisterType((*BountyDispute)(nil), "gitopia.gitopia.gitopia.BountyDispute")
+	proto.RegisterType((*BountySplit)(nil), "gitopia.gitopia.gitopia.BountySplit")
+	proto.RegisterType((*BountyFunder)(nil), "gitopia.gitopia.gitopia.BountyFunder")
 }
 
 func init() { proto.RegisterFile("gitopia/bounty.proto", fileDescriptor_67a698d5c16076fb) }
 
 var fileDescriptor_67a698d5c16076fb = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0x4f, 0xda, 0xae, 0x5b, 0xdd, 0x69, 0x2a, 0x66, 0x30, 0x2f, 0xa0, 0x2c, 0x9a, 0x40, 0x8a,
-	0x2a, 0x91, 0xb0, 0x71, 0x41, 0x93, 0x38, 0x34, 0x4d, 0x0e, 0x11, 0xd2, 0x98, 0x9c, 0x0c, 0x09,
-	0x2e, 0x55, 0xda, 0x58, 0x25, 0x42, 0xad, 0xa3, 0xd8, 0x85, 0xf5, 0x0d, 0x50, 0x4f, 0xbc, 0x40,
-	0x4f, 0xdc, 0x78, 0x0f, 0xa4, 0x1d, 0x38, 0xec, 0xc8, 0x09, 0x50, 0xfb, 0x22, 0x28, 0x4e, 0xda,
-	0x79, 0x4c, 0x88, 0xd3, 0x97, 0xef, 0xf7, 0xef, 0xfb, 0x62, 0xd9, 0x60, 0x77, 0x98, 0x70, 0x9a,
-	0x26, 0x91, 0xdd, 0xa7, 0x93, 0x31, 0x9f, 0x5a, 0x69, 0x46, 0x39, 0x85, 0x7b, 0x25, 0x6a, 0xfd,
-	0x55, 0xb5, 0xdd, 0x21, 0x1d, 0x52, 0xa1, 0xb1, 0xf3, 0xaf, 0x42, 0xae, 0xe9, 0x03, 0xca, 0x46,
-	0x94, 0xd9, 0xfd, 0x88, 0x11, 0xfb, 0xc3, 0x51, 0x9f, 0xf0, 0xe8, 0xc8, 0x1e, 0xd0, 0x64, 0x5c,
-	0xf0, 0x87, 0xdf, 0xab, 0xa0, 0xee, 0x88, 0x7c, 0xb8, 0x03, 0x2a, 0x49, 0x8c, 0x54, 0x43, 0x35,
-	0x6b, 0xb8, 0x92, 0xc4, 0x70, 0x00, 0xea, 0xd1, 0x28, 0xa7, 0x50, 0xc5, 0xa8, 0x9a, 0xcd, 0xe3,
-	0x7d, 0xab, 0xc8, 0xb2, 0xf2, 0x2c, 0xab, 0xcc, 0xb2, 0xba, 0x34, 0x19, 0x3b, 0x4f, 0x2f, 0x7f,
-	0x1e, 0x28, 0x5f, 0x7f, 0x1d, 0x98, 0xc3, 0x84, 0xbf, 0x9b, 0xf4, 0xad, 0x01, 0x1d, 0xd9, 0xe5,
-	0xe0, 0xa2, 0x3c, 0x61, 0xf1, 0x7b, 0x9b, 0x4f, 0x53, 0xc2, 0x84, 0x81, 0xe1, 0x32, 0x1a, 0x9e,
-	0x80, 0x0d, 0xc6, 0x23, 0x4e, 0x50, 0xd5, 0x50, 0xcd, 0x9d, 0xe3, 0x47, 0xd6, 0x3f, 0x7e, 0xcf,
-	0x2a, 0x96, 0x0c, 0x72, 0x2d, 0x2e, 0x2c, 0xf0, 0x10, 0x6c, 0x67, 0x24, 0xa5, 0x2c, 0xe1, 0x34,
-	0x9b, 0xfa, 0x31, 0xaa, 0x89, 0xd5, 0x6f, 0x60, 0xf0, 0x21, 0x68, 0xa4, 0x51, 0x46, 0xc6, 0xdc,
-	0x4f, 0x62, 0xb4, 0x21, 0x04, 0xd7, 0x00, 0x7c, 0x01, 0xea, 0x45, 0x83, 0xea, 0x62, 0xfc, 0xe3,
-	0xff, 0x8c, 0x3f, 0x13, 0x62, 0x5c, 0x9a, 0xa0, 0x06, 0xb6, 0xc8, 0x45, 0x9a, 0x64, 0xa4, 0xc3,
-	0xd1, 0xa6, 0xa1, 0x9a, 0x55, 0xbc, 0xee, 0xa1, 0x0e, 0x40, 0x46, 0x3e, 0x46, 0x59, 0x4c, 0xe2,
-	0x90, 0xa2, 0x2d, 0x43, 0x35, 0x1b, 0x58, 0x42, 0xf2, 0xc5, 0x06, 0x19, 0x89, 0x38, 0x89, 0x3b,
-	0x1c, 0x35, 0x84, 0xf9, 0x1a, 0xc8, 0xd9, 0x49, 0x1a, 0x97, 0x2c, 0x28, 0xd8, 0x35, 0x00, 0x11,
-	0xd8, 0x14, 0x52, 0x9a, 0xa1, 0xa6, 0x08, 0x5e, 0xb5, 0xed, 0x6f, 0x2a, 0x68, 0x4a, 0x27, 0x05,
-	0x9f, 0x03, 0xe4, 0xbc, 0x3a, 0x3f, 0x0d, 0xdf, 0xf4, 0x82, 0xb0, 0x13, 0x7a, 0xbd, 0x00, 0x77,
-	0x5d, 0xcf, 0xf1, 0xc3, 0xd0, 0x73, 0x5b, 0x8a, 0xa6, 0xcd, 0xe6, 0xc6, 0x7d, 0x49, 0x2e, 0xb1,
-	0xf0, 0x04, 0xec, 0xdf, 0x70, 0xba, 0x5e, 0x10, 0x76, 0xb1, 0xe7, 0xfa, 0xb9, 0x55, 0xd5, 0x1e,
-	0xcc, 0xe6, 0xc6, 0x9e, 0x64, 0x95, 0xe9, 0x5b, 0x5e, 0xec, 0xbd, 0xf6, 0x70, 0xe8, 0xb9, 0x4e,
-	0xa7, 0xfb, 0xb2, 0x55, 0xb9, 0xe5, 0x95, 0x69, 0xad, 0xf6, 0xe9, 0x8b, 0xae, 0xb4, 0x5d, 0xb0,
-	0x2d, 0x9f, 0x38, 0xb4, 0xc0, 0xdd, 0x32, 0xf1, 0xac, 0x83, 0xbd, 0xd3, 0xb0, 0xe7, 0x07, 0xc1,
-	0xb9, 0xd7, 0x52, 0xb4, 0x7b, 0xb3, 0xb9, 0x71, 0x47, 0x96, 0xfa, 0x8c, 0x4d, 0x48, 0x91, 0xe2,
-	0xb8, 0x97, 0x0b, 0x5d, 0xbd, 0x5a, 0xe8, 0xea, 0xef, 0x85, 0xae, 0x7e, 0x5e, 0xea, 0xca, 0xd5,
-	0x52, 0x57, 0x7e, 0x2c, 0x75, 0xe5, 0x6d, 0x5b, 0xba, 0xa8, 0xab, 0x67, 0xb6, 0xaa, 0x17, 0xeb,
-	0x2f, 0x71, 0x61, 0xfb, 0x75, 0xf1, 0x52, 0x9e, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x45, 0x50,
-	0x25, 0xba, 0x90, 0x03, 0x00, 0x00,
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x13, 0x37, 0x6d, 0x27, 0xdd, 0x2a, 0xcc, 0x76, 0xdb, 0x59, 0x83, 0xbc, 0x56, 0x04,
+	0x28, 0xaa, 0x84, 0xb3, 0x1b, 0x2e, 0x68, 0x01, 0x09, 0x27, 0x36, 0x92, 0xc5, 0x6a, 0x09, 0x63,
+	0x07, 0x04, 0x97, 0xc8, 0x89, 0x47, 0xc1, 0x22, 0xcd, 0x18, 0xcf, 0x78, 0xd9, 0x1c, 0xb9, 0xa1,
+	0x9c, 0xb8, 0x70, 0x8c, 0x38, 0x70, 0x83, 0x3f, 0xb2, 0xc7, 0x1e, 0x39, 0x01, 0x6a, 0xc5, 0x85,
+	0x5f, 0x81, 0x3c, 0xb6, 0xd3, 0x71, 0x2b, 0xe8, 0x05, 0xf6, 0x64, 0xcf, 0xfb, 0xbe, 0xef, 0xbd,
+	0x37, 0x9f, 0xdf, 0x8c, 0xc1, 0xd1, 0x3c, 0xe2, 0x34, 0x8e, 0x82, 0xde, 0x94, 0xa6, 0x4b, 0xbe,
+	0x32, 0xe3, 0x84, 0x72, 0x0a, 0x4f, 0x8a, 0xa8, 0x79, 0xed, 0xa9, 0x1d, 0xcd, 0xe9, 0x9c, 0x0a,
+	0x4e, 0x2f, 0x7b, 0xcb, 0xe9, 0x9a, 0x3e, 0xa3, 0xec, 0x8c, 0xb2, 0xde, 0x34, 0x60, 0xa4, 0xf7,
+	0xec, 0xd1, 0x94, 0xf0, 0xe0, 0x51, 0x6f, 0x46, 0xa3, 0x65, 0x8e, 0x77, 0xfe, 0x52, 0x41, 0x73,
+	0x20, 0xf2, 0xc3, 0x43, 0x50, 0x8f, 0x42, 0xa4, 0x18, 0x4a, 0x57, 0xc5, 0xf5, 0x28, 0x84, 0x33,
+	0xd0, 0x0c, 0xce, 0x32, 0x08, 0xd5, 0x8d, 0x46, 0xb7, 0xd5, 0xbf, 0x6f, 0xe6, 0xb9, 0xcc, 0x2c,
+	0x97, 0x59, 0xe4, 0x32, 0x87, 0x34, 0x5a, 0x0e, 0x1e, 0xbe, 0xf8, 0xed, 0x41, 0xed, 0xe7, 0xdf,
+	0x1f, 0x74, 0xe7, 0x11, 0xff, 0x32, 0x9d, 0x9a, 0x33, 0x7a, 0xd6, 0x2b, 0x0a, 0xe7, 0x8f, 0xb7,
+	0x58, 0xf8, 0x55, 0x8f, 0xaf, 0x62, 0xc2, 0x84, 0x80, 0xe1, 0x22, 0x35, 0x7c, 0x0c, 0x76, 0x18,
+	0x0f, 0x38, 0x41, 0x0d, 0x43, 0xe9, 0x1e, 0xf6, 0x5f, 0x37, 0xff, 0x61, 0x7b, 0x66, 0xde, 0xa4,
+	0x97, 0x71, 0x71, 0x2e, 0x81, 0x1d, 0x70, 0x90, 0x90, 0x98, 0xb2, 0x88, 0xd3, 0x64, 0xe5, 0x86,
+	0x48, 0x15, 0xad, 0x57, 0x62, 0xf0, 0x35, 0xb0, 0x1f, 0x07, 0x09, 0x59, 0x72, 0x37, 0x0a, 0xd1,
+	0x8e, 0x20, 0x5c, 0x05, 0xe0, 0xfb, 0xa0, 0x99, 0x2f, 0x50, 0x53, 0x94, 0x7f, 0xe3, 0x96, 0xf2,
+	0x23, 0x41, 0xc6, 0x85, 0x08, 0x6a, 0x60, 0x8f, 0x3c, 0x8f, 0xa3, 0x84, 0x58, 0x1c, 0xed, 0x1a,
+	0x4a, 0xb7, 0x81, 0xb7, 0x6b, 0xa8, 0x03, 0x90, 0x90, 0x6f, 0x82, 0x24, 0x24, 0xa1, 0x4f, 0xd1,
+	0x9e, 0xa1, 0x74, 0xf7, 0xb1, 0x14, 0xc9, 0x1a, 0x9b, 0x25, 0x24, 0xe0, 0x24, 0xb4, 0x38, 0xda,
+	0x17, 0xe2, 0xab, 0x40, 0x86, 0xa6, 0x71, 0x58, 0xa0, 0x20, 0x47, 0xb7, 0x01, 0x88, 0xc0, 0xae,
+	0xa0, 0xd2, 0x04, 0xb5, 0x44, 0xe2, 0x72, 0x09, 0xdf, 0x03, 0x4d, 0x16, 0x2f, 0x22, 0xce, 0xd0,
+	0x81, 0xf8, 0x66, 0xb7, 0xfa, 0x99, 0x91, 0x71, 0xa1, 0xc9, 0xaa, 0x26, 0x64, 0x41, 0x02, 0x96,
+	0x6d, 0xe8, 0x4e, 0x5e, 0x75, 0x1b, 0x80, 0x1f, 0x80, 0xdd, 0x30, 0x62, 0x71, 0xca, 0x09, 0x3a,
+	0x34, 0x94, 0x6e, 0xab, 0xff, 0xe6, 0x2d, 0xc9, 0xed, 0x9c, 0x8d, 0x4b, 0x59, 0xe7, 0x47, 0x05,
+	0xdc, 0xa9, 0x40, 0xf2, 0x4e, 0x94, 0xea, 0x4e, 0x8e, 0x41, 0x33, 0x21, 0x01, 0xa3, 0x4b, 0x54,
+	0x17, 0x40, 0xb1, 0xaa, 0xfa, 0xd6, 0xb8, 0xee, 0x9b, 0x70, 0x9d, 0xd1, 0xc5, 0x33, 0x12, 0x0e,
+	0x56, 0x48, 0x2d, 0x5d, 0x2f, 0x23, 0x32, 0x6e, 0x71, 0x31, 0x0f, 0x0d, 0x2c, 0x45, 0x3a, 0x3f,
+	0xd4, 0x41, 0x4b, 0x72, 0x26, 0xeb, 0x2f, 0x08, 0xc3, 0x84, 0x30, 0x56, 0xf6, 0x57, 0x2c, 0xb3,
+	0x4c, 0x31, 0x49, 0x66, 0x64, 0xc9, 0x83, 0x39, 0x11, 0x3d, 0xaa, 0x58, 0x8a, 0x48, 0xa7, 0xa7,
+	0xf1, 0xff, 0x9d, 0x9e, 0x39, 0xd8, 0x2b, 0x47, 0x0a, 0xa9, 0xff, 0x7d, 0x99, 0x6d, 0xf2, 0xce,
+	0x9f, 0x0a, 0x38, 0xc8, 0x7d, 0xf9, 0x30, 0x5d, 0x86, 0x24, 0xc9, 0x46, 0x3f, 0xbf, 0x96, 0xdc,
+	0xf2, 0xca, 0xd8, 0xae, 0x65, 0xd3, 0xea, 0x55, 0xd3, 0x5e, 0x8a, 0x29, 0x95, 0x09, 0x51, 0xff,
+	0xf5, 0x64, 0xed, 0x5c, 0x3b, 0x59, 0xa7, 0xbf, 0x5c, 0x7d, 0x7f, 0x71, 0xc5, 0xbc, 0x03, 0xd0,
+	0xe0, 0xe3, 0xf1, 0x53, 0xff, 0xf3, 0x89, 0xe7, 0x5b, 0xbe, 0x33, 0xf1, 0xf0, 0xd0, 0x76, 0x06,
+	0xae, 0xef, 0x3b, 0x76, 0xbb, 0xa6, 0x69, 0xeb, 0x8d, 0x71, 0x2c, 0xd1, 0x25, 0x14, 0x3e, 0x06,
+	0xf7, 0x2b, 0x4a, 0xdb, 0xf1, 0xfc, 0x21, 0x76, 0x6c, 0x37, 0x93, 0x2a, 0xda, 0xab, 0xeb, 0x8d,
+	0x71, 0x22, 0x49, 0x65, 0xf8, 0x86, 0x16, 0x3b, 0x9f, 0x3a, 0xd8, 0x77, 0xec, 0x81, 0x35, 0xfc,
+	0xa8, 0x5d, 0xbf, 0xa1, 0x95, 0x61, 0xf8, 0x10, 0x1c, 0x55, 0xb4, 0xd6, 0x67, 0x16, 0xb6, 0x1d,
+	0xbb, 0xdd, 0xd0, 0x8e, 0xd7, 0x1b, 0x03, 0x4a, 0xb2, 0x02, 0x81, 0x7d, 0x70, 0xaf, 0xda, 0xa9,
+	0xeb, 0x8d, 0xc6, 0x59, 0x97, 0xaa, 0x76, 0xb2, 0xde, 0x18, 0x77, 0xe5, 0x2e, 0x0b, 0x48, 0x53,
+	0xbf, 0xfb, 0x49, 0xaf, 0x9d, 0x7e, 0xbb, 0x9d, 0x8a, 0xfc, 0x62, 0x84, 0x26, 0xb8, 0x5b, 0xa4,
+	0x1a, 0x59, 0xd8, 0x79, 0xea, 0x4f, 0x5c, 0xcf, 0x1b, 0x3b, 0xed, 0x9a, 0x76, 0x6f, 0xbd, 0x31,
+	0x5e, 0x91, 0xa9, 0x2e, 0x63, 0x29, 0x81, 0xef, 0x02, 0xad, 0xca, 0x1f, 0x8d, 0x9f, 0x3c, 0x99,
+	0x60, 0xe7, 0x93, 0xb1, 0xe3, 0xf9, 0x55, 0x97, 0x72, 0xd9, 0x28, 0x5d, 0x2c, 0x30, 0xf9, 0x3a,
+	0x25, 0x8c, 0xe7, 0x3d, 0x0c, 0xec, 0x17, 0x17, 0xba, 0x72, 0x7e, 0xa1, 0x2b, 0x7f, 0x5c, 0xe8,
+	0xca, 0xf7, 0x97, 0x7a, 0xed, 0xfc, 0x52, 0xaf, 0xfd, 0x7a, 0xa9, 0xd7, 0xbe, 0x38, 0x95, 0x26,
+	0xa7, 0xfc, 0x95, 0x96, 0xcf, 0xe7, 0xdb, 0x37, 0x31, 0x41, 0xd3, 0xa6, 0xf8, 0x1b, 0xbe, 0xfd,
+	0xf7, 0x00, 0xa1, 0x2d, 0x33, 0x28, 0x74, 0x07, 0x00, 0x00,
 }
 
 func (m *Bounty) Marshal() (dAtA []byte, err error) {
@@ -265,6 +546,37 @@ func (m *Bounty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Dispute != nil {
+		{
+			size, err := m.Dispute.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBounty(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ReleaseAt != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ReleaseAt))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -336,71 +648,916 @@ func (m *Bounty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBounty(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBounty(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BountyDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Bounty) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BountyDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BountyDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedAt != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ResolvedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ResolvedBy) > 0 {
+		i -= len(m.ResolvedBy)
+		copy(dAtA[i:], m.ResolvedBy)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ResolvedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BountySplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BountySplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BountySplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovBounty(uint64(m.Id))
+	if len(m.Rewarded) > 0 {
+		for iNdEx := len(m.Rewarded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovBounty(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Percentage != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Percentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BountyFunder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BountyFunder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BountyFunder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BountyId != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.BountyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBounty(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBounty(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Bounty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBounty(uint64(m.Id))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.State != 0 {
+		n += 1 + sovBounty(uint64(m.State))
+	}
+	if m.RepositoryId != 0 {
+		n += 1 + sovBounty(uint64(m.RepositoryId))
+	}
+	if m.ParentIid != 0 {
+		n += 1 + sovBounty(uint64(m.ParentIid))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovBounty(uint64(m.Parent))
+	}
+	if m.ExpireAt != 0 {
+		n += 1 + sovBounty(uint64(m.ExpireAt))
+	}
+	l = len(m.RewardedTo)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovBounty(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovBounty(uint64(m.UpdatedAt))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.ReleaseAt != 0 {
+		n += 1 + sovBounty(uint64(m.ReleaseAt))
+	}
+	if m.Dispute != nil {
+		l = m.Dispute.Size()
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	return n
+}
+
+func (m *BountyDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovBounty(uint64(m.CreatedAt))
+	}
+	l = len(m.ResolvedBy)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.ResolvedAt != 0 {
+		n += 1 + sovBounty(uint64(m.ResolvedAt))
+	}
+	return n
+}
+
+func (m *BountySplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.Percentage != 0 {
+		n += 1 + sovBounty(uint64(m.Percentage))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.Rewarded) > 0 {
+		for _, e := range m.Rewarded {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BountyFunder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BountyId != 0 {
+		n += 1 + sovBounty(uint64(m.BountyId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovBounty(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovBounty(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func sovBounty(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBounty(x uint64) (n int) {
+	return sovBounty(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Bounty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bounty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bounty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= BountyState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentIid", wireType)
+			}
+			m.ParentIid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentIid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= BountyParent(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
+			}
+			m.ExpireAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardedTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardedTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, &BountySplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseAt", wireType)
+			}
+			m.ReleaseAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dispute == nil {
+				m.Dispute = &BountyDispute{}
+			}
+			if err := m.Dispute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BountyDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BountyDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BountyDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolvedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
+			}
+			m.ResolvedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.State != 0 {
-		n += 1 + sovBounty(uint64(m.State))
-	}
-	if m.RepositoryId != 0 {
-		n += 1 + sovBounty(uint64(m.RepositoryId))
-	}
-	if m.ParentIid != 0 {
-		n += 1 + sovBounty(uint64(m.ParentIid))
-	}
-	if m.Parent != 0 {
-		n += 1 + sovBounty(uint64(m.Parent))
-	}
-	if m.ExpireAt != 0 {
-		n += 1 + sovBounty(uint64(m.ExpireAt))
-	}
-	l = len(m.RewardedTo)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovBounty(uint64(m.CreatedAt))
-	}
-	if m.UpdatedAt != 0 {
-		n += 1 + sovBounty(uint64(m.UpdatedAt))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	return n
-}
 
-func sovBounty(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBounty(x uint64) (n int) {
-	return sovBounty(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Bounty) Unmarshal(dAtA []byte) error {
+func (m *BountySplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -423,36 +1580,17 @@ func (m *Bounty) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bounty: wiretype end group for non-group")
+			return fmt.Errorf("proto: BountySplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bounty: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BountySplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBounty
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -462,31 +1600,29 @@ func (m *Bounty) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 			}
-			m.State = 0
+			m.Percentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -496,16 +1632,16 @@ func (m *Bounty) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= BountyState(b&0x7F) << shift
+				m.Percentage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.RepositoryId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -515,35 +1651,31 @@ func (m *Bounty) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RepositoryId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentIid", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
 			}
-			m.ParentIid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBounty
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ParentIid |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Parent = 0
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewarded", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -553,16 +1685,81 @@ func (m *Bounty) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Parent |= BountyParent(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewarded = append(m.Rewarded, types.Coin{})
+			if err := m.Rewarded[len(m.Rewarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BountyFunder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BountyFunder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BountyFunder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BountyId", wireType)
 			}
-			m.ExpireAt = 0
+			m.BountyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -572,14 +1769,14 @@ func (m *Bounty) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpireAt |= int64(b&0x7F) << shift
+				m.BountyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardedTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -607,13 +1804,13 @@ func (m *Bounty) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardedTo = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.CreatedAt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -623,16 +1820,31 @@ func (m *Bounty) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			m.UpdatedAt = 0
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -642,16 +1854,16 @@ func (m *Bounty) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var stringLen uint64
+			m.UpdatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -661,24 +1873,11 @@ func (m *Bounty) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpdatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBounty
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBounty
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBounty(dAtA[iNdEx:])
@@ -37,6 +37,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateBountyExpiry{}, "gitopia/UpdateBountyExpiry", nil)
 	cdc.RegisterConcrete(&MsgCloseBounty{}, "gitopia/CloseBounty", nil)
 	cdc.RegisterConcrete(&MsgDeleteBounty{}, "gitopia/DeleteBounty", nil)
+	cdc.RegisterConcrete(&MsgSetBountySplits{}, "gitopia/SetBountySplits", nil)
+	cdc.RegisterConcrete(&MsgFundBounty{}, "gitopia/FundBounty", nil)
+	cdc.RegisterConcrete(&MsgDisputeBounty{}, "gitopia/DisputeBounty", nil)
+	cdc.RegisterConcrete(&MsgResolveBountyDispute{}, "gitopia/ResolveBountyDispute", nil)
+	cdc.RegisterConcrete(&MsgAwardBounty{}, "gitopia/AwardBounty", nil)
+	cdc.RegisterConcrete(&MsgUpdateBountyArbiter{}, "gitopia/UpdateBountyArbiter", nil)
 	cdc.RegisterConcrete(&MsgToggleForcePush{}, "gitopia/ToggleForcePush", nil)
 	cdc.RegisterConcrete(&MsgExercise{}, "gitopia/Exercise", nil)
 	// this line is used by starport scaffolding # 2
@@ -57,7 +63,17 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRemovePullRequestAssignees{}, "gitopia/RemovePullRequestAssignees", nil)
 	cdc.RegisterConcrete(&MsgAddPullRequestLabels{}, "gitopia/AddPullRequestLabels", nil)
 	cdc.RegisterConcrete(&MsgRemovePullRequestLabels{}, "gitopia/RemovePullRequestLabels", nil)
+	cdc.RegisterConcrete(&MsgSetPullRequestMilestone{}, "gitopia/SetPullRequestMilestone", nil)
 	cdc.RegisterConcrete(&MsgDeletePullRequest{}, "gitopia/DeletePullRequest", nil)
+	cdc.RegisterConcrete(&MsgSubmitPullRequestReview{}, "gitopia/SubmitPullRequestReview", nil)
+	cdc.RegisterConcrete(&MsgMarkPullRequestReadyForReview{}, "gitopia/MarkPullRequestReadyForReview", nil)
+	cdc.RegisterConcrete(&MsgConvertPullRequestToDraft{}, "gitopia/ConvertPullRequestToDraft", nil)
+	cdc.RegisterConcrete(&MsgEnablePullRequestAutoMerge{}, "gitopia/EnablePullRequestAutoMerge", nil)
+	cdc.RegisterConcrete(&MsgDisablePullRequestAutoMerge{}, "gitopia/DisablePullRequestAutoMerge", nil)
+	cdc.RegisterConcrete(&MsgAddPullRequestToMergeQueue{}, "gitopia/AddPullRequestToMergeQueue", nil)
+	cdc.RegisterConcrete(&MsgRemovePullRequestFromMergeQueue{}, "gitopia/RemovePullRequestFromMergeQueue", nil)
+	cdc.RegisterConcrete(&MsgSetPullRequestChangedPaths{}, "gitopia/SetPullRequestChangedPaths", nil)
+	cdc.RegisterConcrete(&MsgSetPullRequestCommitMessages{}, "gitopia/SetPullRequestCommitMessages", nil)
 
 	cdc.RegisterConcrete(&MsgCreateDao{}, "gitopia/CreateDao", nil)
 	cdc.RegisterConcrete(&MsgRenameDao{}, "gitopia/RenameDao", nil)
@@ -70,6 +86,13 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateComment{}, "gitopia/CreateComment", nil)
 	cdc.RegisterConcrete(&MsgUpdateComment{}, "gitopia/UpdateComment", nil)
 	cdc.RegisterConcrete(&MsgDeleteComment{}, "gitopia/DeleteComment", nil)
+	cdc.RegisterConcrete(&MsgToggleReaction{}, "gitopia/ToggleReaction", nil)
+	cdc.RegisterConcrete(&MsgResolveCommentThread{}, "gitopia/ResolveCommentThread", nil)
+	cdc.RegisterConcrete(&MsgUnresolveCommentThread{}, "gitopia/UnresolveCommentThread", nil)
+	cdc.RegisterConcrete(&MsgHideComment{}, "gitopia/HideComment", nil)
+	cdc.RegisterConcrete(&MsgUnhideComment{}, "gitopia/UnhideComment", nil)
+	cdc.RegisterConcrete(&MsgLockConversation{}, "gitopia/LockConversation", nil)
+	cdc.RegisterConcrete(&MsgUnlockConversation{}, "gitopia/UnlockConversation", nil)
 
 	cdc.RegisterConcrete(&MsgCreateIssue{}, "gitopia/CreateIssue", nil)
 	cdc.RegisterConcrete(&MsgUpdateIssueTitle{}, "gitopia/UpdateIssueTitle", nil)
@@ -79,7 +102,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRemoveIssueAssignees{}, "gitopia/RemoveIssueAssignees", nil)
 	cdc.RegisterConcrete(&MsgAddIssueLabels{}, "gitopia/AddIssueLabels", nil)
 	cdc.RegisterConcrete(&MsgRemoveIssueLabels{}, "gitopia/RemoveIssueLabels", nil)
+	cdc.RegisterConcrete(&MsgSetIssueMilestone{}, "gitopia/SetIssueMilestone", nil)
 	cdc.RegisterConcrete(&MsgDeleteIssue{}, "gitopia/DeleteIssue", nil)
+	cdc.RegisterConcrete(&MsgTransferIssue{}, "gitopia/TransferIssue", nil)
 
 	cdc.RegisterConcrete(&MsgCreateRepository{}, "gitopia/CreateRepository", nil)
 	cdc.RegisterConcrete(&MsgInvokeForkRepository{}, "gitopia/InvokeForkRepository", nil)
@@ -93,8 +118,27 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateRepositoryLabel{}, "gitopia/CreateRepositoryLabel", nil)
 	cdc.RegisterConcrete(&MsgUpdateRepositoryLabel{}, "gitopia/UpdateRepositoryLabel", nil)
 	cdc.RegisterConcrete(&MsgDeleteRepositoryLabel{}, "gitopia/DeleteRepositoryLabel", nil)
+	cdc.RegisterConcrete(&MsgCreateRepositoryMilestone{}, "gitopia/CreateRepositoryMilestone", nil)
+	cdc.RegisterConcrete(&MsgUpdateRepositoryMilestone{}, "gitopia/UpdateRepositoryMilestone", nil)
+	cdc.RegisterConcrete(&MsgToggleRepositoryMilestoneState{}, "gitopia/ToggleRepositoryMilestoneState", nil)
+	cdc.RegisterConcrete(&MsgDeleteRepositoryMilestone{}, "gitopia/DeleteRepositoryMilestone", nil)
+	cdc.RegisterConcrete(&MsgCreateRepositoryIssueTemplate{}, "gitopia/CreateRepositoryIssueTemplate", nil)
+	cdc.RegisterConcrete(&MsgUpdateRepositoryIssueTemplate{}, "gitopia/UpdateRepositoryIssueTemplate", nil)
+	cdc.RegisterConcrete(&MsgDeleteRepositoryIssueTemplate{}, "gitopia/DeleteRepositoryIssueTemplate", nil)
+	cdc.RegisterConcrete(&MsgCreateBranchProtectionRule{}, "gitopia/CreateBranchProtectionRule", nil)
+	cdc.RegisterConcrete(&MsgUpdateBranchProtectionRule{}, "gitopia/UpdateBranchProtectionRule", nil)
+	cdc.RegisterConcrete(&MsgDeleteBranchProtectionRule{}, "gitopia/DeleteBranchProtectionRule", nil)
+	cdc.RegisterConcrete(&MsgSetCommitStatus{}, "gitopia/SetCommitStatus", nil)
 	cdc.RegisterConcrete(&MsgToggleRepositoryForking{}, "gitopia/ToggleRepositoryForking", nil)
 	cdc.RegisterConcrete(&MsgToggleArweaveBackup{}, "gitopia/ToggleArweaveBackup", nil)
+	cdc.RegisterConcrete(&MsgUpdateRepositoryAllowedMergeMethods{}, "gitopia/UpdateRepositoryAllowedMergeMethods", nil)
+	cdc.RegisterConcrete(&MsgUpdateRepositoryCodeOwners{}, "gitopia/UpdateRepositoryCodeOwners", nil)
+	cdc.RegisterConcrete(&MsgArchiveRepository{}, "gitopia/ArchiveRepository", nil)
+	cdc.RegisterConcrete(&MsgUnarchiveRepository{}, "gitopia/UnarchiveRepository", nil)
+	cdc.RegisterConcrete(&MsgStarRepository{}, "gitopia/StarRepository", nil)
+	cdc.RegisterConcrete(&MsgUnstarRepository{}, "gitopia/UnstarRepository", nil)
+	cdc.RegisterConcrete(&MsgWatchRepository{}, "gitopia/WatchRepository", nil)
+	cdc.RegisterConcrete(&MsgUnwatchRepository{}, "gitopia/UnwatchRepository", nil)
 	cdc.RegisterConcrete(&MsgDeleteRepository{}, "gitopia/DeleteRepository", nil)
 
 	cdc.RegisterConcrete(&MsgCreateUser{}, "gitopia/CreateUser", nil)
@@ -103,6 +147,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateUserBio{}, "gitopia/UpdateUserBio", nil)
 	cdc.RegisterConcrete(&MsgUpdateUserAvatar{}, "gitopia/UpdateUserAvatar", nil)
 	cdc.RegisterConcrete(&MsgDeleteUser{}, "gitopia/DeleteUser", nil)
+	cdc.RegisterConcrete(&MsgFollow{}, "gitopia/Follow", nil)
+	cdc.RegisterConcrete(&MsgUnfollow{}, "gitopia/Unfollow", nil)
+	cdc.RegisterConcrete(&MsgMarkNotificationsRead{}, "gitopia/MarkNotificationsRead", nil)
 	// cdc.RegisterConcrete(&MsgTransferUser{}, "gitopia/TransferUser", nil)
 
 }
@@ -144,6 +191,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateBountyExpiry{},
 		&MsgCloseBounty{},
 		&MsgDeleteBounty{},
+		&MsgSetBountySplits{},
+		&MsgFundBounty{},
+		&MsgDisputeBounty{},
+		&MsgResolveBountyDispute{},
+		&MsgAwardBounty{},
+		&MsgUpdateBountyArbiter{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgToggleForcePush{},
@@ -173,7 +226,17 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUnlinkPullRequestIssueByIid{},
 		&MsgAddPullRequestLabels{},
 		&MsgRemovePullRequestLabels{},
+		&MsgSetPullRequestMilestone{},
 		&MsgDeletePullRequest{},
+		&MsgSubmitPullRequestReview{},
+		&MsgMarkPullRequestReadyForReview{},
+		&MsgConvertPullRequestToDraft{},
+		&MsgEnablePullRequestAutoMerge{},
+		&MsgDisablePullRequestAutoMerge{},
+		&MsgAddPullRequestToMergeQueue{},
+		&MsgRemovePullRequestFromMergeQueue{},
+		&MsgSetPullRequestChangedPaths{},
+		&MsgSetPullRequestCommitMessages{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateDao{},
@@ -188,6 +251,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateComment{},
 		&MsgUpdateComment{},
 		&MsgDeleteComment{},
+		&MsgToggleReaction{},
+		&MsgResolveCommentThread{},
+		&MsgUnresolveCommentThread{},
+		&MsgHideComment{},
+		&MsgUnhideComment{},
+		&MsgLockConversation{},
+		&MsgUnlockConversation{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateIssue{},
@@ -198,7 +268,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRemoveIssueAssignees{},
 		&MsgAddIssueLabels{},
 		&MsgRemoveIssueLabels{},
+		&MsgSetIssueMilestone{},
 		&MsgDeleteIssue{},
+		&MsgTransferIssue{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateRepository{},
@@ -213,8 +285,27 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateRepositoryLabel{},
 		&MsgUpdateRepositoryLabel{},
 		&MsgDeleteRepositoryLabel{},
+		&MsgCreateRepositoryMilestone{},
+		&MsgUpdateRepositoryMilestone{},
+		&MsgToggleRepositoryMilestoneState{},
+		&MsgDeleteRepositoryMilestone{},
+		&MsgCreateRepositoryIssueTemplate{},
+		&MsgUpdateRepositoryIssueTemplate{},
+		&MsgDeleteRepositoryIssueTemplate{},
+		&MsgCreateBranchProtectionRule{},
+		&MsgUpdateBranchProtectionRule{},
+		&MsgDeleteBranchProtectionRule{},
+		&MsgSetCommitStatus{},
 		&MsgToggleRepositoryForking{},
 		&MsgToggleArweaveBackup{},
+		&MsgUpdateRepositoryAllowedMergeMethods{},
+		&MsgUpdateRepositoryCodeOwners{},
+		&MsgArchiveRepository{},
+		&MsgUnarchiveRepository{},
+		&MsgStarRepository{},
+		&MsgUnstarRepository{},
+		&MsgWatchRepository{},
+		&MsgUnwatchRepository{},
 		&MsgDeleteRepository{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -224,6 +315,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateUserBio{},
 		&MsgUpdateUserAvatar{},
 		&MsgDeleteUser{},
+		&MsgFollow{},
+		&MsgUnfollow{},
+		&MsgMarkNotificationsRead{},
 		// &MsgTransferUser{},
 	)
 
@@ -26,25 +26,33 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type CommentType int32
 
 const (
-	CommentTypeNone                CommentType = 0
-	CommentTypeReply               CommentType = 1
-	CommentTypeAddLabels           CommentType = 2
-	CommentTypeRemoveLabels        CommentType = 3
-	CommentTypeAddAssignees        CommentType = 4
-	CommentTypeRemoveAssignees     CommentType = 5
-	CommentTypeAddReviewers        CommentType = 6
-	CommentTypeRemoveReviewers     CommentType = 7
-	CommentTypeModifiedTitle       CommentType = 8
-	CommentTypeModifiedDescription CommentType = 9
-	CommentTypeIssueClosed         CommentType = 10
-	CommentTypeIssueOpened         CommentType = 11
-	CommentTypePullRequestClosed   CommentType = 12
-	CommentTypePullRequestOpened   CommentType = 13
-	CommentTypePullRequestMerged   CommentType = 14
-	CommentTypeReview              CommentType = 15
-	CommentTypeAddBounty           CommentType = 16
-	CommentTypeModifiedBounty      CommentType = 17
-	CommentTypeClosedBounty        CommentType = 18
+	CommentTypeNone                        CommentType = 0
+	CommentTypeReply                       CommentType = 1
+	CommentTypeAddLabels                   CommentType = 2
+	CommentTypeRemoveLabels                CommentType = 3
+	CommentTypeAddAssignees                CommentType = 4
+	CommentTypeRemoveAssignees             CommentType = 5
+	CommentTypeAddReviewers                CommentType = 6
+	CommentTypeRemoveReviewers             CommentType = 7
+	CommentTypeModifiedTitle               CommentType = 8
+	CommentTypeModifiedDescription         CommentType = 9
+	CommentTypeIssueClosed                 CommentType = 10
+	CommentTypeIssueOpened                 CommentType = 11
+	CommentTypePullRequestClosed           CommentType = 12
+	CommentTypePullRequestOpened           CommentType = 13
+	CommentTypePullRequestMerged           CommentType = 14
+	CommentTypeReview                      CommentType = 15
+	CommentTypeAddBounty                   CommentType = 16
+	CommentTypeModifiedBounty              CommentType = 17
+	CommentTypeClosedBounty                CommentType = 18
+	CommentTypePullRequestReadyForReview   CommentType = 19
+	CommentTypePullRequestConvertedToDraft CommentType = 20
+	CommentTypeConversationLocked          CommentType = 21
+	CommentTypeConversationUnlocked        CommentType = 22
+	CommentTypeMilestoneAdded              CommentType = 23
+	CommentTypeMilestoneRemoved            CommentType = 24
+	CommentTypeCrossReference              CommentType = 25
+	CommentTypeIssueTransferred            CommentType = 26
 )
 
 var CommentType_name = map[int32]string{
@@ -67,28 +75,44 @@ var CommentType_name = map[int32]string{
 	16: "COMMENT_TYPE_ADD_BOUNTY",
 	17: "COMMENT_TYPE_MODIFIED_BOUNTY",
 	18: "COMMENT_TYPE_CLOSED_BOUNTY",
+	19: "COMMENT_TYPE_PULL_REQUEST_READY_FOR_REVIEW",
+	20: "COMMENT_TYPE_PULL_REQUEST_CONVERTED_TO_DRAFT",
+	21: "COMMENT_TYPE_CONVERSATION_LOCKED",
+	22: "COMMENT_TYPE_CONVERSATION_UNLOCKED",
+	23: "COMMENT_TYPE_MILESTONE_ADDED",
+	24: "COMMENT_TYPE_MILESTONE_REMOVED",
+	25: "COMMENT_TYPE_CROSS_REFERENCE",
+	26: "COMMENT_TYPE_ISSUE_TRANSFERRED",
 }
 
 var CommentType_value = map[string]int32{
-	"COMMENT_TYPE_NONE":                 0,
-	"COMMENT_TYPE_REPLY":                1,
-	"COMMENT_TYPE_ADD_LABELS":           2,
-	"COMMENT_TYPE_REMOVE_LABELS":        3,
-	"COMMENT_TYPE_ADD_ASSIGNEES":        4,
-	"COMMENT_TYPE_REMOVE_ASSIGNEES":     5,
-	"COMMENT_TYPE_ADD_REVIEWERS":        6,
-	"COMMENT_TYPE_REMOVE_REVIEWERS":     7,
-	"COMMENT_TYPE_MODIFIED_TITLE":       8,
-	"COMMENT_TYPE_MODIFIED_DESCRIPTION": 9,
-	"COMMENT_TYPE_ISSUE_CLOSED":         10,
-	"COMMENT_TYPE_ISSUE_OPENED":         11,
-	"COMMENT_TYPE_PULL_REQUEST_CLOSED":  12,
-	"COMMENT_TYPE_PULL_REQUEST_OPENED":  13,
-	"COMMENT_TYPE_PULL_REQUEST_MERGED":  14,
-	"COMMENT_TYPE_REVIEW":               15,
-	"COMMENT_TYPE_ADD_BOUNTY":           16,
-	"COMMENT_TYPE_MODIFIED_BOUNTY":      17,
-	"COMMENT_TYPE_CLOSED_BOUNTY":        18,
+	"COMMENT_TYPE_NONE":                            0,
+	"COMMENT_TYPE_REPLY":                           1,
+	"COMMENT_TYPE_ADD_LABELS":                      2,
+	"COMMENT_TYPE_REMOVE_LABELS":                   3,
+	"COMMENT_TYPE_ADD_ASSIGNEES":                   4,
+	"COMMENT_TYPE_REMOVE_ASSIGNEES":                5,
+	"COMMENT_TYPE_ADD_REVIEWERS":                   6,
+	"COMMENT_TYPE_REMOVE_REVIEWERS":                7,
+	"COMMENT_TYPE_MODIFIED_TITLE":                  8,
+	"COMMENT_TYPE_MODIFIED_DESCRIPTION":            9,
+	"COMMENT_TYPE_ISSUE_CLOSED":                    10,
+	"COMMENT_TYPE_ISSUE_OPENED":                    11,
+	"COMMENT_TYPE_PULL_REQUEST_CLOSED":             12,
+	"COMMENT_TYPE_PULL_REQUEST_OPENED":             13,
+	"COMMENT_TYPE_PULL_REQUEST_MERGED":             14,
+	"COMMENT_TYPE_REVIEW":                          15,
+	"COMMENT_TYPE_ADD_BOUNTY":                      16,
+	"COMMENT_TYPE_MODIFIED_BOUNTY":                 17,
+	"COMMENT_TYPE_CLOSED_BOUNTY":                   18,
+	"COMMENT_TYPE_PULL_REQUEST_READY_FOR_REVIEW":   19,
+	"COMMENT_TYPE_PULL_REQUEST_CONVERTED_TO_DRAFT": 20,
+	"COMMENT_TYPE_CONVERSATION_LOCKED":             21,
+	"COMMENT_TYPE_CONVERSATION_UNLOCKED":           22,
+	"COMMENT_TYPE_MILESTONE_ADDED":                 23,
+	"COMMENT_TYPE_MILESTONE_REMOVED":               24,
+	"COMMENT_TYPE_CROSS_REFERENCE":                 25,
+	"COMMENT_TYPE_ISSUE_TRANSFERRED":               26,
 }
 
 func (x CommentType) String() string {
@@ -99,6 +123,46 @@ func (CommentType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_61a8a10ae7d09fb4, []int{0}
 }
 
+type CommentHiddenReason int32
+
+const (
+	CommentHiddenReasonNone      CommentHiddenReason = 0
+	CommentHiddenReasonSpam      CommentHiddenReason = 1
+	CommentHiddenReasonOffTopic  CommentHiddenReason = 2
+	CommentHiddenReasonOutdated  CommentHiddenReason = 3
+	CommentHiddenReasonAbuse     CommentHiddenReason = 4
+	CommentHiddenReasonDuplicate CommentHiddenReason = 5
+	CommentHiddenReasonResolved  CommentHiddenReason = 6
+)
+
+var CommentHiddenReason_name = map[int32]string{
+	0: "COMMENT_HIDDEN_REASON_NONE",
+	1: "COMMENT_HIDDEN_REASON_SPAM",
+	2: "COMMENT_HIDDEN_REASON_OFF_TOPIC",
+	3: "COMMENT_HIDDEN_REASON_OUTDATED",
+	4: "COMMENT_HIDDEN_REASON_ABUSE",
+	5: "COMMENT_HIDDEN_REASON_DUPLICATE",
+	6: "COMMENT_HIDDEN_REASON_RESOLVED",
+}
+
+var CommentHiddenReason_value = map[string]int32{
+	"COMMENT_HIDDEN_REASON_NONE":      0,
+	"COMMENT_HIDDEN_REASON_SPAM":      1,
+	"COMMENT_HIDDEN_REASON_OFF_TOPIC": 2,
+	"COMMENT_HIDDEN_REASON_OUTDATED":  3,
+	"COMMENT_HIDDEN_REASON_ABUSE":     4,
+	"COMMENT_HIDDEN_REASON_DUPLICATE": 5,
+	"COMMENT_HIDDEN_REASON_RESOLVED":  6,
+}
+
+func (x CommentHiddenReason) String() string {
+	return proto.EnumName(CommentHiddenReason_name, int32(x))
+}
+
+func (CommentHiddenReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_61a8a10ae7d09fb4, []int{1}
+}
+
 type CommentParent int32
 
 const (
@@ -124,30 +188,35 @@ func (x CommentParent) String() string {
 }
 
 func (CommentParent) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_61a8a10ae7d09fb4, []int{1}
+	return fileDescriptor_61a8a10ae7d09fb4, []int{2}
 }
 
 type Comment struct {
-	Creator           string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id                uint64        `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	RepositoryId      uint64        `protobuf:"varint,3,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
-	ParentIid         uint64        `protobuf:"varint,4,opt,name=parentIid,proto3" json:"parentIid,omitempty"`
-	Parent            CommentParent `protobuf:"varint,5,opt,name=parent,proto3,enum=gitopia.gitopia.gitopia.CommentParent" json:"parent,omitempty"`
-	CommentIid        uint64        `protobuf:"varint,6,opt,name=commentIid,proto3" json:"commentIid,omitempty"`
-	Body              string        `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
-	Attachments       []*Attachment `protobuf:"bytes,8,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	DiffHunk          string        `protobuf:"bytes,9,opt,name=diffHunk,proto3" json:"diffHunk,omitempty"`
-	Path              string        `protobuf:"bytes,10,opt,name=path,proto3" json:"path,omitempty"`
-	Position          uint64        `protobuf:"varint,11,opt,name=position,proto3" json:"position,omitempty"`
-	System            bool          `protobuf:"varint,12,opt,name=system,proto3" json:"system,omitempty"`
-	AuthorAssociation string        `protobuf:"bytes,13,opt,name=authorAssociation,proto3" json:"authorAssociation,omitempty"`
-	CreatedAt         int64         `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt         int64         `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	CommentType       CommentType   `protobuf:"varint,16,opt,name=commentType,proto3,enum=gitopia.gitopia.gitopia.CommentType" json:"commentType,omitempty"`
-	Resolved          bool          `protobuf:"varint,17,opt,name=resolved,proto3" json:"resolved,omitempty"`
-	Replies           []uint64      `protobuf:"varint,18,rep,packed,name=replies,proto3" json:"replies,omitempty"`
-	Reactions         []*Reaction   `protobuf:"bytes,19,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	Hidden            bool          `protobuf:"varint,20,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	Creator           string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id                uint64              `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	RepositoryId      uint64              `protobuf:"varint,3,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	ParentIid         uint64              `protobuf:"varint,4,opt,name=parentIid,proto3" json:"parentIid,omitempty"`
+	Parent            CommentParent       `protobuf:"varint,5,opt,name=parent,proto3,enum=gitopia.gitopia.gitopia.CommentParent" json:"parent,omitempty"`
+	CommentIid        uint64              `protobuf:"varint,6,opt,name=commentIid,proto3" json:"commentIid,omitempty"`
+	Body              string              `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	Attachments       []*Attachment       `protobuf:"bytes,8,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	DiffHunk          string              `protobuf:"bytes,9,opt,name=diffHunk,proto3" json:"diffHunk,omitempty"`
+	Path              string              `protobuf:"bytes,10,opt,name=path,proto3" json:"path,omitempty"`
+	Position          uint64              `protobuf:"varint,11,opt,name=position,proto3" json:"position,omitempty"`
+	System            bool                `protobuf:"varint,12,opt,name=system,proto3" json:"system,omitempty"`
+	AuthorAssociation string              `protobuf:"bytes,13,opt,name=authorAssociation,proto3" json:"authorAssociation,omitempty"`
+	CreatedAt         int64               `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt         int64               `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	CommentType       CommentType         `protobuf:"varint,16,opt,name=commentType,proto3,enum=gitopia.gitopia.gitopia.CommentType" json:"commentType,omitempty"`
+	Resolved          bool                `protobuf:"varint,17,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	Replies           []uint64            `protobuf:"varint,18,rep,packed,name=replies,proto3" json:"replies,omitempty"`
+	Reactions         []*Reaction         `protobuf:"bytes,19,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Hidden            bool                `protobuf:"varint,20,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	ReactionCounts    []*ReactionCount    `protobuf:"bytes,21,rep,name=reactionCounts,proto3" json:"reactionCounts,omitempty"`
+	InReplyTo         uint64              `protobuf:"varint,22,opt,name=inReplyTo,proto3" json:"inReplyTo,omitempty"`
+	ResolvedBy        string              `protobuf:"bytes,23,opt,name=resolvedBy,proto3" json:"resolvedBy,omitempty"`
+	HiddenReason      CommentHiddenReason `protobuf:"varint,24,opt,name=hiddenReason,proto3,enum=gitopia.gitopia.gitopia.CommentHiddenReason" json:"hiddenReason,omitempty"`
+	HiddenBy          string              `protobuf:"bytes,25,opt,name=hiddenBy,proto3" json:"hiddenBy,omitempty"`
 }
 
 func (m *Comment) Reset()         { *m = Comment{} }
@@ -323,8 +392,44 @@ func (m *Comment) GetHidden() bool {
 	return false
 }
 
+func (m *Comment) GetReactionCounts() []*ReactionCount {
+	if m != nil {
+		return m.ReactionCounts
+	}
+	return nil
+}
+
+func (m *Comment) GetInReplyTo() uint64 {
+	if m != nil {
+		return m.InReplyTo
+	}
+	return 0
+}
+
+func (m *Comment) GetResolvedBy() string {
+	if m != nil {
+		return m.ResolvedBy
+	}
+	return ""
+}
+
+func (m *Comment) GetHiddenReason() CommentHiddenReason {
+	if m != nil {
+		return m.HiddenReason
+	}
+	return CommentHiddenReasonNone
+}
+
+func (m *Comment) GetHiddenBy() string {
+	if m != nil {
+		return m.HiddenBy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("gitopia.gitopia.gitopia.CommentType", CommentType_name, CommentType_value)
+	proto.RegisterEnum("gitopia.gitopia.gitopia.CommentHiddenReason", CommentHiddenReason_name, CommentHiddenReason_value)
 	proto.RegisterEnum("gitopia.gitopia.gitopia.CommentParent", CommentParent_name, CommentParent_value)
 	proto.RegisterType((*Comment)(nil), "gitopia.gitopia.gitopia.Comment")
 }
@@ -332,70 +437,98 @@ func init() {
 func init() { proto.RegisterFile("gitopia/comment.proto", fileDescriptor_61a8a10ae7d09fb4) }
 
 var fileDescriptor_61a8a10ae7d09fb4 = []byte{
-	// 996 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdb, 0x6e, 0xe3, 0x44,
-	0x18, 0xae, 0xdb, 0xf4, 0x90, 0x49, 0x0f, 0xee, 0xb4, 0xdb, 0xce, 0x7a, 0xbb, 0xd1, 0x6c, 0x41,
-	0x28, 0xaa, 0x56, 0x29, 0x5a, 0xc4, 0x05, 0x42, 0xb0, 0x72, 0x9b, 0xe9, 0x62, 0x29, 0x27, 0xec,
-	0x74, 0x51, 0xb9, 0x89, 0xdc, 0xcc, 0x34, 0xb5, 0x48, 0x3d, 0xc6, 0x76, 0x0a, 0x79, 0x03, 0xe4,
-	0x2b, 0x5e, 0xc0, 0xdc, 0xf0, 0x0e, 0x3c, 0x03, 0x97, 0x7b, 0x83, 0xc4, 0x25, 0x6a, 0x5f, 0x04,
-	0x79, 0x6c, 0x27, 0x76, 0xeb, 0x14, 0xae, 0xec, 0xff, 0x9f, 0xff, 0xfb, 0xbe, 0xff, 0x34, 0x89,
-	0xc1, 0xb3, 0xa1, 0xe5, 0x73, 0xc7, 0x32, 0x8f, 0x07, 0xfc, 0xe6, 0x86, 0xd9, 0x7e, 0xdd, 0x71,
-	0xb9, 0xcf, 0xe1, 0x7e, 0xe2, 0xae, 0x3f, 0x78, 0x2a, 0xbb, 0x43, 0x3e, 0xe4, 0x22, 0xe6, 0x38,
-	0x7a, 0x8b, 0xc3, 0x95, 0xbd, 0x94, 0xc5, 0x65, 0xe6, 0xc0, 0xb7, 0xb8, 0x9d, 0xf8, 0x51, 0xea,
-	0x37, 0x7d, 0xdf, 0x1c, 0x5c, 0xcf, 0x04, 0x0e, 0xff, 0x5a, 0x06, 0xab, 0xa7, 0xb1, 0x24, 0x44,
-	0x60, 0x75, 0xe0, 0x32, 0xd3, 0xe7, 0x2e, 0x92, 0xb0, 0x54, 0x2b, 0xeb, 0xa9, 0x09, 0x37, 0xc1,
-	0xa2, 0x45, 0xd1, 0x22, 0x96, 0x6a, 0x25, 0x7d, 0xd1, 0xa2, 0xf0, 0x10, 0xac, 0xbb, 0xcc, 0xe1,
-	0x9e, 0xe5, 0x73, 0x77, 0xa2, 0x51, 0xb4, 0x24, 0x4e, 0x72, 0x3e, 0x78, 0x00, 0xca, 0x8e, 0xe9,
-	0x32, 0xdb, 0xd7, 0x2c, 0x8a, 0x4a, 0x22, 0x60, 0xe6, 0x80, 0x5f, 0x83, 0x95, 0xd8, 0x40, 0xcb,
-	0x58, 0xaa, 0x6d, 0xbe, 0xf9, 0xa4, 0x3e, 0xa7, 0xd2, 0x7a, 0x92, 0x5d, 0x57, 0x44, 0xeb, 0x09,
-	0x0a, 0x56, 0x01, 0x48, 0x3a, 0x15, 0xd1, 0xaf, 0x08, 0xfa, 0x8c, 0x07, 0x42, 0x50, 0xba, 0xe4,
-	0x74, 0x82, 0x56, 0x45, 0x21, 0xe2, 0x1d, 0x12, 0x50, 0x99, 0xd5, 0xef, 0xa1, 0x35, 0xbc, 0x54,
-	0xab, 0xbc, 0xf9, 0x68, 0xae, 0xb0, 0x3a, 0x8d, 0xd5, 0xb3, 0x38, 0xa8, 0x80, 0x35, 0x6a, 0x5d,
-	0x5d, 0x7d, 0x33, 0xb6, 0x7f, 0x40, 0x65, 0x41, 0x3f, 0xb5, 0x23, 0x59, 0xc7, 0xf4, 0xaf, 0x11,
-	0x88, 0x65, 0xa3, 0xf7, 0x28, 0x5e, 0xb4, 0xc5, 0xe2, 0x36, 0xaa, 0x88, 0x44, 0xa7, 0x36, 0xdc,
-	0x03, 0x2b, 0xde, 0xc4, 0xf3, 0xd9, 0x0d, 0x5a, 0xc7, 0x52, 0x6d, 0x4d, 0x4f, 0x2c, 0xf8, 0x1a,
-	0x6c, 0x9b, 0x63, 0xff, 0x9a, 0xbb, 0xaa, 0xe7, 0xf1, 0x81, 0x65, 0x0a, 0xf0, 0x86, 0x20, 0x7d,
-	0x7c, 0x10, 0xb5, 0x5a, 0x4c, 0x8a, 0x51, 0xd5, 0x47, 0x9b, 0x58, 0xaa, 0x2d, 0xe9, 0x33, 0x47,
-	0x74, 0x3a, 0x76, 0x68, 0x72, 0xba, 0x15, 0x9f, 0x4e, 0x1d, 0xf0, 0x0c, 0x54, 0x92, 0xb6, 0xf5,
-	0x26, 0x0e, 0x43, 0xb2, 0x98, 0xc6, 0xc7, 0xff, 0x35, 0x8d, 0x28, 0x56, 0xcf, 0x02, 0xa3, 0x2a,
-	0x5d, 0xe6, 0xf1, 0xd1, 0x2d, 0xa3, 0x68, 0x5b, 0xd4, 0x32, 0xb5, 0xa3, 0xc5, 0x72, 0x99, 0x33,
-	0xb2, 0x98, 0x87, 0x20, 0x5e, 0xaa, 0x95, 0xf4, 0xd4, 0x84, 0x6f, 0x41, 0x39, 0x5d, 0x55, 0x0f,
-	0xed, 0x88, 0x81, 0xbc, 0x9a, 0xab, 0xad, 0x27, 0x91, 0xfa, 0x0c, 0x13, 0x35, 0xf0, 0xda, 0xa2,
-	0x94, 0xd9, 0x68, 0x37, 0x6e, 0x60, 0x6c, 0x1d, 0xfd, 0x56, 0x06, 0x95, 0x4c, 0xae, 0xf0, 0x08,
-	0x6c, 0x9f, 0x76, 0x5a, 0x2d, 0xd2, 0xee, 0xf5, 0x7b, 0x17, 0x5d, 0xd2, 0x6f, 0x77, 0xda, 0x44,
-	0x5e, 0x50, 0x76, 0x82, 0x10, 0x6f, 0x65, 0xe2, 0xda, 0xdc, 0x66, 0xf0, 0x35, 0x80, 0xb9, 0x58,
-	0x9d, 0x74, 0x9b, 0x17, 0xb2, 0xa4, 0xec, 0x06, 0x21, 0x96, 0xb3, 0x0d, 0x60, 0xce, 0x68, 0x02,
-	0x3f, 0x07, 0xfb, 0xb9, 0x68, 0xb5, 0xd1, 0xe8, 0x37, 0xd5, 0x13, 0xd2, 0x34, 0xe4, 0x45, 0x05,
-	0x05, 0x21, 0xde, 0xcd, 0x40, 0x54, 0x4a, 0x9b, 0xe6, 0x25, 0x1b, 0x79, 0xf0, 0x4b, 0xa0, 0x3c,
-	0x10, 0x69, 0x75, 0xde, 0x93, 0x14, 0xb9, 0xa4, 0xbc, 0x08, 0x42, 0xbc, 0x9f, 0x13, 0xbb, 0xe1,
-	0xb7, 0x6c, 0x0e, 0x38, 0xd2, 0x54, 0x0d, 0x43, 0x7b, 0xd7, 0x26, 0xc4, 0x90, 0x4b, 0x8f, 0xc0,
-	0x2a, 0xa5, 0xaa, 0xe7, 0x59, 0x43, 0x9b, 0x31, 0x0f, 0xaa, 0xe0, 0x65, 0x91, 0xf2, 0x0c, 0xbf,
-	0xac, 0x54, 0x83, 0x10, 0x2b, 0x8f, 0xc4, 0x67, 0x14, 0x45, 0xfa, 0x3a, 0x79, 0xaf, 0x91, 0xef,
-	0x88, 0x6e, 0xc8, 0x2b, 0x45, 0xfa, 0x3a, 0xbb, 0xb5, 0xd8, 0x4f, 0xcc, 0x9d, 0xab, 0x3f, 0xc3,
-	0xaf, 0xce, 0xd1, 0x9f, 0x51, 0x7c, 0x05, 0x5e, 0xe4, 0x28, 0x5a, 0x9d, 0x86, 0x76, 0xa6, 0x91,
-	0x46, 0xbf, 0xa7, 0xf5, 0x9a, 0x44, 0x5e, 0x53, 0x0e, 0x82, 0x10, 0xa3, 0x0c, 0x41, 0x8b, 0x53,
-	0xeb, 0xca, 0x62, 0xb4, 0x67, 0xf9, 0x23, 0x06, 0x35, 0xf0, 0xaa, 0x18, 0xde, 0x20, 0xc6, 0xa9,
-	0xae, 0x75, 0x7b, 0x5a, 0xa7, 0x2d, 0x97, 0x95, 0xc3, 0x20, 0xc4, 0xd5, 0x02, 0x92, 0x06, 0xf3,
-	0x06, 0xae, 0xe5, 0x88, 0xab, 0xf7, 0x05, 0x78, 0x9e, 0xa3, 0xd2, 0x0c, 0xe3, 0x9c, 0xf4, 0x4f,
-	0x9b, 0x1d, 0x83, 0x34, 0x64, 0xa0, 0x28, 0x41, 0x88, 0xf7, 0x32, 0x14, 0x9a, 0xe7, 0x8d, 0xd9,
-	0xe9, 0x88, 0x7b, 0x8c, 0xce, 0x81, 0x76, 0xba, 0xa4, 0x4d, 0x1a, 0x72, 0xa5, 0x18, 0xda, 0x71,
-	0x98, 0xcd, 0x28, 0x3c, 0x03, 0x38, 0x07, 0xed, 0x9e, 0x37, 0x9b, 0x7d, 0x9d, 0x7c, 0x7b, 0x4e,
-	0x8c, 0x5e, 0x2a, 0xbe, 0xae, 0xe0, 0x20, 0xc4, 0x07, 0x19, 0x86, 0xee, 0x78, 0x34, 0xd2, 0xd9,
-	0x8f, 0x63, 0xe6, 0xf9, 0x49, 0x0a, 0x4f, 0xf2, 0x24, 0x99, 0x6c, 0x3c, 0xc5, 0xf3, 0x7f, 0xf2,
-	0x69, 0x11, 0xfd, 0x1d, 0x69, 0xc8, 0x9b, 0x4f, 0xf1, 0xb4, 0x98, 0x3b, 0x64, 0x14, 0xd6, 0xc1,
-	0xce, 0x83, 0xd5, 0x88, 0x76, 0x42, 0xde, 0x52, 0x9e, 0x05, 0x21, 0xde, 0xce, 0x2d, 0x44, 0xb4,
-	0x0a, 0x85, 0x77, 0xef, 0xa4, 0x73, 0xde, 0xee, 0x5d, 0xc8, 0x72, 0xd1, 0xdd, 0x3b, 0xe1, 0x63,
-	0xdb, 0x9f, 0xc0, 0xb7, 0xe0, 0xa0, 0x78, 0xfe, 0x09, 0x76, 0x5b, 0x79, 0x19, 0x84, 0xf8, 0x79,
-	0xc1, 0xe8, 0x13, 0x82, 0x87, 0xfb, 0x1f, 0xb7, 0x3c, 0x85, 0xc3, 0x47, 0xfb, 0x1f, 0xb7, 0x3b,
-	0x06, 0x2b, 0xa5, 0x5f, 0x7e, 0xaf, 0x2e, 0x1c, 0xfd, 0x21, 0x81, 0x8d, 0xdc, 0x5f, 0x5b, 0xb6,
-	0xf8, 0xae, 0xaa, 0x47, 0x8f, 0xe4, 0x47, 0x2a, 0x5b, 0x7c, 0x1c, 0x2b, 0x7e, 0xa6, 0x3e, 0x05,
-	0xbb, 0x0f, 0xe2, 0xc5, 0x06, 0xc9, 0x92, 0xb2, 0x17, 0x84, 0x18, 0xe6, 0x00, 0x62, 0x79, 0xb2,
-	0xd7, 0x26, 0x41, 0x64, 0x07, 0x25, 0x2f, 0xe6, 0xae, 0x4d, 0x0c, 0xcc, 0xcc, 0x28, 0x4e, 0xfc,
-	0xa4, 0xf1, 0xe7, 0x5d, 0x55, 0xfa, 0x70, 0x57, 0x95, 0xfe, 0xb9, 0xab, 0x4a, 0xbf, 0xde, 0x57,
-	0x17, 0x3e, 0xdc, 0x57, 0x17, 0xfe, 0xbe, 0xaf, 0x2e, 0x7c, 0x7f, 0x34, 0xb4, 0xfc, 0xeb, 0xf1,
-	0x65, 0x7d, 0xc0, 0x6f, 0x8e, 0xd3, 0x0f, 0x8e, 0xf4, 0xf9, 0xf3, 0xf4, 0xcd, 0x9f, 0x38, 0xcc,
-	0xbb, 0x5c, 0x11, 0x9f, 0x1f, 0x9f, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x90, 0x2b, 0x3e, 0xf8,
-	0xf8, 0x08, 0x00, 0x00,
+	// 1444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0xb6, 0x6c, 0xc7, 0x97, 0xf1, 0x25, 0xf4, 0xf8, 0x46, 0x33, 0x8e, 0xc2, 0x38, 0x41, 0x20,
+	0x18, 0x86, 0xf3, 0x23, 0x3f, 0xba, 0x28, 0x8a, 0x36, 0xa0, 0xc5, 0x51, 0x42, 0x44, 0x22, 0xd5,
+	0x21, 0x95, 0xd6, 0x45, 0x01, 0x81, 0x16, 0x47, 0x36, 0x11, 0x99, 0xc3, 0x92, 0x94, 0x5b, 0xbd,
+	0x41, 0xc1, 0x55, 0x5f, 0x80, 0xab, 0xbe, 0x43, 0x9f, 0xa1, 0xcb, 0x74, 0xd7, 0x65, 0x91, 0x2c,
+	0xfb, 0x02, 0x5d, 0x16, 0x1c, 0x92, 0x16, 0x29, 0x89, 0x4e, 0x57, 0xe2, 0xcc, 0x9c, 0xef, 0x3b,
+	0xb7, 0x8f, 0x67, 0x44, 0xb0, 0x7b, 0x69, 0x07, 0xd4, 0xb5, 0xcd, 0xe7, 0x3d, 0x7a, 0x7d, 0x4d,
+	0x9c, 0xe0, 0xd4, 0xf5, 0x68, 0x40, 0xe1, 0x7e, 0xba, 0x7d, 0x3a, 0xf1, 0x2b, 0xec, 0x5c, 0xd2,
+	0x4b, 0xca, 0x6c, 0x9e, 0xc7, 0x4f, 0x89, 0xb9, 0xb0, 0x97, 0xb1, 0x78, 0xc4, 0xec, 0x05, 0x36,
+	0x75, 0xd2, 0x7d, 0x3e, 0xdb, 0x37, 0x83, 0xc0, 0xec, 0x5d, 0x8d, 0x1d, 0x1c, 0xfd, 0xb1, 0x0c,
+	0x96, 0xeb, 0x89, 0x4b, 0xc8, 0x83, 0xe5, 0x9e, 0x47, 0xcc, 0x80, 0x7a, 0x7c, 0x45, 0xac, 0xd4,
+	0x56, 0x71, 0xb6, 0x84, 0x9b, 0x60, 0xde, 0xb6, 0xf8, 0x79, 0xb1, 0x52, 0x5b, 0xc4, 0xf3, 0xb6,
+	0x05, 0x8f, 0xc0, 0xba, 0x47, 0x5c, 0xea, 0xdb, 0x01, 0xf5, 0x46, 0x8a, 0xc5, 0x2f, 0xb0, 0x93,
+	0xc2, 0x1e, 0x3c, 0x04, 0xab, 0xae, 0xe9, 0x11, 0x27, 0x50, 0x6c, 0x8b, 0x5f, 0x64, 0x06, 0xe3,
+	0x0d, 0xf8, 0x15, 0x58, 0x4a, 0x16, 0xfc, 0x3d, 0xb1, 0x52, 0xdb, 0x7c, 0xf1, 0xec, 0xb4, 0x24,
+	0xd3, 0xd3, 0x34, 0xba, 0x36, 0xb3, 0xc6, 0x29, 0x0a, 0x56, 0x01, 0x48, 0x2b, 0x15, 0xd3, 0x2f,
+	0x31, 0xfa, 0xdc, 0x0e, 0x84, 0x60, 0xf1, 0x82, 0x5a, 0x23, 0x7e, 0x99, 0x25, 0xc2, 0x9e, 0x21,
+	0x02, 0x6b, 0xe3, 0xfc, 0x7d, 0x7e, 0x45, 0x5c, 0xa8, 0xad, 0xbd, 0x78, 0x52, 0xea, 0x58, 0xba,
+	0xb5, 0xc5, 0x79, 0x1c, 0x14, 0xc0, 0x8a, 0x65, 0xf7, 0xfb, 0xaf, 0x87, 0xce, 0x3b, 0x7e, 0x95,
+	0xd1, 0xdf, 0xae, 0x63, 0xb7, 0xae, 0x19, 0x5c, 0xf1, 0x20, 0x71, 0x1b, 0x3f, 0xc7, 0xf6, 0xac,
+	0x2c, 0x36, 0x75, 0xf8, 0x35, 0x16, 0xe8, 0xed, 0x1a, 0xee, 0x81, 0x25, 0x7f, 0xe4, 0x07, 0xe4,
+	0x9a, 0x5f, 0x17, 0x2b, 0xb5, 0x15, 0x9c, 0xae, 0xe0, 0x09, 0xd8, 0x32, 0x87, 0xc1, 0x15, 0xf5,
+	0x24, 0xdf, 0xa7, 0x3d, 0xdb, 0x64, 0xe0, 0x0d, 0x46, 0x3a, 0x7d, 0x10, 0x97, 0x9a, 0x75, 0x8a,
+	0x58, 0x52, 0xc0, 0x6f, 0x8a, 0x95, 0xda, 0x02, 0x1e, 0x6f, 0xc4, 0xa7, 0x43, 0xd7, 0x4a, 0x4f,
+	0xef, 0x27, 0xa7, 0xb7, 0x1b, 0xb0, 0x01, 0xd6, 0xd2, 0xb2, 0x19, 0x23, 0x97, 0xf0, 0x1c, 0xeb,
+	0xc6, 0xd3, 0x4f, 0x75, 0x23, 0xb6, 0xc5, 0x79, 0x60, 0x9c, 0xa5, 0x47, 0x7c, 0x3a, 0xb8, 0x21,
+	0x16, 0xbf, 0xc5, 0x72, 0xb9, 0x5d, 0xc7, 0xc2, 0xf2, 0x88, 0x3b, 0xb0, 0x89, 0xcf, 0x43, 0x71,
+	0xa1, 0xb6, 0x88, 0xb3, 0x25, 0x7c, 0x09, 0x56, 0x33, 0xa9, 0xfa, 0xfc, 0x36, 0x6b, 0xc8, 0xe3,
+	0x52, 0xdf, 0x38, 0xb5, 0xc4, 0x63, 0x4c, 0x5c, 0xc0, 0x2b, 0xdb, 0xb2, 0x88, 0xc3, 0xef, 0x24,
+	0x05, 0x4c, 0x56, 0x50, 0x05, 0x9b, 0x99, 0x51, 0x9d, 0x0e, 0xe3, 0x76, 0xef, 0x32, 0xf6, 0x67,
+	0x9f, 0x64, 0x67, 0xe6, 0x78, 0x02, 0x1d, 0x17, 0xd1, 0x76, 0x30, 0x71, 0x07, 0x23, 0x83, 0xf2,
+	0x7b, 0x89, 0x9a, 0x6f, 0x37, 0x62, 0x35, 0x66, 0xc9, 0x9e, 0x8d, 0xf8, 0x7d, 0xd6, 0xa7, 0xdc,
+	0x0e, 0x6c, 0x83, 0xf5, 0x24, 0x2e, 0x4c, 0x4c, 0x9f, 0x3a, 0x3c, 0xcf, 0xaa, 0x7c, 0xf2, 0xa9,
+	0x2a, 0xbf, 0xce, 0x61, 0x70, 0x81, 0x21, 0x2e, 0x77, 0xb2, 0x3e, 0x1b, 0xf1, 0x07, 0x89, 0x08,
+	0xb3, 0xf5, 0xf1, 0x3f, 0x1b, 0x60, 0x2d, 0xd7, 0x27, 0x78, 0x0c, 0xb6, 0xea, 0x5a, 0xab, 0x85,
+	0x54, 0xa3, 0x6b, 0x9c, 0xb7, 0x51, 0x57, 0xd5, 0x54, 0xc4, 0xcd, 0x09, 0xdb, 0x61, 0x24, 0xde,
+	0xcf, 0xd9, 0xa9, 0xd4, 0x21, 0xf0, 0x04, 0xc0, 0x82, 0x2d, 0x46, 0xed, 0xe6, 0x39, 0x57, 0x11,
+	0x76, 0xc2, 0x48, 0xe4, 0xf2, 0xcd, 0x8f, 0x33, 0x87, 0x9f, 0x81, 0xfd, 0x82, 0xb5, 0x24, 0xcb,
+	0xdd, 0xa6, 0x74, 0x86, 0x9a, 0x3a, 0x37, 0x2f, 0xf0, 0x61, 0x24, 0xee, 0xe4, 0x20, 0x92, 0x65,
+	0x35, 0xcd, 0x0b, 0x32, 0xf0, 0xe1, 0x17, 0x40, 0x98, 0x70, 0xd2, 0xd2, 0xde, 0xa2, 0x0c, 0xb9,
+	0x20, 0x3c, 0x08, 0x23, 0x71, 0xbf, 0xe0, 0xec, 0x9a, 0xde, 0x90, 0x12, 0x70, 0xec, 0x53, 0xd2,
+	0x75, 0xe5, 0x95, 0x8a, 0x90, 0xce, 0x2d, 0x4e, 0x81, 0x25, 0xcb, 0x92, 0x7c, 0xdf, 0xbe, 0x74,
+	0x08, 0xf1, 0xa1, 0x04, 0x1e, 0xce, 0xf2, 0x3c, 0xc6, 0xdf, 0x13, 0xaa, 0x61, 0x24, 0x0a, 0x53,
+	0xce, 0xc7, 0x14, 0xb3, 0xfc, 0x63, 0xf4, 0x56, 0x41, 0xdf, 0x20, 0xac, 0x73, 0x4b, 0xb3, 0xfc,
+	0x63, 0x72, 0x63, 0x93, 0x1f, 0x89, 0x57, 0xea, 0x7f, 0x8c, 0x5f, 0x2e, 0xf1, 0x3f, 0xa6, 0xf8,
+	0x12, 0x3c, 0x28, 0x50, 0xb4, 0x34, 0x59, 0x69, 0x28, 0x48, 0xee, 0x1a, 0x8a, 0xd1, 0x44, 0xdc,
+	0x8a, 0x70, 0x18, 0x46, 0x22, 0x9f, 0x23, 0x68, 0x51, 0xcb, 0xee, 0xdb, 0xc4, 0x32, 0xec, 0x60,
+	0x40, 0xa0, 0x02, 0x1e, 0xcf, 0x86, 0xcb, 0x48, 0xaf, 0x63, 0xa5, 0x6d, 0x28, 0x9a, 0xca, 0xad,
+	0x0a, 0x47, 0x61, 0x24, 0x56, 0x67, 0x90, 0xc8, 0xc4, 0xef, 0x79, 0xb6, 0xcb, 0xc6, 0xce, 0xe7,
+	0xe0, 0xa0, 0x40, 0xa5, 0xe8, 0x7a, 0x07, 0x75, 0xeb, 0x4d, 0x4d, 0x47, 0x32, 0x07, 0x04, 0x21,
+	0x8c, 0xc4, 0xbd, 0x1c, 0x85, 0xe2, 0xfb, 0x43, 0x52, 0x1f, 0x50, 0x9f, 0x58, 0x25, 0x50, 0xad,
+	0x8d, 0x54, 0x24, 0x73, 0x6b, 0xb3, 0xa1, 0x9a, 0x4b, 0x1c, 0x62, 0xc1, 0x06, 0x10, 0x0b, 0xd0,
+	0x76, 0xa7, 0xd9, 0xec, 0x62, 0xf4, 0x75, 0x07, 0xe9, 0x46, 0xe6, 0x7c, 0x5d, 0x10, 0xc3, 0x48,
+	0x3c, 0xcc, 0x31, 0xb4, 0x87, 0x83, 0x01, 0x26, 0x3f, 0x0c, 0x89, 0x1f, 0xa4, 0x21, 0xdc, 0xc9,
+	0x93, 0x46, 0xb2, 0x71, 0x17, 0xcf, 0x7f, 0x89, 0xa7, 0x85, 0xf0, 0x2b, 0x24, 0x73, 0x9b, 0x77,
+	0xf1, 0xb4, 0x88, 0x77, 0x49, 0x2c, 0x78, 0x0a, 0xb6, 0x27, 0xa4, 0x11, 0x6b, 0x82, 0xbb, 0x2f,
+	0xec, 0x86, 0x91, 0xb8, 0x55, 0x10, 0x44, 0x2c, 0x85, 0x99, 0xef, 0xde, 0x99, 0xd6, 0x51, 0x8d,
+	0x73, 0x8e, 0x9b, 0xf5, 0xee, 0x9d, 0xc5, 0x83, 0x6c, 0x04, 0x5f, 0x82, 0xc3, 0xd9, 0xfd, 0x4f,
+	0xb1, 0x5b, 0xc2, 0xc3, 0x30, 0x12, 0x0f, 0x66, 0xb4, 0x3e, 0x25, 0x98, 0xd4, 0x7f, 0x52, 0xf2,
+	0x0c, 0x0e, 0xa7, 0xf4, 0x9f, 0x94, 0x3b, 0x05, 0x7f, 0x0b, 0x8e, 0xcb, 0x8b, 0x85, 0x91, 0x24,
+	0x9f, 0x77, 0x1b, 0x1a, 0xce, 0x72, 0xdf, 0x16, 0x6a, 0x61, 0x24, 0x3e, 0x9d, 0x5d, 0x36, 0x4c,
+	0x4c, 0x6b, 0xd4, 0xa0, 0x5e, 0x5a, 0x8e, 0xef, 0xc1, 0xc9, 0x1d, 0xb2, 0xd0, 0xd4, 0xb7, 0x08,
+	0x1b, 0xf1, 0x4b, 0xa2, 0x75, 0x65, 0x2c, 0x35, 0x0c, 0x6e, 0x47, 0x38, 0x0e, 0x23, 0xf1, 0x59,
+	0x89, 0x44, 0xa8, 0x73, 0x43, 0xbc, 0x80, 0x58, 0x06, 0x95, 0x3d, 0xb3, 0x1f, 0xc0, 0x57, 0x13,
+	0x4d, 0x4e, 0x08, 0x75, 0x29, 0x7e, 0x5b, 0xba, 0x4d, 0xad, 0xfe, 0x06, 0xc9, 0xdc, 0xae, 0xf0,
+	0x38, 0x8c, 0xc4, 0x87, 0xf9, 0xd4, 0x19, 0x8d, 0xcf, 0x2e, 0xe9, 0x26, 0xed, 0xbd, 0x23, 0x16,
+	0x7c, 0x03, 0x8e, 0xca, 0x89, 0x3a, 0x6a, 0x4a, 0xb5, 0x27, 0x3c, 0x09, 0x23, 0xf1, 0x51, 0x09,
+	0x55, 0xc7, 0x19, 0x24, 0x64, 0x53, 0xbd, 0x54, 0x9a, 0x48, 0x37, 0x34, 0x95, 0x89, 0x01, 0xc9,
+	0xdc, 0xfe, 0x74, 0x2f, 0xed, 0x01, 0xf1, 0x03, 0xea, 0xc4, 0x82, 0x20, 0x16, 0xac, 0x83, 0x6a,
+	0x09, 0x41, 0x32, 0x98, 0x64, 0x8e, 0x17, 0x1e, 0x85, 0x91, 0xf8, 0x60, 0x16, 0x45, 0x32, 0x98,
+	0xa6, 0xa3, 0xa8, 0x63, 0x4d, 0xd7, 0xbb, 0x18, 0x35, 0x10, 0x46, 0x6a, 0x1d, 0x71, 0x07, 0x53,
+	0x51, 0xd4, 0x3d, 0xea, 0xfb, 0x98, 0xf4, 0x89, 0x47, 0x9c, 0x1e, 0x99, 0x8a, 0x22, 0x19, 0x06,
+	0x06, 0x96, 0x54, 0xbd, 0x81, 0x30, 0x46, 0x32, 0x27, 0x4c, 0x45, 0xc1, 0x26, 0x82, 0xe1, 0x99,
+	0x8e, 0xdf, 0x27, 0x9e, 0x47, 0x2c, 0x61, 0xf1, 0xe7, 0x5f, 0xab, 0x73, 0xc7, 0x7f, 0x2f, 0x80,
+	0xed, 0x19, 0x97, 0x67, 0x5e, 0xb4, 0xaf, 0x15, 0x59, 0x46, 0x6a, 0x2c, 0x36, 0x5d, 0x53, 0xb3,
+	0xbb, 0x30, 0x2f, 0xda, 0x3c, 0x90, 0xdd, 0x89, 0xa5, 0x60, 0xbd, 0x2d, 0xb5, 0xb8, 0x4a, 0x29,
+	0x58, 0x77, 0xcd, 0x6b, 0x28, 0x83, 0x47, 0xb3, 0xc1, 0x5a, 0xa3, 0xd1, 0x35, 0xb4, 0xb6, 0x52,
+	0xe7, 0xe6, 0x0b, 0xd9, 0xe5, 0x19, 0xb4, 0x7e, 0xdf, 0xa0, 0xae, 0xdd, 0xcb, 0x97, 0x68, 0x82,
+	0xa5, 0x63, 0xc8, 0x92, 0x81, 0x64, 0x6e, 0xa1, 0x9c, 0x64, 0x18, 0xb0, 0x3f, 0x7b, 0xf9, 0x9b,
+	0xa3, 0x48, 0x22, 0x9d, 0x75, 0x74, 0xc4, 0x2d, 0x16, 0x6e, 0x8e, 0x3c, 0x83, 0x74, 0x31, 0xf4,
+	0x09, 0x44, 0x65, 0x99, 0xc8, 0x9d, 0x76, 0x53, 0xa9, 0x4b, 0x06, 0xe2, 0xee, 0x15, 0xe6, 0x5c,
+	0x9e, 0x42, 0x1e, 0xba, 0x03, 0xbb, 0x67, 0x06, 0xa4, 0x3c, 0x15, 0x8c, 0x74, 0xad, 0x19, 0x6b,
+	0x6e, 0xa9, 0x34, 0x15, 0x9c, 0xfe, 0xa5, 0x4a, 0xbb, 0xfd, 0x5b, 0x05, 0x6c, 0x14, 0x3e, 0x0f,
+	0xf2, 0x43, 0xb4, 0x2d, 0xe1, 0xf8, 0x27, 0x6d, 0x70, 0x7e, 0x88, 0x26, 0xb6, 0xac, 0xb5, 0xff,
+	0x03, 0x3b, 0x13, 0xf6, 0x4c, 0x7c, 0x5c, 0x45, 0xd8, 0x0b, 0x23, 0x11, 0x16, 0x00, 0x4c, 0x72,
+	0xf9, 0x22, 0xa6, 0x88, 0xfc, 0xa4, 0xe1, 0xe6, 0x0b, 0x45, 0x4c, 0x80, 0xb9, 0xc1, 0x92, 0x04,
+	0x7e, 0x26, 0xff, 0xfe, 0xa1, 0x5a, 0x79, 0xff, 0xa1, 0x5a, 0xf9, 0xeb, 0x43, 0xb5, 0xf2, 0xcb,
+	0xc7, 0xea, 0xdc, 0xfb, 0x8f, 0xd5, 0xb9, 0x3f, 0x3f, 0x56, 0xe7, 0xbe, 0x3b, 0xbe, 0xb4, 0x83,
+	0xab, 0xe1, 0xc5, 0x69, 0x8f, 0x5e, 0x3f, 0xcf, 0x3e, 0xda, 0xb2, 0xdf, 0x9f, 0x6e, 0x9f, 0x82,
+	0x91, 0x4b, 0xfc, 0x8b, 0x25, 0xf6, 0x09, 0xf7, 0xff, 0x7f, 0x07, 0x00, 0x6c, 0x6b, 0x43, 0xa1,
+	0x3c, 0x0e, 0x00, 0x00,
 }
 
 func (m *Comment) Marshal() (dAtA []byte, err error) {
@@ -418,6 +551,54 @@ func (m *Comment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HiddenBy) > 0 {
+		i -= len(m.HiddenBy)
+		copy(dAtA[i:], m.HiddenBy)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.HiddenBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.HiddenReason != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.HiddenReason))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.ResolvedBy) > 0 {
+		i -= len(m.ResolvedBy)
+		copy(dAtA[i:], m.ResolvedBy)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.ResolvedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.InReplyTo != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.InReplyTo))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.ReactionCounts) > 0 {
+		for iNdEx := len(m.ReactionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReactionCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintComment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
 	if m.Hidden {
 		i--
 		if m.Hidden {
@@ -679,6 +860,26 @@ func (m *Comment) Size() (n int) {
 	if m.Hidden {
 		n += 3
 	}
+	if len(m.ReactionCounts) > 0 {
+		for _, e := range m.ReactionCounts {
+			l = e.Size()
+			n += 2 + l + sovComment(uint64(l))
+		}
+	}
+	if m.InReplyTo != 0 {
+		n += 2 + sovComment(uint64(m.InReplyTo))
+	}
+	l = len(m.ResolvedBy)
+	if l > 0 {
+		n += 2 + l + sovComment(uint64(l))
+	}
+	if m.HiddenReason != 0 {
+		n += 2 + sovComment(uint64(m.HiddenReason))
+	}
+	l = len(m.HiddenBy)
+	if l > 0 {
+		n += 2 + l + sovComment(uint64(l))
+	}
 	return n
 }
 
@@ -1252,6 +1453,142 @@ func (m *Comment) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Hidden = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReactionCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReactionCounts = append(m.ReactionCounts, &ReactionCount{})
+			if err := m.ReactionCounts[len(m.ReactionCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InReplyTo", wireType)
+			}
+			m.InReplyTo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InReplyTo |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolvedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenReason", wireType)
+			}
+			m.HiddenReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HiddenReason |= CommentHiddenReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HiddenBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipComment(dAtA[iNdEx:])
@@ -0,0 +1,637 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gitopia/comment_revision.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CommentRevision records the previous body of an edited comment, or of an
+// issue or pull request description when commentIid is 0.
+type CommentRevision struct {
+	RepositoryId uint64        `protobuf:"varint,1,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	Parent       CommentParent `protobuf:"varint,2,opt,name=parent,proto3,enum=gitopia.gitopia.gitopia.CommentParent" json:"parent,omitempty"`
+	ParentIid    uint64        `protobuf:"varint,3,opt,name=parentIid,proto3" json:"parentIid,omitempty"`
+	CommentIid   uint64        `protobuf:"varint,4,opt,name=commentIid,proto3" json:"commentIid,omitempty"`
+	Revision     uint64        `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	Editor       string        `protobuf:"bytes,6,opt,name=editor,proto3" json:"editor,omitempty"`
+	Body         string        `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	BodyHash     string        `protobuf:"bytes,8,opt,name=bodyHash,proto3" json:"bodyHash,omitempty"`
+	EditedAt     int64         `protobuf:"varint,9,opt,name=editedAt,proto3" json:"editedAt,omitempty"`
+}
+
+func (m *CommentRevision) Reset()         { *m = CommentRevision{} }
+func (m *CommentRevision) String() string { return proto.CompactTextString(m) }
+func (*CommentRevision) ProtoMessage()    {}
+func (*CommentRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52b2783fe441d5e0, []int{0}
+}
+func (m *CommentRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommentRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommentRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommentRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommentRevision.Merge(m, src)
+}
+func (m *CommentRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommentRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommentRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommentRevision proto.InternalMessageInfo
+
+func (m *CommentRevision) GetRepositoryId() uint64 {
+	if m != nil {
+		return m.RepositoryId
+	}
+	return 0
+}
+
+func (m *CommentRevision) GetParent() CommentParent {
+	if m != nil {
+		return m.Parent
+	}
+	return CommentParentNone
+}
+
+func (m *CommentRevision) GetParentIid() uint64 {
+	if m != nil {
+		return m.ParentIid
+	}
+	return 0
+}
+
+func (m *CommentRevision) GetCommentIid() uint64 {
+	if m != nil {
+		return m.CommentIid
+	}
+	return 0
+}
+
+func (m *CommentRevision) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *CommentRevision) GetEditor() string {
+	if m != nil {
+		return m.Editor
+	}
+	return ""
+}
+
+func (m *CommentRevision) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *CommentRevision) GetBodyHash() string {
+	if m != nil {
+		return m.BodyHash
+	}
+	return ""
+}
+
+func (m *CommentRevision) GetEditedAt() int64 {
+	if m != nil {
+		return m.EditedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CommentRevision)(nil), "gitopia.gitopia.gitopia.CommentRevision")
+}
+
+func init() { proto.RegisterFile("gitopia/comment_revision.proto", fileDescriptor_52b2783fe441d5e0) }
+
+var fileDescriptor_52b2783fe441d5e0 = []byte{
+	// 290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0x2c, 0xc9,
+	0x2f, 0xc8, 0x4c, 0xd4, 0x4f, 0xce, 0xcf, 0xcd, 0x4d, 0xcd, 0x2b, 0x89, 0x2f, 0x4a, 0x2d, 0xcb,
+	0x2c, 0xce, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xca, 0xeb, 0xa1,
+	0xd1, 0x52, 0xa2, 0x68, 0x1a, 0x21, 0xea, 0x95, 0x36, 0x33, 0x71, 0xf1, 0x3b, 0x43, 0x44, 0x82,
+	0xa0, 0x26, 0x09, 0x29, 0x71, 0xf1, 0x14, 0xa5, 0x16, 0xe4, 0x17, 0x67, 0x96, 0xe4, 0x17, 0x55,
+	0x7a, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xb0, 0x04, 0xa1, 0x88, 0x09, 0xd9, 0x71, 0xb1, 0x15,
+	0x24, 0x16, 0xa5, 0xe6, 0x95, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x19, 0xa9, 0xe9, 0xe1, 0xb0,
+	0x58, 0x0f, 0x6a, 0x7a, 0x00, 0x58, 0x75, 0x10, 0x54, 0x97, 0x90, 0x0c, 0x17, 0x27, 0x84, 0xe5,
+	0x99, 0x99, 0x22, 0xc1, 0x0c, 0xb6, 0x00, 0x21, 0x20, 0x24, 0xc7, 0xc5, 0x05, 0x75, 0x26, 0x48,
+	0x9a, 0x05, 0x2c, 0x8d, 0x24, 0x22, 0x24, 0xc5, 0xc5, 0x01, 0xf3, 0xb7, 0x04, 0x2b, 0x58, 0x16,
+	0xce, 0x17, 0x12, 0xe3, 0x62, 0x4b, 0x4d, 0x01, 0x39, 0x53, 0x82, 0x4d, 0x81, 0x51, 0x83, 0x33,
+	0x08, 0xca, 0x13, 0x12, 0xe2, 0x62, 0x49, 0xca, 0x4f, 0xa9, 0x94, 0x60, 0x07, 0x8b, 0x82, 0xd9,
+	0x20, 0x73, 0x40, 0xb4, 0x47, 0x62, 0x71, 0x86, 0x04, 0x07, 0x58, 0x1c, 0xce, 0x07, 0xc9, 0x81,
+	0x74, 0xa6, 0xa6, 0x38, 0x96, 0x48, 0x70, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xf9, 0x4e, 0x2e,
+	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
+	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x95, 0x9e, 0x59, 0x92, 0x51,
+	0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x0b, 0x71, 0x18, 0x5d, 0x01, 0x67, 0x95, 0x54, 0x16,
+	0xa4, 0x16, 0x27, 0xb1, 0x81, 0xa3, 0xc0, 0x18, 0x30, 0x00, 0x21, 0x3d, 0x50, 0xb4, 0xd4, 0x01,
+	0x00, 0x00,
+}
+
+func (m *CommentRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommentRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommentRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EditedAt != 0 {
+		i = encodeVarintCommentRevision(dAtA, i, uint64(m.EditedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.BodyHash) > 0 {
+		i -= len(m.BodyHash)
+		copy(dAtA[i:], m.BodyHash)
+		i = encodeVarintCommentRevision(dAtA, i, uint64(len(m.BodyHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintCommentRevision(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintCommentRevision(dAtA, i, uint64(len(m.Editor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Revision != 0 {
+		i = encodeVarintCommentRevision(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CommentIid != 0 {
+		i = encodeVarintCommentRevision(dAtA, i, uint64(m.CommentIid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ParentIid != 0 {
+		i = encodeVarintCommentRevision(dAtA, i, uint64(m.ParentIid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Parent != 0 {
+		i = encodeVarintCommentRevision(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RepositoryId != 0 {
+		i = encodeVarintCommentRevision(dAtA, i, uint64(m.RepositoryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCommentRevision(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCommentRevision(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CommentRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RepositoryId != 0 {
+		n += 1 + sovCommentRevision(uint64(m.RepositoryId))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovCommentRevision(uint64(m.Parent))
+	}
+	if m.ParentIid != 0 {
+		n += 1 + sovCommentRevision(uint64(m.ParentIid))
+	}
+	if m.CommentIid != 0 {
+		n += 1 + sovCommentRevision(uint64(m.CommentIid))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovCommentRevision(uint64(m.Revision))
+	}
+	l = len(m.Editor)
+	if l > 0 {
+		n += 1 + l + sovCommentRevision(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovCommentRevision(uint64(l))
+	}
+	l = len(m.BodyHash)
+	if l > 0 {
+		n += 1 + l + sovCommentRevision(uint64(l))
+	}
+	if m.EditedAt != 0 {
+		n += 1 + sovCommentRevision(uint64(m.EditedAt))
+	}
+	return n
+}
+
+func sovCommentRevision(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCommentRevision(x uint64) (n int) {
+	return sovCommentRevision(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CommentRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommentRevision
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommentRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommentRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommentRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommentRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= CommentParent(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentIid", wireType)
+			}
+			m.ParentIid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommentRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentIid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentIid", wireType)
+			}
+			m.CommentIid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommentRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommentIid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommentRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommentRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommentRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommentRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommentRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommentRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommentRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommentRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommentRevision
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommentRevision
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BodyHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditedAt", wireType)
+			}
+			m.EditedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommentRevision
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EditedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommentRevision(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommentRevision
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCommentRevision(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCommentRevision
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCommentRevision
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCommentRevision
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCommentRevision
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCommentRevision
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCommentRevision
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCommentRevision        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCommentRevision          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCommentRevision = fmt.Errorf("proto: unexpected end of group")
+)
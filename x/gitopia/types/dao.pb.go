@@ -24,20 +24,21 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Dao struct {
-	Creator     string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id          uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Address     string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Name        string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	AvatarUrl   string   `protobuf:"bytes,5,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
-	Followers   []string `protobuf:"bytes,6,rep,name=followers,proto3" json:"followers,omitempty"`
-	Following   []string `protobuf:"bytes,7,rep,name=following,proto3" json:"following,omitempty"`
-	Teams       []uint64 `protobuf:"varint,8,rep,packed,name=teams,proto3" json:"teams,omitempty"`
-	Location    string   `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
-	Website     string   `protobuf:"bytes,10,opt,name=website,proto3" json:"website,omitempty"`
-	Verified    bool     `protobuf:"varint,11,opt,name=verified,proto3" json:"verified,omitempty"`
-	Description string   `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt   int64    `protobuf:"varint,13,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt   int64    `protobuf:"varint,14,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Creator        string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id             uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Address        string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Name           string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarUrl      string   `protobuf:"bytes,5,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
+	Followers      []string `protobuf:"bytes,6,rep,name=followers,proto3" json:"followers,omitempty"`
+	Following      []string `protobuf:"bytes,7,rep,name=following,proto3" json:"following,omitempty"`
+	Teams          []uint64 `protobuf:"varint,8,rep,packed,name=teams,proto3" json:"teams,omitempty"`
+	Location       string   `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	Website        string   `protobuf:"bytes,10,opt,name=website,proto3" json:"website,omitempty"`
+	Verified       bool     `protobuf:"varint,11,opt,name=verified,proto3" json:"verified,omitempty"`
+	Description    string   `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt      int64    `protobuf:"varint,13,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      int64    `protobuf:"varint,14,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	FollowersCount uint64   `protobuf:"varint,15,opt,name=followersCount,proto3" json:"followersCount,omitempty"`
 }
 
 func (m *Dao) Reset()         { *m = Dao{} }
@@ -171,6 +172,13 @@ func (m *Dao) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (m *Dao) GetFollowersCount() uint64 {
+	if m != nil {
+		return m.FollowersCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Dao)(nil), "gitopia.gitopia.gitopia.Dao")
 }
@@ -178,28 +186,29 @@ func init() {
 func init() { proto.RegisterFile("gitopia/dao.proto", fileDescriptor_bbacb5867cc9ed90) }
 
 var fileDescriptor_bbacb5867cc9ed90 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x4d, 0x6a, 0xc3, 0x30,
-	0x10, 0x85, 0x23, 0xdb, 0xf9, 0x53, 0xda, 0x40, 0x45, 0xa0, 0x22, 0x14, 0x23, 0xba, 0x32, 0x5d,
-	0x24, 0x8b, 0x9e, 0xa0, 0x25, 0x27, 0x30, 0x74, 0xd3, 0x9d, 0x62, 0x29, 0xae, 0xc0, 0xf1, 0x18,
-	0x49, 0x49, 0xda, 0x5b, 0xf4, 0x58, 0x5d, 0x66, 0xd9, 0x65, 0x49, 0xce, 0x51, 0x28, 0x92, 0x1d,
-	0x27, 0x74, 0x35, 0xf3, 0xde, 0xf7, 0xc6, 0x1e, 0x34, 0xf8, 0x26, 0x57, 0x16, 0x2a, 0xc5, 0xe7,
-	0x82, 0xc3, 0xac, 0xd2, 0x60, 0x81, 0xdc, 0x36, 0xd6, 0xec, 0x5f, 0x9d, 0x4e, 0x72, 0xc8, 0xc1,
-	0x67, 0xe6, 0xae, 0xab, 0xe3, 0xf7, 0xbf, 0x01, 0x0e, 0x17, 0x1c, 0x08, 0xc5, 0xfd, 0x4c, 0x4b,
-	0x6e, 0x41, 0x53, 0xc4, 0x50, 0x32, 0x4c, 0x4f, 0x92, 0x8c, 0x71, 0xa0, 0x04, 0x0d, 0x18, 0x4a,
-	0xa2, 0x34, 0x50, 0xc2, 0x25, 0xb9, 0x10, 0x5a, 0x1a, 0x43, 0xc3, 0x3a, 0xd9, 0x48, 0x42, 0x70,
-	0x54, 0xf2, 0xb5, 0xa4, 0x91, 0xb7, 0x7d, 0x4f, 0xee, 0xf0, 0x90, 0x6f, 0xb9, 0xe5, 0xfa, 0x45,
-	0x17, 0xb4, 0xeb, 0xc1, 0xd9, 0x70, 0x74, 0x05, 0x45, 0x01, 0x3b, 0xa9, 0x0d, 0xed, 0xb1, 0xd0,
-	0xd1, 0xd6, 0x38, 0x53, 0x55, 0xe6, 0xb4, 0x7f, 0x49, 0x55, 0x99, 0x93, 0x09, 0xee, 0x5a, 0xc9,
-	0xd7, 0x86, 0x0e, 0x58, 0x98, 0x44, 0x69, 0x2d, 0xc8, 0x14, 0x0f, 0x0a, 0xc8, 0xb8, 0x55, 0x50,
-	0xd2, 0xa1, 0xff, 0x5d, 0xab, 0xdd, 0xe6, 0x3b, 0xb9, 0x34, 0xca, 0x4a, 0x8a, 0xeb, 0xcd, 0x1b,
-	0xe9, 0xa6, 0xb6, 0x52, 0xab, 0x95, 0x92, 0x82, 0x8e, 0x18, 0x4a, 0x06, 0x69, 0xab, 0x09, 0xc3,
-	0x23, 0x21, 0x4d, 0xa6, 0x55, 0xe5, 0x3f, 0x7a, 0xe5, 0x27, 0x2f, 0x2d, 0xb7, 0xa7, 0x7f, 0x2c,
-	0x29, 0x9e, 0x2c, 0xbd, 0x66, 0x28, 0x09, 0xd3, 0xb3, 0xe1, 0xe8, 0xa6, 0x12, 0x0d, 0x1d, 0xd7,
-	0xb4, 0x35, 0x9e, 0x17, 0x5f, 0x87, 0x18, 0xed, 0x0f, 0x31, 0xfa, 0x39, 0xc4, 0xe8, 0xf3, 0x18,
-	0x77, 0xf6, 0xc7, 0xb8, 0xf3, 0x7d, 0x8c, 0x3b, 0xaf, 0x0f, 0xb9, 0xb2, 0x6f, 0x9b, 0xe5, 0x2c,
-	0x83, 0xf5, 0xfc, 0x74, 0xe6, 0x53, 0x7d, 0x6f, 0x3b, 0xfb, 0x51, 0x49, 0xb3, 0xec, 0xf9, 0x63,
-	0x3e, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x40, 0x3b, 0x47, 0xeb, 0x10, 0x02, 0x00, 0x00,
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4f, 0x6e, 0xea, 0x30,
+	0x10, 0x87, 0x31, 0x09, 0xff, 0xcc, 0x7b, 0x3c, 0x3d, 0x0b, 0xa9, 0x23, 0x54, 0x45, 0x51, 0x17,
+	0x55, 0xd4, 0x05, 0x2c, 0x7a, 0x82, 0xb6, 0x9c, 0x20, 0x52, 0x37, 0xdd, 0x99, 0xd8, 0xa4, 0x96,
+	0x42, 0x26, 0x72, 0x0c, 0xb4, 0xb7, 0xe8, 0x25, 0x7a, 0x97, 0x2e, 0x59, 0x76, 0x59, 0xc1, 0x45,
+	0x2a, 0x3b, 0x21, 0x20, 0x56, 0x9e, 0xdf, 0xf7, 0x8d, 0x93, 0x89, 0x63, 0xfa, 0x3f, 0x55, 0x06,
+	0x0b, 0xc5, 0x67, 0x82, 0xe3, 0xb4, 0xd0, 0x68, 0x90, 0x5d, 0xd5, 0x68, 0x7a, 0xb1, 0x4e, 0xc6,
+	0x29, 0xa6, 0xe8, 0x7a, 0x66, 0xb6, 0xaa, 0xda, 0x6f, 0x3e, 0x3d, 0xea, 0xcd, 0x39, 0x32, 0xa0,
+	0xbd, 0x44, 0x4b, 0x6e, 0x50, 0x03, 0x09, 0x49, 0x34, 0x88, 0x8f, 0x91, 0x8d, 0x68, 0x5b, 0x09,
+	0x68, 0x87, 0x24, 0xf2, 0xe3, 0xb6, 0x12, 0xb6, 0x93, 0x0b, 0xa1, 0x65, 0x59, 0x82, 0x57, 0x75,
+	0xd6, 0x91, 0x31, 0xea, 0xe7, 0x7c, 0x25, 0xc1, 0x77, 0xd8, 0xd5, 0xec, 0x9a, 0x0e, 0xf8, 0x86,
+	0x1b, 0xae, 0x9f, 0x75, 0x06, 0x1d, 0x27, 0x4e, 0xc0, 0xda, 0x25, 0x66, 0x19, 0x6e, 0xa5, 0x2e,
+	0xa1, 0x1b, 0x7a, 0xd6, 0x36, 0xe0, 0x64, 0x55, 0x9e, 0x42, 0xef, 0xdc, 0xaa, 0x3c, 0x65, 0x63,
+	0xda, 0x31, 0x92, 0xaf, 0x4a, 0xe8, 0x87, 0x5e, 0xe4, 0xc7, 0x55, 0x60, 0x13, 0xda, 0xcf, 0x30,
+	0xe1, 0x46, 0x61, 0x0e, 0x03, 0xf7, 0xba, 0x26, 0xdb, 0xc9, 0xb7, 0x72, 0x51, 0x2a, 0x23, 0x81,
+	0x56, 0x93, 0xd7, 0xd1, 0xee, 0xda, 0x48, 0xad, 0x96, 0x4a, 0x0a, 0x18, 0x86, 0x24, 0xea, 0xc7,
+	0x4d, 0x66, 0x21, 0x1d, 0x0a, 0x59, 0x26, 0x5a, 0x15, 0xee, 0xa1, 0x7f, 0xdc, 0xce, 0x73, 0x64,
+	0xe7, 0x74, 0x87, 0x25, 0xc5, 0x83, 0x81, 0xbf, 0x21, 0x89, 0xbc, 0xf8, 0x04, 0xac, 0x5d, 0x17,
+	0xa2, 0xb6, 0xa3, 0xca, 0x36, 0x80, 0xdd, 0xd2, 0x51, 0xf3, 0xc1, 0x4f, 0xb8, 0xce, 0x0d, 0xfc,
+	0x73, 0x27, 0x7d, 0x41, 0x1f, 0xe7, 0x5f, 0xfb, 0x80, 0xec, 0xf6, 0x01, 0xf9, 0xd9, 0x07, 0xe4,
+	0xe3, 0x10, 0xb4, 0x76, 0x87, 0xa0, 0xf5, 0x7d, 0x08, 0x5a, 0x2f, 0x77, 0xa9, 0x32, 0xaf, 0xeb,
+	0xc5, 0x34, 0xc1, 0xd5, 0xec, 0x78, 0x1d, 0x8e, 0xeb, 0x5b, 0x53, 0x99, 0xf7, 0x42, 0x96, 0x8b,
+	0xae, 0xfb, 0xe9, 0xf7, 0xbf, 0x03, 0x00, 0x69, 0xef, 0xa4, 0x2f, 0x38, 0x02, 0x00, 0x00,
 }
 
 func (m *Dao) Marshal() (dAtA []byte, err error) {
@@ -222,6 +231,11 @@ func (m *Dao) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FollowersCount != 0 {
+		i = encodeVarintDao(dAtA, i, uint64(m.FollowersCount))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.UpdatedAt != 0 {
 		i = encodeVarintDao(dAtA, i, uint64(m.UpdatedAt))
 		i--
@@ -411,6 +425,9 @@ func (m *Dao) Size() (n int) {
 	if m.UpdatedAt != 0 {
 		n += 1 + sovDao(uint64(m.UpdatedAt))
 	}
+	if m.FollowersCount != 0 {
+		n += 1 + sovDao(uint64(m.FollowersCount))
+	}
 	return n
 }
 
@@ -890,6 +907,25 @@ func (m *Dao) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowersCount", wireType)
+			}
+			m.FollowersCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDao
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FollowersCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDao(dAtA[iNdEx:])
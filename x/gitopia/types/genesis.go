@@ -17,20 +17,30 @@ func DefaultGenesis() *GenesisState {
 		ExercisedAmountList: []ExercisedAmount{},
 		BountyList:          []Bounty{},
 		// this line is used by starport scaffolding # genesis/types/default
-		TaskList:              []Task{},
-		BranchList:            []Branch{},
-		TagList:               []Tag{},
-		MemberList:            []Member{},
-		ReleaseList:           []Release{},
-		PullRequestList:       []PullRequest{},
-		DaoList:               []Dao{},
-		CommentList:           []Comment{},
-		IssueList:             []Issue{},
-		RepositoryList:        []Repository{},
-		BaseRepositoryKeyList: []BaseRepositoryKey{},
-		UserList:              []User{},
-		UserDaoList:           []UserDao{},
-		WhoisList:             []Whois{},
+		TaskList:                 []Task{},
+		BranchList:               []Branch{},
+		CommitStatusList:         []CommitStatus{},
+		TagList:                  []Tag{},
+		MemberList:               []Member{},
+		ReleaseList:              []Release{},
+		PullRequestList:          []PullRequest{},
+		PullRequestReviewList:    []PullRequestReview{},
+		PullRequestAutoMergeList: []PullRequestAutoMerge{},
+		MergeQueueList:           []MergeQueue{},
+		RepositoryStarList:       []RepositoryStar{},
+		RepositoryWatchList:      []RepositoryWatch{},
+		FollowList:               []Follow{},
+		CommentRevisionList:      []CommentRevision{},
+		NotificationList:         []Notification{},
+		BountyFunderList:         []BountyFunder{},
+		DaoList:                  []Dao{},
+		CommentList:              []Comment{},
+		IssueList:                []Issue{},
+		RepositoryList:           []Repository{},
+		BaseRepositoryKeyList:    []BaseRepositoryKey{},
+		UserList:                 []User{},
+		UserDaoList:              []UserDao{},
+		WhoisList:                []Whois{},
 	}
 }
 
@@ -147,6 +157,112 @@ func (gs GenesisState) Validate() error {
 		}
 		pullRequestIdMap[elem.Id] = true
 	}
+	// Check for duplicated ID in commitStatus
+	commitStatusIdMap := make(map[uint64]bool)
+	commitStatusCount := gs.GetCommitStatusCount()
+
+	for _, elem := range gs.CommitStatusList {
+		if _, ok := commitStatusIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for commitStatus")
+		}
+		if elem.Id >= commitStatusCount {
+			return fmt.Errorf("commitStatus id should be lower or equal than the last id")
+		}
+		commitStatusIdMap[elem.Id] = true
+	}
+	// Check for duplicated ID in pullRequestReview
+	pullRequestReviewIdMap := make(map[uint64]bool)
+	pullRequestReviewCount := gs.GetPullRequestReviewCount()
+
+	for _, elem := range gs.PullRequestReviewList {
+		if _, ok := pullRequestReviewIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for pullRequestReview")
+		}
+		if elem.Id >= pullRequestReviewCount {
+			return fmt.Errorf("pullRequestReview id should be lower or equal than the last id")
+		}
+		pullRequestReviewIdMap[elem.Id] = true
+	}
+	// Check for duplicated pull request in pullRequestAutoMerge
+	pullRequestAutoMergeMap := make(map[string]bool)
+
+	for _, elem := range gs.PullRequestAutoMergeList {
+		key := fmt.Sprintf("%d/%d", elem.RepositoryId, elem.PullRequestIid)
+		if _, ok := pullRequestAutoMergeMap[key]; ok {
+			return fmt.Errorf("duplicated pull request for pullRequestAutoMerge")
+		}
+		pullRequestAutoMergeMap[key] = true
+	}
+	// Check for duplicated branch in mergeQueue
+	mergeQueueMap := make(map[string]bool)
+
+	for _, elem := range gs.MergeQueueList {
+		key := fmt.Sprintf("%d/%s", elem.RepositoryId, elem.Branch)
+		if _, ok := mergeQueueMap[key]; ok {
+			return fmt.Errorf("duplicated branch for mergeQueue")
+		}
+		mergeQueueMap[key] = true
+	}
+	// Check for duplicated address in repositoryStar
+	repositoryStarMap := make(map[string]bool)
+
+	for _, elem := range gs.RepositoryStarList {
+		key := fmt.Sprintf("%d/%s", elem.RepositoryId, elem.Address)
+		if _, ok := repositoryStarMap[key]; ok {
+			return fmt.Errorf("duplicated address for repositoryStar")
+		}
+		repositoryStarMap[key] = true
+	}
+	// Check for duplicated address in repositoryWatch
+	repositoryWatchMap := make(map[string]bool)
+
+	for _, elem := range gs.RepositoryWatchList {
+		key := fmt.Sprintf("%d/%s", elem.RepositoryId, elem.Address)
+		if _, ok := repositoryWatchMap[key]; ok {
+			return fmt.Errorf("duplicated address for repositoryWatch")
+		}
+		repositoryWatchMap[key] = true
+	}
+	// Check for duplicated follow
+	followMap := make(map[string]bool)
+
+	for _, elem := range gs.FollowList {
+		key := elem.Follower + "/" + elem.Following
+		if _, ok := followMap[key]; ok {
+			return fmt.Errorf("duplicated follow")
+		}
+		followMap[key] = true
+	}
+	// Check for duplicated commentRevision
+	commentRevisionMap := make(map[string]bool)
+
+	for _, elem := range gs.CommentRevisionList {
+		key := fmt.Sprintf("%d/%s/%d/%d/%d", elem.RepositoryId, elem.Parent.String(), elem.ParentIid, elem.CommentIid, elem.Revision)
+		if _, ok := commentRevisionMap[key]; ok {
+			return fmt.Errorf("duplicated revision for commentRevision")
+		}
+		commentRevisionMap[key] = true
+	}
+	// Check for duplicated ID in notification
+	notificationMap := make(map[string]bool)
+
+	for _, elem := range gs.NotificationList {
+		key := fmt.Sprintf("%s/%d", elem.Address, elem.Id)
+		if _, ok := notificationMap[key]; ok {
+			return fmt.Errorf("duplicated id for notification")
+		}
+		notificationMap[key] = true
+	}
+	// Check for duplicated address in bountyFunder
+	bountyFunderMap := make(map[string]bool)
+
+	for _, elem := range gs.BountyFunderList {
+		key := fmt.Sprintf("%d/%s", elem.BountyId, elem.Address)
+		if _, ok := bountyFunderMap[key]; ok {
+			return fmt.Errorf("duplicated address for bountyFunder")
+		}
+		bountyFunderMap[key] = true
+	}
 	// Check for duplicated ID in dao
 	daoIdMap := make(map[uint64]bool)
 	daoCount := gs.GetDaoCount()
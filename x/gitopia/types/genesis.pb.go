@@ -25,8 +25,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the gitopia module's genesis state.
 type GenesisState struct {
-	ExercisedAmountList  []ExercisedAmount `protobuf:"bytes,30,rep,name=exercisedAmountList,proto3" json:"exercisedAmountList"`
-	ExercisedAmountCount uint64            `protobuf:"varint,31,opt,name=exercisedAmountCount,proto3" json:"exercisedAmountCount,omitempty"`
+	BountyFunderList         []BountyFunder         `protobuf:"bytes,43,rep,name=bountyFunderList,proto3" json:"bountyFunderList"`
+	NotificationList         []Notification         `protobuf:"bytes,42,rep,name=notificationList,proto3" json:"notificationList"`
+	CommentRevisionList      []CommentRevision      `protobuf:"bytes,41,rep,name=commentRevisionList,proto3" json:"commentRevisionList"`
+	FollowList               []Follow               `protobuf:"bytes,40,rep,name=followList,proto3" json:"followList"`
+	RepositoryWatchList      []RepositoryWatch      `protobuf:"bytes,39,rep,name=repositoryWatchList,proto3" json:"repositoryWatchList"`
+	RepositoryStarList       []RepositoryStar       `protobuf:"bytes,38,rep,name=repositoryStarList,proto3" json:"repositoryStarList"`
+	MergeQueueList           []MergeQueue           `protobuf:"bytes,37,rep,name=mergeQueueList,proto3" json:"mergeQueueList"`
+	PullRequestAutoMergeList []PullRequestAutoMerge `protobuf:"bytes,36,rep,name=pullRequestAutoMergeList,proto3" json:"pullRequestAutoMergeList"`
+	PullRequestReviewList    []PullRequestReview    `protobuf:"bytes,32,rep,name=pullRequestReviewList,proto3" json:"pullRequestReviewList"`
+	PullRequestReviewCount   uint64                 `protobuf:"varint,33,opt,name=pullRequestReviewCount,proto3" json:"pullRequestReviewCount,omitempty"`
+	CommitStatusList         []CommitStatus         `protobuf:"bytes,34,rep,name=commitStatusList,proto3" json:"commitStatusList"`
+	CommitStatusCount        uint64                 `protobuf:"varint,35,opt,name=commitStatusCount,proto3" json:"commitStatusCount,omitempty"`
+	ExercisedAmountList      []ExercisedAmount      `protobuf:"bytes,30,rep,name=exercisedAmountList,proto3" json:"exercisedAmountList"`
+	ExercisedAmountCount     uint64                 `protobuf:"varint,31,opt,name=exercisedAmountCount,proto3" json:"exercisedAmountCount,omitempty"`
 	// params defines all the paramaters of the module.
 	Params                Params              `protobuf:"bytes,29,opt,name=params,proto3" json:"params"`
 	BountyList            []Bounty            `protobuf:"bytes,27,rep,name=bountyList,proto3" json:"bountyList"`
@@ -93,6 +105,90 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetBountyFunderList() []BountyFunder {
+	if m != nil {
+		return m.BountyFunderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNotificationList() []Notification {
+	if m != nil {
+		return m.NotificationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommentRevisionList() []CommentRevision {
+	if m != nil {
+		return m.CommentRevisionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFollowList() []Follow {
+	if m != nil {
+		return m.FollowList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRepositoryWatchList() []RepositoryWatch {
+	if m != nil {
+		return m.RepositoryWatchList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRepositoryStarList() []RepositoryStar {
+	if m != nil {
+		return m.RepositoryStarList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMergeQueueList() []MergeQueue {
+	if m != nil {
+		return m.MergeQueueList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPullRequestAutoMergeList() []PullRequestAutoMerge {
+	if m != nil {
+		return m.PullRequestAutoMergeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPullRequestReviewList() []PullRequestReview {
+	if m != nil {
+		return m.PullRequestReviewList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPullRequestReviewCount() uint64 {
+	if m != nil {
+		return m.PullRequestReviewCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCommitStatusList() []CommitStatus {
+	if m != nil {
+		return m.CommitStatusList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommitStatusCount() uint64 {
+	if m != nil {
+		return m.CommitStatusCount
+	}
+	return 0
+}
+
 func (m *GenesisState) GetExercisedAmountList() []ExercisedAmount {
 	if m != nil {
 		return m.ExercisedAmountList
@@ -317,55 +413,73 @@ func init() {
 func init() { proto.RegisterFile("gitopia/genesis.proto", fileDescriptor_fe28ed7a80acf9ab) }
 
 var fileDescriptor_fe28ed7a80acf9ab = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0xdd, 0x4e, 0x13, 0x41,
-	0x14, 0xc7, 0x5b, 0x41, 0x3e, 0xa6, 0x7c, 0x0e, 0x20, 0xa5, 0xc2, 0xd2, 0xa0, 0x17, 0x0d, 0x17,
-	0x25, 0xc1, 0x5b, 0x8d, 0xb1, 0x40, 0xd4, 0xa8, 0x89, 0x56, 0x8c, 0x89, 0x37, 0x3a, 0x6d, 0xc7,
-	0x65, 0x03, 0xdb, 0xa9, 0x3b, 0xb3, 0x11, 0xde, 0xc2, 0xc7, 0xe2, 0x92, 0x4b, 0xaf, 0x8c, 0x81,
-	0xf8, 0x1e, 0x66, 0xce, 0x99, 0x8f, 0x65, 0x61, 0xd9, 0x1b, 0x3a, 0xf3, 0xdf, 0x73, 0xce, 0x6f,
-	0xe6, 0x3f, 0x67, 0x67, 0x21, 0x2b, 0x61, 0xa4, 0xc4, 0x28, 0x62, 0x3b, 0x21, 0x1f, 0x72, 0x19,
-	0xc9, 0xf6, 0x28, 0x11, 0x4a, 0xd0, 0x55, 0x23, 0xb7, 0x73, 0xbf, 0x0d, 0x6a, 0xe3, 0x15, 0x93,
-	0xc7, 0x18, 0xdc, 0x58, 0xb6, 0x5a, 0x2f, 0x61, 0xc3, 0xfe, 0x91, 0x51, 0x17, 0x7d, 0x64, 0x98,
-	0x0f, 0x8c, 0x79, 0xdc, 0xe3, 0xc9, 0x8d, 0x74, 0x91, 0x0e, 0xd5, 0x99, 0x53, 0x45, 0x28, 0x60,
-	0xb8, 0xa3, 0x47, 0x46, 0x75, 0xcb, 0x4d, 0xf8, 0x09, 0x67, 0x92, 0x1b, 0x79, 0xcd, 0xca, 0xa3,
-	0xf4, 0xe4, 0xa4, 0xcb, 0x7f, 0xa4, 0x5c, 0xaa, 0xfc, 0x32, 0x06, 0xec, 0x46, 0x91, 0xbe, 0x88,
-	0x63, 0x3e, 0xb4, 0x91, 0x4b, 0x56, 0x8e, 0xa4, 0x4c, 0x6d, 0xe5, 0xba, 0x07, 0x8e, 0x84, 0x8c,
-	0x94, 0x48, 0xec, 0x02, 0x9d, 0x13, 0xa9, 0x74, 0x5b, 0x71, 0x25, 0x7e, 0x1e, 0x09, 0xeb, 0xa5,
-	0xdf, 0xdf, 0x88, 0x25, 0x2c, 0xb6, 0x6a, 0x60, 0x55, 0x7e, 0xca, 0x93, 0x7e, 0x24, 0xf9, 0xe0,
-	0x2b, 0x8b, 0xb5, 0x01, 0xf8, 0x7c, 0xeb, 0xdf, 0x2c, 0x99, 0x79, 0x89, 0x67, 0xf2, 0x51, 0x31,
-	0xc5, 0xe9, 0x37, 0xb2, 0xe4, 0x42, 0x5f, 0x40, 0xe4, 0xdb, 0x48, 0xaa, 0x7a, 0xd0, 0x1c, 0x6b,
-	0xd5, 0x76, 0x5b, 0xed, 0x82, 0x03, 0x6b, 0x1f, 0x5c, 0xcf, 0xe9, 0x8c, 0x9f, 0xff, 0xd9, 0xac,
-	0x74, 0x6f, 0x2b, 0x45, 0x77, 0xc9, 0x72, 0x4e, 0xde, 0xd3, 0x7f, 0xea, 0x9b, 0xcd, 0x6a, 0x6b,
-	0xbc, 0x7b, 0xeb, 0x33, 0xfa, 0x8c, 0x4c, 0xe0, 0xb6, 0xea, 0x1b, 0xcd, 0x6a, 0xab, 0xb6, 0xbb,
-	0x59, 0xb8, 0x90, 0xf7, 0x10, 0x66, 0xf8, 0x26, 0x89, 0x1e, 0x10, 0x82, 0xa7, 0x0e, 0x7b, 0x79,
-	0x08, 0x7b, 0x29, 0x2e, 0xd1, 0x81, 0x50, 0x53, 0x22, 0x93, 0x48, 0x9b, 0xa4, 0x86, 0x33, 0x5c,
-	0xf0, 0x3a, 0x2c, 0x38, 0x2b, 0xd1, 0x57, 0xa4, 0xa6, 0xcf, 0x69, 0x9f, 0x09, 0x20, 0xad, 0x01,
-	0xa9, 0x59, 0x48, 0xfa, 0x84, 0xb1, 0x06, 0x95, 0x4d, 0xa5, 0xdf, 0xc9, 0x4a, 0x8f, 0x49, 0xde,
-	0x75, 0xfd, 0xf0, 0x86, 0xe3, 0xea, 0x1b, 0x50, 0x73, 0xbb, 0x78, 0xf5, 0xf9, 0x2c, 0x53, 0xfd,
-	0xf6, 0x72, 0xda, 0x1a, 0x7c, 0x4d, 0xa0, 0xf8, 0x6a, 0x89, 0x35, 0xef, 0x20, 0xd4, 0x5a, 0xe3,
-	0x13, 0xb5, 0x35, 0x38, 0x43, 0x6b, 0xea, 0x68, 0x4d, 0x46, 0xa2, 0x4f, 0xc9, 0xa4, 0x62, 0x21,
-	0x50, 0x56, 0x80, 0xb2, 0x5e, 0x48, 0x39, 0x64, 0xa1, 0x41, 0xd8, 0x14, 0xda, 0x20, 0x53, 0x8a,
-	0x85, 0x58, 0xfc, 0x01, 0x14, 0x77, 0x73, 0x38, 0x5d, 0xb8, 0x12, 0xa0, 0xf8, 0x52, 0xd9, 0xe9,
-	0x42, 0xa8, 0x3b, 0x5d, 0x97, 0x08, 0xa7, 0x0b, 0x33, 0xa4, 0x2c, 0x9b, 0xd3, 0xf5, 0x12, 0x7d,
-	0xae, 0x17, 0x21, 0x8f, 0x01, 0xb3, 0x08, 0x98, 0x8d, 0x3b, 0xf6, 0x20, 0x8f, 0x0d, 0xc4, 0x25,
-	0xd1, 0x75, 0x32, 0xad, 0xc7, 0x08, 0xa0, 0x00, 0xf0, 0x82, 0x6e, 0x1e, 0x73, 0xdf, 0x00, 0x61,
-	0xbe, 0xa4, 0x79, 0xba, 0x18, 0x6b, 0x9b, 0x27, 0x93, 0x4a, 0xb7, 0xc8, 0x8c, 0x99, 0x22, 0x6a,
-	0x01, 0x50, 0xd7, 0x34, 0x7a, 0x48, 0xe6, 0x33, 0xd7, 0x18, 0x10, 0x67, 0x81, 0xf8, 0xb8, 0xf8,
-	0xdd, 0xf2, 0xf1, 0x86, 0x9a, 0x2f, 0x41, 0xb7, 0xc9, 0x42, 0x46, 0x42, 0xfa, 0x1c, 0xd0, 0x6f,
-	0xe8, 0xba, 0x23, 0x06, 0xe6, 0x45, 0xa9, 0x95, 0x74, 0x84, 0x7f, 0x49, 0x6c, 0x8a, 0xee, 0x88,
-	0x01, 0x13, 0x48, 0x98, 0xc1, 0x8e, 0xb0, 0x73, 0xed, 0xa4, 0xb9, 0x74, 0xa1, 0xfa, 0x74, 0x89,
-	0x93, 0x7b, 0x18, 0x6b, 0x9d, 0xcc, 0xa4, 0x6a, 0x27, 0xcd, 0x14, 0x49, 0x04, 0x9d, 0xcc, 0x6a,
-	0xb4, 0x43, 0xa6, 0xe1, 0x2e, 0x07, 0xd6, 0x24, 0xb0, 0x82, 0x42, 0xd6, 0x6b, 0x1d, 0x69, 0x48,
-	0x3e, 0x8d, 0x06, 0x84, 0xc0, 0x04, 0x29, 0x53, 0x40, 0xc9, 0x28, 0xf4, 0x03, 0x99, 0xf3, 0x9f,
-	0x06, 0x00, 0xdd, 0x07, 0xd0, 0xa3, 0x3b, 0xda, 0xc3, 0x86, 0x1b, 0x5a, 0xae, 0x00, 0x6d, 0x91,
-	0x79, 0xaf, 0x20, 0x77, 0x02, 0xb8, 0x79, 0x59, 0xf7, 0xbd, 0xbe, 0x9a, 0x00, 0x3b, 0x56, 0xd2,
-	0xf7, 0xfa, 0x4a, 0xb3, 0x7d, 0x6f, 0x93, 0x74, 0xdf, 0xeb, 0x31, 0x42, 0xc6, 0xb1, 0xef, 0x9d,
-	0xa0, 0xfd, 0x83, 0x0f, 0x19, 0xd4, 0xaf, 0x96, 0xf8, 0xf7, 0x59, 0x47, 0x5a, 0xff, 0x5c, 0x9a,
-	0xf6, 0x0f, 0x26, 0x88, 0xb8, 0x87, 0xfe, 0x79, 0xa5, 0xb3, 0x7f, 0x7e, 0x19, 0x54, 0x2f, 0x2e,
-	0x83, 0xea, 0xdf, 0xcb, 0xa0, 0xfa, 0xeb, 0x2a, 0xa8, 0x5c, 0x5c, 0x05, 0x95, 0xdf, 0x57, 0x41,
-	0xe5, 0xcb, 0x76, 0x18, 0xa9, 0xa3, 0xb4, 0xd7, 0xee, 0x8b, 0x78, 0xc7, 0xfd, 0x97, 0x62, 0x7e,
-	0x4f, 0xdd, 0x48, 0x9d, 0x8d, 0xb8, 0xec, 0x4d, 0xc0, 0x47, 0xf3, 0xc9, 0xff, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x72, 0x6e, 0xdb, 0x31, 0xcf, 0x08, 0x00, 0x00,
+	// 1051 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x51, 0x6f, 0x1b, 0x45,
+	0x10, 0x8e, 0x69, 0x48, 0x9b, 0x4d, 0x68, 0x92, 0x4d, 0xd2, 0xba, 0x6e, 0x7a, 0x31, 0x69, 0x4b,
+	0x4d, 0x00, 0x47, 0x2a, 0x12, 0x4f, 0x20, 0x54, 0xa7, 0x2d, 0x20, 0x28, 0x6a, 0x9d, 0xa2, 0x48,
+	0x48, 0x28, 0xac, 0xed, 0xcd, 0xe5, 0x14, 0xdb, 0xeb, 0xde, 0xee, 0xd1, 0xf6, 0x5f, 0xf0, 0xb3,
+	0xfa, 0xd8, 0x47, 0x9e, 0x10, 0x4a, 0x5e, 0xf9, 0x11, 0xd5, 0xcc, 0xec, 0xde, 0xad, 0xcf, 0x77,
+	0xb9, 0x3c, 0xf9, 0xf6, 0xdb, 0xf9, 0xbe, 0x6f, 0x6e, 0x76, 0x76, 0xf7, 0xcc, 0x36, 0xc3, 0xc8,
+	0xa8, 0x49, 0x24, 0xf6, 0x42, 0x39, 0x96, 0x3a, 0xd2, 0xed, 0x49, 0xac, 0x8c, 0xe2, 0x37, 0x2d,
+	0xdc, 0xce, 0xfd, 0x36, 0xb8, 0x8b, 0x37, 0x42, 0x9f, 0x52, 0x70, 0x63, 0xc3, 0x61, 0xbd, 0x58,
+	0x8c, 0xfb, 0x27, 0x16, 0x5d, 0xcb, 0x22, 0xc3, 0x7c, 0xe0, 0x48, 0x8e, 0x7a, 0x32, 0x9e, 0xa1,
+	0xab, 0x64, 0x6c, 0xde, 0xa6, 0xa8, 0x0a, 0x15, 0x3e, 0xee, 0xc1, 0x93, 0x45, 0xd3, 0x74, 0x63,
+	0x39, 0x94, 0x42, 0x4b, 0x0b, 0xdf, 0x72, 0xf0, 0x24, 0x19, 0x0e, 0xbb, 0xf2, 0x55, 0x22, 0xb5,
+	0xc9, 0xa7, 0x31, 0x10, 0x33, 0x22, 0x7d, 0x35, 0x1a, 0xc9, 0xb1, 0x8b, 0x5c, 0x77, 0x70, 0xa4,
+	0x75, 0xe2, 0x94, 0xeb, 0x99, 0xe1, 0x44, 0xe9, 0xc8, 0xa8, 0xd8, 0x25, 0x98, 0x56, 0x22, 0xd1,
+	0x32, 0xce, 0x4b, 0xbc, 0x3e, 0x51, 0x91, 0xce, 0xbf, 0xdf, 0x44, 0xc4, 0x62, 0xe4, 0xd0, 0xc0,
+	0xa1, 0xf2, 0x8d, 0x8c, 0xfb, 0x91, 0x96, 0x83, 0x23, 0x31, 0x82, 0x02, 0xd8, 0xf9, 0xdb, 0x7e,
+	0x92, 0x91, 0x39, 0xd2, 0x46, 0x98, 0x44, 0xe7, 0xdf, 0x77, 0x24, 0xe3, 0x50, 0x1e, 0xbd, 0x4a,
+	0x64, 0x22, 0xf3, 0x69, 0x69, 0x23, 0x66, 0xd3, 0x12, 0xa6, 0x7f, 0x92, 0x4f, 0xeb, 0x58, 0x0d,
+	0x87, 0xea, 0x75, 0x3e, 0x2d, 0x5b, 0x9b, 0xa3, 0x58, 0xfe, 0x15, 0xe9, 0x48, 0x8d, 0xed, 0x7c,
+	0xc3, 0xcd, 0x8f, 0x95, 0x89, 0x8e, 0xa3, 0xbe, 0x30, 0xe9, 0xdc, 0xce, 0xff, 0x9b, 0x6c, 0xf9,
+	0x07, 0x6a, 0xa3, 0x03, 0x23, 0x8c, 0xe4, 0x87, 0x6c, 0x95, 0xd6, 0xf4, 0x69, 0x32, 0x1e, 0xc8,
+	0xf8, 0x97, 0x48, 0x9b, 0xfa, 0x17, 0xcd, 0x2b, 0xad, 0xa5, 0x87, 0xf7, 0xdb, 0x25, 0x0d, 0xd6,
+	0xee, 0x78, 0x84, 0xce, 0xfc, 0xbb, 0x7f, 0xb7, 0xe7, 0xba, 0x33, 0x22, 0x20, 0xec, 0xfb, 0xa3,
+	0xf0, 0x6e, 0x85, 0xf0, 0xaf, 0x1e, 0xc1, 0x09, 0xe7, 0x45, 0xf8, 0x9f, 0x6c, 0xdd, 0xbe, 0x78,
+	0xd7, 0xbe, 0x37, 0x6a, 0x7f, 0x8e, 0xda, 0xad, 0x52, 0xed, 0xfd, 0x69, 0x8e, 0x95, 0x2f, 0x92,
+	0xe2, 0x4f, 0x18, 0xa3, 0x82, 0xa3, 0x70, 0x0b, 0x85, 0xb7, 0x4b, 0x85, 0x9f, 0x62, 0xa8, 0xd5,
+	0xf3, 0x88, 0x90, 0x68, 0xd6, 0x91, 0x87, 0xb0, 0xac, 0xa8, 0xf7, 0xa0, 0x22, 0xd1, 0xee, 0x34,
+	0xc7, 0x25, 0x5a, 0x20, 0xc5, 0xff, 0x60, 0x3c, 0x83, 0x0f, 0x8c, 0xa0, 0xe5, 0xfb, 0x0c, 0x0d,
+	0x1e, 0x5c, 0xc2, 0x00, 0x28, 0x56, 0xbf, 0x40, 0x88, 0xbf, 0x60, 0xd7, 0xb1, 0x79, 0x5f, 0x40,
+	0xef, 0xa2, 0xf4, 0x7d, 0x94, 0xbe, 0x5b, 0x2a, 0xfd, 0x2c, 0x0d, 0xb7, 0xb2, 0x39, 0x01, 0xae,
+	0x58, 0xdd, 0xdb, 0xff, 0x8f, 0x12, 0xa3, 0x90, 0x82, 0xe2, 0xf7, 0x50, 0xfc, 0xab, 0x52, 0xf1,
+	0xe7, 0x05, 0x44, 0x6b, 0x53, 0x2a, 0xca, 0x8f, 0xd9, 0xa6, 0x37, 0x07, 0xcb, 0x2c, 0x69, 0x59,
+	0x9b, 0xe8, 0xb6, 0x7b, 0x19, 0x37, 0x62, 0x59, 0xab, 0x62, 0x39, 0xfe, 0x0d, 0xbb, 0x31, 0x33,
+	0xb1, 0x0f, 0x7b, 0xa2, 0xfe, 0x69, 0xb3, 0xd6, 0x9a, 0xef, 0x96, 0xcc, 0xc2, 0x36, 0xa1, 0xd3,
+	0xe3, 0x00, 0x0f, 0x0f, 0x4c, 0x6d, 0xa7, 0x62, 0x9b, 0xec, 0x7b, 0x04, 0xb7, 0x4d, 0xf2, 0x22,
+	0xfc, 0x4b, 0xb6, 0xe6, 0x63, 0x94, 0xcb, 0x5d, 0xcc, 0x65, 0x76, 0x02, 0x7a, 0x35, 0x3d, 0xe4,
+	0x1e, 0xe1, 0x19, 0x87, 0x99, 0x04, 0x15, 0xbd, 0xfa, 0x64, 0x9a, 0xe3, 0x7a, 0xb5, 0x40, 0x8a,
+	0x3f, 0x64, 0x1b, 0x39, 0x98, 0x52, 0xda, 0xc6, 0x94, 0x0a, 0xe7, 0xf8, 0x77, 0x6c, 0x81, 0x0e,
+	0xe4, 0xfa, 0x9d, 0x66, 0xed, 0xc2, 0x4d, 0xf8, 0x1c, 0xc3, 0xac, 0xbf, 0x25, 0xc1, 0x3e, 0xa6,
+	0x63, 0x09, 0xdf, 0xe5, 0x76, 0xc5, 0x3e, 0xa6, 0x53, 0xcd, 0xed, 0xe3, 0x8c, 0xc8, 0x9b, 0x6c,
+	0x89, 0x46, 0x94, 0xf0, 0x16, 0x26, 0xec, 0x43, 0xfc, 0x47, 0xb6, 0x04, 0x37, 0xcc, 0x63, 0xa1,
+	0xd0, 0xe9, 0x16, 0x3a, 0x35, 0x4b, 0x9d, 0x7e, 0xa3, 0x58, 0x6b, 0xe5, 0x53, 0xa1, 0x5d, 0x7b,
+	0x42, 0xcb, 0x6c, 0x8b, 0xfe, 0x2c, 0x29, 0xfb, 0x46, 0x45, 0xbb, 0x76, 0xf2, 0x2c, 0xd7, 0xae,
+	0x85, 0x72, 0x50, 0x1a, 0xba, 0xe0, 0x51, 0xfc, 0x66, 0x45, 0x69, 0x9e, 0x61, 0xa8, 0x2b, 0x4d,
+	0x46, 0x84, 0xd2, 0xd0, 0x88, 0x4a, 0x53, 0xa7, 0xd2, 0x78, 0x10, 0xff, 0x96, 0x5d, 0x35, 0x22,
+	0x44, 0x97, 0x4d, 0x74, 0xd9, 0x2a, 0x75, 0x79, 0x29, 0x42, 0x6b, 0xe1, 0x28, 0xbc, 0xc1, 0xae,
+	0x19, 0x11, 0x92, 0xf8, 0x0d, 0x14, 0x4f, 0xc7, 0xb8, 0xba, 0xf8, 0x31, 0x83, 0xe2, 0xeb, 0x55,
+	0xab, 0x8b, 0xa1, 0xe9, 0xea, 0xa6, 0x44, 0x5c, 0x5d, 0x1c, 0x91, 0xcb, 0x86, 0x5d, 0xdd, 0x0c,
+	0xe2, 0xdf, 0x43, 0x12, 0xfa, 0x14, 0x6d, 0xd6, 0xd0, 0xe6, 0xce, 0x05, 0xef, 0xa0, 0x4f, 0xad,
+	0x49, 0x4a, 0xe2, 0x5b, 0x6c, 0x11, 0x9e, 0xc9, 0x80, 0xa3, 0x41, 0x06, 0x40, 0xf3, 0xd8, 0x2f,
+	0x25, 0x74, 0x58, 0xa9, 0x68, 0x9e, 0x2e, 0xc5, 0xba, 0xe6, 0xf1, 0xa8, 0x7c, 0x87, 0x2d, 0xdb,
+	0x21, 0x59, 0xad, 0xa2, 0xd5, 0x14, 0xc6, 0x5f, 0xb2, 0x15, 0xef, 0x24, 0x42, 0xc7, 0x4f, 0xd0,
+	0xf1, 0xde, 0x65, 0x4e, 0x42, 0xeb, 0x9a, 0x97, 0xe0, 0xbb, 0x6c, 0xd5, 0x83, 0xc8, 0xfd, 0x3a,
+	0xba, 0xcf, 0xe0, 0xd0, 0x11, 0x03, 0xbb, 0x51, 0x96, 0x2a, 0x3a, 0x22, 0xdb, 0x24, 0x8e, 0x02,
+	0x1d, 0x31, 0x10, 0x8a, 0x1c, 0x96, 0xa9, 0x23, 0xdc, 0x18, 0x2a, 0x69, 0xaf, 0x73, 0x54, 0x5f,
+	0xac, 0xa8, 0xa4, 0xfd, 0x22, 0x70, 0x95, 0xf4, 0xa8, 0x50, 0x49, 0x3b, 0x24, 0x27, 0x46, 0x95,
+	0xf4, 0x31, 0xde, 0x61, 0x8b, 0xf8, 0x15, 0x8a, 0x5e, 0x57, 0xd1, 0x2b, 0x28, 0xf5, 0xfa, 0x09,
+	0x22, 0xad, 0x53, 0x46, 0xe3, 0x01, 0x63, 0x38, 0x20, 0x97, 0x6b, 0xe8, 0xe2, 0x21, 0x70, 0x03,
+	0x67, 0xf7, 0x32, 0x1a, 0x7d, 0x5c, 0x71, 0x03, 0x67, 0x5b, 0xdd, 0xdd, 0xc0, 0xd3, 0x02, 0xbc,
+	0xc5, 0x56, 0x32, 0x84, 0x7c, 0x17, 0xd0, 0x37, 0x0f, 0x43, 0xdf, 0xc3, 0xd1, 0x84, 0xb6, 0x57,
+	0x2a, 0xfa, 0x1e, 0x8e, 0x34, 0xd7, 0xf7, 0x8e, 0x04, 0x7d, 0x0f, 0xcf, 0x64, 0x32, 0x4f, 0x7d,
+	0x9f, 0x02, 0x50, 0x3f, 0xfc, 0x04, 0x47, 0xfd, 0x5a, 0x45, 0xfd, 0x0e, 0x21, 0xd2, 0xd5, 0x2f,
+	0xa5, 0x41, 0xfd, 0x70, 0x40, 0x16, 0x1f, 0x51, 0xfd, 0x32, 0xa4, 0xf3, 0xf8, 0xdd, 0x59, 0x50,
+	0x7b, 0x7f, 0x16, 0xd4, 0xfe, 0x3b, 0x0b, 0x6a, 0x7f, 0x9f, 0x07, 0x73, 0xef, 0xcf, 0x83, 0xb9,
+	0x7f, 0xce, 0x83, 0xb9, 0xdf, 0x77, 0xc3, 0xc8, 0x9c, 0x24, 0xbd, 0x76, 0x5f, 0x8d, 0xf6, 0xd2,
+	0xff, 0x57, 0xf6, 0xf7, 0x4d, 0xfa, 0x64, 0xde, 0x4e, 0xa4, 0xee, 0x2d, 0xe0, 0xb7, 0xf3, 0xd7,
+	0x1f, 0x06, 0x00, 0xda, 0xbd, 0x26, 0xa2, 0x89, 0x0d, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -388,6 +502,180 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BountyFunderList) > 0 {
+		for iNdEx := len(m.BountyFunderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BountyFunderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.NotificationList) > 0 {
+		for iNdEx := len(m.NotificationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NotificationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.CommentRevisionList) > 0 {
+		for iNdEx := len(m.CommentRevisionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommentRevisionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.FollowList) > 0 {
+		for iNdEx := len(m.FollowList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FollowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.RepositoryWatchList) > 0 {
+		for iNdEx := len(m.RepositoryWatchList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RepositoryWatchList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.RepositoryStarList) > 0 {
+		for iNdEx := len(m.RepositoryStarList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RepositoryStarList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.MergeQueueList) > 0 {
+		for iNdEx := len(m.MergeQueueList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MergeQueueList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.PullRequestAutoMergeList) > 0 {
+		for iNdEx := len(m.PullRequestAutoMergeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PullRequestAutoMergeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.CommitStatusCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CommitStatusCount))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.CommitStatusList) > 0 {
+		for iNdEx := len(m.CommitStatusList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitStatusList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.PullRequestReviewCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PullRequestReviewCount))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.PullRequestReviewList) > 0 {
+		for iNdEx := len(m.PullRequestReviewList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PullRequestReviewList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if m.ExercisedAmountCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ExercisedAmountCount))
 		i--
@@ -884,6 +1172,72 @@ func (m *GenesisState) Size() (n int) {
 	if m.ExercisedAmountCount != 0 {
 		n += 2 + sovGenesis(uint64(m.ExercisedAmountCount))
 	}
+	if len(m.PullRequestReviewList) > 0 {
+		for _, e := range m.PullRequestReviewList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PullRequestReviewCount != 0 {
+		n += 2 + sovGenesis(uint64(m.PullRequestReviewCount))
+	}
+	if len(m.CommitStatusList) > 0 {
+		for _, e := range m.CommitStatusList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CommitStatusCount != 0 {
+		n += 2 + sovGenesis(uint64(m.CommitStatusCount))
+	}
+	if len(m.PullRequestAutoMergeList) > 0 {
+		for _, e := range m.PullRequestAutoMergeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MergeQueueList) > 0 {
+		for _, e := range m.MergeQueueList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RepositoryStarList) > 0 {
+		for _, e := range m.RepositoryStarList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RepositoryWatchList) > 0 {
+		for _, e := range m.RepositoryWatchList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FollowList) > 0 {
+		for _, e := range m.FollowList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommentRevisionList) > 0 {
+		for _, e := range m.CommentRevisionList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NotificationList) > 0 {
+		for _, e := range m.NotificationList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BountyFunderList) > 0 {
+		for _, e := range m.BountyFunderList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1765,6 +2119,384 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestReviewList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullRequestReviewList = append(m.PullRequestReviewList, PullRequestReview{})
+			if err := m.PullRequestReviewList[len(m.PullRequestReviewList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestReviewCount", wireType)
+			}
+			m.PullRequestReviewCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PullRequestReviewCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitStatusList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitStatusList = append(m.CommitStatusList, CommitStatus{})
+			if err := m.CommitStatusList[len(m.CommitStatusList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitStatusCount", wireType)
+			}
+			m.CommitStatusCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitStatusCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestAutoMergeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullRequestAutoMergeList = append(m.PullRequestAutoMergeList, PullRequestAutoMerge{})
+			if err := m.PullRequestAutoMergeList[len(m.PullRequestAutoMergeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeQueueList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeQueueList = append(m.MergeQueueList, MergeQueue{})
+			if err := m.MergeQueueList[len(m.MergeQueueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryStarList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepositoryStarList = append(m.RepositoryStarList, RepositoryStar{})
+			if err := m.RepositoryStarList[len(m.RepositoryStarList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryWatchList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepositoryWatchList = append(m.RepositoryWatchList, RepositoryWatch{})
+			if err := m.RepositoryWatchList[len(m.RepositoryWatchList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FollowList = append(m.FollowList, Follow{})
+			if err := m.FollowList[len(m.FollowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentRevisionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommentRevisionList = append(m.CommentRevisionList, CommentRevision{})
+			if err := m.CommentRevisionList[len(m.CommentRevisionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotificationList = append(m.NotificationList, Notification{})
+			if err := m.NotificationList[len(m.NotificationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BountyFunderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BountyFunderList = append(m.BountyFunderList, BountyFunder{})
+			if err := m.BountyFunderList[len(m.BountyFunderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -125,6 +125,103 @@ func TestGenesisState_Validate(t *testing.T) {
 				},
 				PullRequestCount: 2,
 
+				PullRequestReviewList: []types.PullRequestReview{
+					{
+						Creator: sample.AccAddress(),
+						Id:      0,
+					},
+					{
+						Creator: sample.AccAddress(),
+						Id:      1,
+					},
+				},
+				PullRequestReviewCount: 2,
+
+				PullRequestAutoMergeList: []types.PullRequestAutoMerge{
+					{
+						RepositoryId:   0,
+						PullRequestIid: 1,
+					},
+					{
+						RepositoryId:   0,
+						PullRequestIid: 2,
+					},
+				},
+
+				MergeQueueList: []types.MergeQueue{
+					{
+						RepositoryId: 0,
+						Branch:       "master",
+					},
+					{
+						RepositoryId: 1,
+						Branch:       "master",
+					},
+				},
+
+				FollowList: []types.Follow{
+					{
+						Follower:  "A",
+						Following: "B",
+					},
+					{
+						Follower:  "B",
+						Following: "A",
+					},
+				},
+
+				CommentRevisionList: []types.CommentRevision{
+					{
+						RepositoryId: 0,
+						Parent:       types.CommentParentIssue,
+						ParentIid:    1,
+						CommentIid:   1,
+						Revision:     1,
+					},
+					{
+						RepositoryId: 0,
+						Parent:       types.CommentParentIssue,
+						ParentIid:    1,
+						CommentIid:   1,
+						Revision:     2,
+					},
+				},
+
+				NotificationList: []types.Notification{
+					{
+						Address: "A",
+						Id:      1,
+					},
+					{
+						Address: "B",
+						Id:      1,
+					},
+				},
+
+				BountyFunderList: []types.BountyFunder{
+					{
+						BountyId: 0,
+						Address:  "A",
+					},
+					{
+						BountyId: 1,
+						Address:  "A",
+					},
+				},
+
+				CommitStatusList: []types.CommitStatus{
+					{
+						Creator: sample.AccAddress(),
+						Id:      0,
+					},
+					{
+						Creator: sample.AccAddress(),
+						Id:      1,
+						Context: "ci/test",
+					},
+				},
+				CommitStatusCount: 2,
+
 				CommentList: []types.Comment{
 					{
 						Creator: sample.AccAddress(),
@@ -405,6 +502,162 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated commit status",
+			genState: &types.GenesisState{
+				CommitStatusList: []types.CommitStatus{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				CommitStatusCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid commit status count",
+			genState: &types.GenesisState{
+				CommitStatusList: []types.CommitStatus{
+					{
+						Id: 0,
+					},
+				},
+				CommitStatusCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pullrequest review",
+			genState: &types.GenesisState{
+				PullRequestReviewList: []types.PullRequestReview{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				PullRequestReviewCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid pullrequest review count",
+			genState: &types.GenesisState{
+				PullRequestReviewList: []types.PullRequestReview{
+					{
+						Id: 0,
+					},
+				},
+				PullRequestReviewCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated merge queue",
+			genState: &types.GenesisState{
+				MergeQueueList: []types.MergeQueue{
+					{
+						RepositoryId: 0,
+						Branch:       "master",
+					},
+					{
+						RepositoryId: 0,
+						Branch:       "master",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated follow",
+			genState: &types.GenesisState{
+				FollowList: []types.Follow{
+					{
+						Follower:  "A",
+						Following: "B",
+					},
+					{
+						Follower:  "A",
+						Following: "B",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated comment revision",
+			genState: &types.GenesisState{
+				CommentRevisionList: []types.CommentRevision{
+					{
+						RepositoryId: 0,
+						Parent:       types.CommentParentPullRequest,
+						ParentIid:    1,
+						CommentIid:   0,
+						Revision:     1,
+					},
+					{
+						RepositoryId: 0,
+						Parent:       types.CommentParentPullRequest,
+						ParentIid:    1,
+						CommentIid:   0,
+						Revision:     1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated notification",
+			genState: &types.GenesisState{
+				NotificationList: []types.Notification{
+					{
+						Address: "A",
+						Id:      1,
+					},
+					{
+						Address: "A",
+						Id:      1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated bounty funder",
+			genState: &types.GenesisState{
+				BountyFunderList: []types.BountyFunder{
+					{
+						BountyId: 0,
+						Address:  "A",
+					},
+					{
+						BountyId: 0,
+						Address:  "A",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pullrequest auto merge",
+			genState: &types.GenesisState{
+				PullRequestAutoMergeList: []types.PullRequestAutoMerge{
+					{
+						RepositoryId:   0,
+						PullRequestIid: 1,
+					},
+					{
+						RepositoryId:   0,
+						PullRequestIid: 1,
+					},
+				},
+			},
+			valid: false,
+		},
 
 		{
 			desc: "duplicated release",
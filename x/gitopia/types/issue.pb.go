@@ -49,23 +49,28 @@ func (Issue_State) EnumDescriptor() ([]byte, []int) {
 }
 
 type Issue struct {
-	Creator       string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id            uint64            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Iid           uint64            `protobuf:"varint,3,opt,name=iid,proto3" json:"iid,omitempty"`
-	Title         string            `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	State         Issue_State       `protobuf:"varint,5,opt,name=state,proto3,enum=gitopia.gitopia.gitopia.Issue_State" json:"state,omitempty"`
-	Description   string            `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	CommentsCount uint64            `protobuf:"varint,7,opt,name=commentsCount,proto3" json:"commentsCount,omitempty"`
-	PullRequests  []*PullRequestIid `protobuf:"bytes,8,rep,name=pullRequests,proto3" json:"pullRequests,omitempty"`
-	RepositoryId  uint64            `protobuf:"varint,9,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
-	Labels        []uint64          `protobuf:"varint,10,rep,packed,name=labels,proto3" json:"labels,omitempty"`
-	Weight        uint64            `protobuf:"varint,11,opt,name=weight,proto3" json:"weight,omitempty"`
-	Assignees     []string          `protobuf:"bytes,12,rep,name=assignees,proto3" json:"assignees,omitempty"`
-	Bounties      []uint64          `protobuf:"varint,13,rep,packed,name=bounties,proto3" json:"bounties,omitempty"`
-	CreatedAt     int64             `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     int64             `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	ClosedAt      int64             `protobuf:"varint,16,opt,name=closedAt,proto3" json:"closedAt,omitempty"`
-	ClosedBy      string            `protobuf:"bytes,17,opt,name=closedBy,proto3" json:"closedBy,omitempty"`
+	Creator        string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id             uint64            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Iid            uint64            `protobuf:"varint,3,opt,name=iid,proto3" json:"iid,omitempty"`
+	Title          string            `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	State          Issue_State       `protobuf:"varint,5,opt,name=state,proto3,enum=gitopia.gitopia.gitopia.Issue_State" json:"state,omitempty"`
+	Description    string            `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	CommentsCount  uint64            `protobuf:"varint,7,opt,name=commentsCount,proto3" json:"commentsCount,omitempty"`
+	PullRequests   []*PullRequestIid `protobuf:"bytes,8,rep,name=pullRequests,proto3" json:"pullRequests,omitempty"`
+	RepositoryId   uint64            `protobuf:"varint,9,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	Labels         []uint64          `protobuf:"varint,10,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	Weight         uint64            `protobuf:"varint,11,opt,name=weight,proto3" json:"weight,omitempty"`
+	Assignees      []string          `protobuf:"bytes,12,rep,name=assignees,proto3" json:"assignees,omitempty"`
+	Bounties       []uint64          `protobuf:"varint,13,rep,packed,name=bounties,proto3" json:"bounties,omitempty"`
+	CreatedAt      int64             `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      int64             `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	ClosedAt       int64             `protobuf:"varint,16,opt,name=closedAt,proto3" json:"closedAt,omitempty"`
+	ClosedBy       string            `protobuf:"bytes,17,opt,name=closedBy,proto3" json:"closedBy,omitempty"`
+	Reactions      []*Reaction       `protobuf:"bytes,18,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	ReactionCounts []*ReactionCount  `protobuf:"bytes,19,rep,name=reactionCounts,proto3" json:"reactionCounts,omitempty"`
+	Locked         bool              `protobuf:"varint,20,opt,name=locked,proto3" json:"locked,omitempty"`
+	Milestone      uint64            `protobuf:"varint,21,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	TransferredTo  *IssueTransfer    `protobuf:"bytes,22,opt,name=transferredTo,proto3" json:"transferredTo,omitempty"`
 }
 
 func (m *Issue) Reset()         { *m = Issue{} }
@@ -220,44 +225,140 @@ func (m *Issue) GetClosedBy() string {
 	return ""
 }
 
+func (m *Issue) GetReactions() []*Reaction {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
+func (m *Issue) GetReactionCounts() []*ReactionCount {
+	if m != nil {
+		return m.ReactionCounts
+	}
+	return nil
+}
+
+func (m *Issue) GetLocked() bool {
+	if m != nil {
+		return m.Locked
+	}
+	return false
+}
+
+func (m *Issue) GetMilestone() uint64 {
+	if m != nil {
+		return m.Milestone
+	}
+	return 0
+}
+
+func (m *Issue) GetTransferredTo() *IssueTransfer {
+	if m != nil {
+		return m.TransferredTo
+	}
+	return nil
+}
+
+// IssueTransfer points a transferred issue to the issue created in the target repository
+type IssueTransfer struct {
+	RepositoryId uint64 `protobuf:"varint,1,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	Iid          uint64 `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+}
+
+func (m *IssueTransfer) Reset()         { *m = IssueTransfer{} }
+func (m *IssueTransfer) String() string { return proto.CompactTextString(m) }
+func (*IssueTransfer) ProtoMessage()    {}
+func (*IssueTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cf64e56e9098bda, []int{1}
+}
+func (m *IssueTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssueTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssueTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IssueTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueTransfer.Merge(m, src)
+}
+func (m *IssueTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssueTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueTransfer proto.InternalMessageInfo
+
+func (m *IssueTransfer) GetRepositoryId() uint64 {
+	if m != nil {
+		return m.RepositoryId
+	}
+	return 0
+}
+
+func (m *IssueTransfer) GetIid() uint64 {
+	if m != nil {
+		return m.Iid
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("gitopia.gitopia.gitopia.Issue_State", Issue_State_name, Issue_State_value)
 	proto.RegisterType((*Issue)(nil), "gitopia.gitopia.gitopia.Issue")
+	proto.RegisterType((*IssueTransfer)(nil), "gitopia.gitopia.gitopia.IssueTransfer")
 }
 
 func init() { proto.RegisterFile("gitopia/issue.proto", fileDescriptor_4cf64e56e9098bda) }
 
 var fileDescriptor_4cf64e56e9098bda = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0xc6, 0x76, 0x9a, 0x4c, 0xd2, 0x10, 0x96, 0x0a, 0x46, 0x11, 0x58, 0x56, 0x54, 0x09,
-	0x8b, 0x83, 0x23, 0x95, 0x1b, 0x37, 0xfa, 0x71, 0x88, 0x40, 0xb4, 0x72, 0x6f, 0xdc, 0x1c, 0x7b,
-	0xe5, 0xae, 0xe4, 0x64, 0x8d, 0x77, 0x2d, 0xc8, 0xbf, 0xe0, 0x67, 0x21, 0x71, 0xe9, 0x91, 0x23,
-	0x4a, 0xfe, 0x08, 0xda, 0x75, 0x6c, 0x93, 0x4a, 0x39, 0xed, 0xbc, 0xf7, 0xe6, 0x3d, 0x8f, 0x77,
-	0x07, 0x5e, 0xa4, 0x5c, 0x89, 0x9c, 0x47, 0x73, 0x2e, 0x65, 0xc9, 0x82, 0xbc, 0x10, 0x4a, 0xd0,
-	0x57, 0x7b, 0x32, 0x78, 0x72, 0x4e, 0xcf, 0x52, 0x91, 0x0a, 0xd3, 0x33, 0xd7, 0x55, 0xd5, 0x3e,
-	0xc5, 0x3a, 0xa3, 0x60, 0xb9, 0x90, 0x5c, 0x89, 0x62, 0x53, 0x29, 0xb3, 0xdf, 0x36, 0x38, 0x0b,
-	0x1d, 0x4c, 0x11, 0x4e, 0xe2, 0x82, 0x45, 0x4a, 0x14, 0x48, 0x3c, 0xe2, 0x0f, 0xc2, 0x1a, 0xd2,
-	0x31, 0x74, 0x79, 0x82, 0x5d, 0x8f, 0xf8, 0x76, 0xd8, 0xe5, 0x09, 0x9d, 0x80, 0xc5, 0x79, 0x82,
-	0x96, 0x21, 0x74, 0x49, 0xcf, 0xc0, 0x51, 0x5c, 0x65, 0x0c, 0x6d, 0xe3, 0xac, 0x00, 0xfd, 0x00,
-	0x8e, 0x54, 0x91, 0x62, 0xe8, 0x78, 0xc4, 0x1f, 0x5f, 0x9c, 0x07, 0x47, 0x86, 0x0e, 0xcc, 0x00,
-	0xc1, 0xbd, 0xee, 0x0d, 0x2b, 0x0b, 0xf5, 0x60, 0x98, 0x30, 0x19, 0x17, 0x3c, 0x57, 0x5c, 0xac,
-	0xb1, 0x67, 0x72, 0xff, 0xa7, 0xe8, 0x39, 0x9c, 0xc6, 0x62, 0xb5, 0x62, 0x6b, 0x25, 0xaf, 0x44,
-	0xb9, 0x56, 0x78, 0x62, 0xe6, 0x39, 0x24, 0xe9, 0x27, 0x18, 0xe5, 0x65, 0x96, 0x85, 0xec, 0x5b,
-	0xc9, 0xa4, 0x92, 0xd8, 0xf7, 0x2c, 0x7f, 0x78, 0xf1, 0xf6, 0xe8, 0x28, 0x77, 0x6d, 0xf3, 0x82,
-	0x27, 0xe1, 0x81, 0x99, 0xce, 0x60, 0xd4, 0x5e, 0xe0, 0x22, 0xc1, 0x81, 0xf9, 0xe2, 0x01, 0x47,
-	0x5f, 0x42, 0x2f, 0x8b, 0x96, 0x2c, 0x93, 0x08, 0x9e, 0xe5, 0xdb, 0xe1, 0x1e, 0x69, 0xfe, 0x3b,
-	0xe3, 0xe9, 0x83, 0xc2, 0xa1, 0x71, 0xed, 0x11, 0x7d, 0x0d, 0x83, 0x48, 0x4a, 0x9e, 0xae, 0x19,
-	0x93, 0x38, 0xf2, 0x2c, 0x7f, 0x10, 0xb6, 0x04, 0x9d, 0x42, 0x7f, 0xa9, 0xff, 0x83, 0x33, 0x89,
-	0xa7, 0x26, 0xaf, 0xc1, 0xda, 0x69, 0x5e, 0x88, 0x25, 0x1f, 0x15, 0x8e, 0x3d, 0xe2, 0x5b, 0x61,
-	0x4b, 0x68, 0xb5, 0xcc, 0x93, 0xbd, 0xfa, 0xac, 0x52, 0x1b, 0x42, 0xe7, 0xc6, 0x99, 0x90, 0x46,
-	0x9c, 0x18, 0xb1, 0xc1, 0xad, 0x76, 0xb9, 0xc1, 0xe7, 0xe6, 0xde, 0x1b, 0x3c, 0x7b, 0x03, 0x8e,
-	0x79, 0x26, 0xda, 0x07, 0xfb, 0xf6, 0xee, 0xe6, 0xcb, 0xa4, 0x43, 0x01, 0x7a, 0x57, 0x9f, 0x6f,
-	0xef, 0x6f, 0xae, 0x27, 0xe4, 0xf2, 0xfa, 0xd7, 0xd6, 0x25, 0x8f, 0x5b, 0x97, 0xfc, 0xdd, 0xba,
-	0xe4, 0xe7, 0xce, 0xed, 0x3c, 0xee, 0xdc, 0xce, 0x9f, 0x9d, 0xdb, 0xf9, 0xfa, 0x2e, 0xe5, 0xea,
-	0xa1, 0x5c, 0x06, 0xb1, 0x58, 0xcd, 0xeb, 0x65, 0xac, 0xcf, 0x1f, 0x4d, 0xa5, 0x36, 0x39, 0x93,
-	0xcb, 0x9e, 0x59, 0xcd, 0xf7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x93, 0x53, 0xb7, 0xfa,
-	0x02, 0x00, 0x00,
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0xc6, 0x49, 0x9a, 0x4c, 0x9b, 0x10, 0xb6, 0x21, 0xac, 0x22, 0xb0, 0x4c, 0x54, 0x81,
+	0xc5, 0xc1, 0x91, 0xca, 0x8d, 0x0b, 0xa2, 0x6d, 0x0e, 0x11, 0x55, 0x5b, 0x6d, 0x7b, 0xe2, 0xe6,
+	0xd8, 0x8b, 0xbb, 0xc2, 0xf1, 0x1a, 0xef, 0x5a, 0x90, 0x7f, 0xc1, 0xcf, 0xe2, 0xd8, 0x23, 0xc7,
+	0x2a, 0xf9, 0x23, 0xc8, 0x1b, 0x7f, 0x90, 0x42, 0xe0, 0xe4, 0x7d, 0xef, 0xcd, 0x1b, 0xcf, 0xce,
+	0xce, 0xc0, 0x61, 0xc0, 0x95, 0x88, 0xb9, 0x3b, 0xe1, 0x52, 0xa6, 0xcc, 0x89, 0x13, 0xa1, 0x04,
+	0x7e, 0x9a, 0x93, 0xce, 0x83, 0xef, 0x68, 0x10, 0x88, 0x40, 0xe8, 0x98, 0x49, 0x76, 0xda, 0x84,
+	0x8f, 0x48, 0x91, 0x23, 0x61, 0xb1, 0x90, 0x5c, 0x89, 0x64, 0x99, 0x2b, 0xc3, 0x4a, 0x71, 0x3d,
+	0xc5, 0x45, 0xb4, 0xe1, 0xc7, 0xf7, 0x2d, 0x68, 0xce, 0xb2, 0x1f, 0x62, 0x02, 0x7b, 0x5e, 0xc2,
+	0x5c, 0x25, 0x12, 0x82, 0x2c, 0x64, 0x77, 0x68, 0x01, 0x71, 0x0f, 0xea, 0xdc, 0x27, 0x75, 0x0b,
+	0xd9, 0x0d, 0x5a, 0xe7, 0x3e, 0xee, 0x83, 0xc1, 0xb9, 0x4f, 0x0c, 0x4d, 0x64, 0x47, 0x3c, 0x80,
+	0xa6, 0xe2, 0x2a, 0x64, 0xa4, 0xa1, 0x9d, 0x1b, 0x80, 0xdf, 0x42, 0x53, 0x2a, 0x57, 0x31, 0xd2,
+	0xb4, 0x90, 0xdd, 0x3b, 0x3e, 0x72, 0x76, 0x5c, 0xc6, 0xd1, 0x05, 0x38, 0xd7, 0x59, 0x2c, 0xdd,
+	0x58, 0xb0, 0x05, 0xfb, 0x3e, 0x93, 0x5e, 0xc2, 0xe3, 0xac, 0x58, 0xd2, 0xd2, 0x79, 0x7f, 0xa7,
+	0xf0, 0x11, 0x74, 0x3d, 0xb1, 0x58, 0xb0, 0x48, 0xc9, 0x53, 0x91, 0x46, 0x8a, 0xec, 0xe9, 0x7a,
+	0xb6, 0x49, 0xfc, 0x01, 0x0e, 0xe2, 0x34, 0x0c, 0x29, 0xfb, 0x92, 0x32, 0xa9, 0x24, 0x69, 0x5b,
+	0x86, 0xbd, 0x7f, 0xfc, 0x6a, 0x67, 0x29, 0x57, 0x55, 0xf0, 0x8c, 0xfb, 0x74, 0xcb, 0x8c, 0xc7,
+	0x70, 0x50, 0x35, 0x76, 0xe6, 0x93, 0x8e, 0xfe, 0xe3, 0x16, 0x87, 0x87, 0xd0, 0x0a, 0xdd, 0x39,
+	0x0b, 0x25, 0x01, 0xcb, 0xb0, 0x1b, 0x34, 0x47, 0x19, 0xff, 0x95, 0xf1, 0xe0, 0x56, 0x91, 0x7d,
+	0xed, 0xca, 0x11, 0x7e, 0x06, 0x1d, 0x57, 0x4a, 0x1e, 0x44, 0x8c, 0x49, 0x72, 0x60, 0x19, 0x76,
+	0x87, 0x56, 0x04, 0x1e, 0x41, 0x7b, 0x9e, 0xdd, 0x83, 0x33, 0x49, 0xba, 0x3a, 0x5f, 0x89, 0x33,
+	0xa7, 0x7e, 0x21, 0xe6, 0xbf, 0x57, 0xa4, 0x67, 0x21, 0xdb, 0xa0, 0x15, 0x91, 0xa9, 0x69, 0xec,
+	0xe7, 0xea, 0xa3, 0x8d, 0x5a, 0x12, 0x59, 0x5e, 0x2f, 0x14, 0x52, 0x8b, 0x7d, 0x2d, 0x96, 0xb8,
+	0xd2, 0x4e, 0x96, 0xe4, 0xb1, 0xee, 0x7b, 0x89, 0xf1, 0x3b, 0xe8, 0x14, 0x03, 0x24, 0x09, 0xd6,
+	0xbd, 0x7c, 0xb1, 0xb3, 0x97, 0x34, 0x8f, 0xa4, 0x95, 0x07, 0x5f, 0x40, 0xaf, 0x00, 0xfa, 0x81,
+	0x24, 0x39, 0xd4, 0x59, 0x5e, 0xfe, 0x37, 0x8b, 0x0e, 0xa7, 0x0f, 0xdc, 0xba, 0xdd, 0xc2, 0xfb,
+	0xcc, 0x7c, 0x32, 0xb0, 0x90, 0xdd, 0xa6, 0x39, 0xca, 0xae, 0xbf, 0xe0, 0x21, 0x93, 0x4a, 0x44,
+	0x8c, 0x3c, 0xd1, 0x1d, 0xaf, 0x08, 0x7c, 0x0e, 0x5d, 0x95, 0xb8, 0x91, 0xfc, 0xc4, 0x92, 0x84,
+	0xf9, 0x37, 0x82, 0x0c, 0x2d, 0xf4, 0xcf, 0x22, 0xf4, 0x84, 0xde, 0xe4, 0x16, 0xba, 0x6d, 0x1e,
+	0x3f, 0x87, 0xa6, 0x9e, 0x5d, 0xdc, 0x86, 0xc6, 0xe5, 0xd5, 0xf4, 0xa2, 0x5f, 0xc3, 0x00, 0xad,
+	0xd3, 0xf3, 0xcb, 0xeb, 0xe9, 0x59, 0x1f, 0x8d, 0xa7, 0xd0, 0xdd, 0xb2, 0xff, 0x31, 0x46, 0xe8,
+	0x2f, 0x63, 0x94, 0xef, 0x58, 0xbd, 0xdc, 0xb1, 0x93, 0xb3, 0x1f, 0x2b, 0x13, 0xdd, 0xad, 0x4c,
+	0x74, 0xbf, 0x32, 0xd1, 0xf7, 0xb5, 0x59, 0xbb, 0x5b, 0x9b, 0xb5, 0x9f, 0x6b, 0xb3, 0xf6, 0xf1,
+	0x75, 0xc0, 0xd5, 0x6d, 0x3a, 0x77, 0x3c, 0xb1, 0x98, 0x14, 0x6b, 0x5e, 0x7c, 0xbf, 0x95, 0x27,
+	0xb5, 0x8c, 0x99, 0x9c, 0xb7, 0xf4, 0xda, 0xbf, 0xf9, 0x35, 0x00, 0x10, 0x95, 0x32, 0x9e, 0x6e,
+	0x04, 0x00, 0x00,
 }
 
 func (m *Issue) Marshal() (dAtA []byte, err error) {
@@ -280,6 +381,71 @@ func (m *Issue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TransferredTo != nil {
+		{
+			size, err := m.TransferredTo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIssue(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Milestone != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.Milestone))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Locked {
+		i--
+		if m.Locked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.ReactionCounts) > 0 {
+		for iNdEx := len(m.ReactionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReactionCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIssue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.Reactions) > 0 {
+		for iNdEx := len(m.Reactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIssue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	if len(m.ClosedBy) > 0 {
 		i -= len(m.ClosedBy)
 		copy(dAtA[i:], m.ClosedBy)
@@ -307,20 +473,20 @@ func (m *Issue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if len(m.Bounties) > 0 {
-		dAtA2 := make([]byte, len(m.Bounties)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.Bounties)*10)
+		var j2 int
 		for _, num := range m.Bounties {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintIssue(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintIssue(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -339,20 +505,20 @@ func (m *Issue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x58
 	}
 	if len(m.Labels) > 0 {
-		dAtA4 := make([]byte, len(m.Labels)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.Labels)*10)
+		var j4 int
 		for _, num := range m.Labels {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintIssue(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintIssue(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -419,6 +585,39 @@ func (m *Issue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IssueTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssueTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssueTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Iid != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.Iid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RepositoryId != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.RepositoryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIssue(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIssue(v)
 	base := offset
@@ -505,6 +704,43 @@ func (m *Issue) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovIssue(uint64(l))
 	}
+	if len(m.Reactions) > 0 {
+		for _, e := range m.Reactions {
+			l = e.Size()
+			n += 2 + l + sovIssue(uint64(l))
+		}
+	}
+	if len(m.ReactionCounts) > 0 {
+		for _, e := range m.ReactionCounts {
+			l = e.Size()
+			n += 2 + l + sovIssue(uint64(l))
+		}
+	}
+	if m.Locked {
+		n += 3
+	}
+	if m.Milestone != 0 {
+		n += 2 + sovIssue(uint64(m.Milestone))
+	}
+	if m.TransferredTo != nil {
+		l = m.TransferredTo.Size()
+		n += 2 + l + sovIssue(uint64(l))
+	}
+	return n
+}
+
+func (m *IssueTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RepositoryId != 0 {
+		n += 1 + sovIssue(uint64(m.RepositoryId))
+	}
+	if m.Iid != 0 {
+		n += 1 + sovIssue(uint64(m.Iid))
+	}
 	return n
 }
 
@@ -1060,6 +1296,237 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 			}
 			m.ClosedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reactions = append(m.Reactions, &Reaction{})
+			if err := m.Reactions[len(m.Reactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReactionCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReactionCounts = append(m.ReactionCounts, &ReactionCount{})
+			if err := m.ReactionCounts[len(m.ReactionCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Locked = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestone", wireType)
+			}
+			m.Milestone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Milestone |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferredTo == nil {
+				m.TransferredTo = &IssueTransfer{}
+			}
+			if err := m.TransferredTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssueTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssueTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssueTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
+			}
+			m.Iid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIssue(dAtA[iNdEx:])
@@ -47,6 +47,15 @@ const (
 	UserCountKey = "User-count-"
 )
 
+const (
+	FollowerKey  = "Follower-value-"
+	FollowingKey = "Following-value-"
+)
+
+const (
+	NotificationKey = "Notification-value-"
+)
+
 const (
 	BaseRepositoryKeyKey = "Base-repository-key-value-"
 	RepositoryKey        = "Repository-value-"
@@ -59,8 +68,9 @@ const (
 )
 
 const (
-	CommentKey      = "Comment-value-"
-	CommentCountKey = "Comment-count-"
+	CommentKey         = "Comment-value-"
+	CommentCountKey    = "Comment-count-"
+	CommentRevisionKey = "Comment-revision-value-"
 )
 
 const (
@@ -73,6 +83,38 @@ const (
 	PullRequestCountKey = "PullRequest-count-"
 )
 
+const (
+	PullRequestAutoMergeKey = "PullRequestAutoMerge-value-"
+	// PullRequestAutoMergeCursorKey stores the key of the last auto-merge request checked
+	PullRequestAutoMergeCursorKey = "PullRequestAutoMerge-cursor-"
+)
+
+const (
+	MergeQueueKey     = "MergeQueue-value-"
+	MergeQueueTaskKey = "MergeQueueTask-value-"
+)
+
+const (
+	RepositoryStarKey = "RepositoryStar-value-"
+	UserStarKey       = "UserStar-value-"
+)
+
+const (
+	RepositoryWatchKey = "RepositoryWatch-value-"
+	UserWatchKey       = "UserWatch-value-"
+)
+
+const (
+	// MilestoneIssueKey and MilestonePullRequestKey index the issues and pull requests of a milestone
+	MilestoneIssueKey       = "MilestoneIssue-value-"
+	MilestonePullRequestKey = "MilestonePullRequest-value-"
+)
+
+const (
+	PullRequestReviewKey      = "PullRequestReview-value-"
+	PullRequestReviewCountKey = "PullRequestReview-count-"
+)
+
 const (
 	ReleaseKey      = "Release-value-"
 	ReleaseCountKey = "Release-count-"
@@ -85,6 +127,10 @@ const (
 	UpdateUserBioEventKey      = "UpdateUserBio"
 	UpdateUserAvatarEventKey   = "UpdateUserAvatar"
 	DeleteUserEventKey         = "DeleteUser"
+	FollowEventKey             = "Follow"
+	UnfollowEventKey           = "Unfollow"
+
+	MarkNotificationsReadEventKey = "MarkNotificationsRead"
 )
 
 const (
@@ -101,30 +147,49 @@ const (
 )
 
 const (
-	CreateRepositoryEventKey             = "CreateRepository"
-	ChangeOwnerEventKey                  = "ChangeOwner"
-	RenameRepositoryEventKey             = "RenameRepository"
-	UpdateRepositoryDescriptionEventKey  = "UpdateRepositoryDescription"
-	UpdateRepositoryCollaboratorEventKey = "UpdateRepositoryCollaborator"
-	RemoveRepositoryCollaboratorEventKey = "RemoveRepositoryCollaborator"
-	CreateRepositoryLabelEventKey        = "CreateRepositoryLabel"
-	UpdateRepositoryLabelEventKey        = "UpdateRepositoryLabel"
-	DeleteRepositoryLabelEventKey        = "DeleteRepositoryLabel"
-	ToggleRepositoryForkingEventKey      = "ToggleRepositoryForking"
-	ToggleArweaveBackupEventKey          = "ToggleArweaveBackup"
-	DeleteRepositoryEventKey             = "DeleteRepository"
-	InvokeForkRepositoryEventKey         = "InvokeForkRepository"
-	ForkRepositoryEventKey               = "ForkRepository"
-	SetRepositoryBranchEventKey          = "SetRepositoryBranch"
-	SetRepositoryTagEventKey             = "SetRepositoryTag"
-	MultiSetRepositoryBranchEventKey     = "MultiSetRepositoryBranch"
-	MultiSetRepositoryTagEventKey        = "MultiSetRepositoryTag"
-	SetRepositoryDefaultBranchEventKey   = "SetRepositoryDefaultBranch"
-	DeleteRepositoryBranchEventKey       = "DeleteRepositoryBranch"
-	MultiDeleteRepositoryBranchEventKey  = "MultiDeleteRepositoryBranch"
-	DeleteRepositoryTagEventKey          = "DeleteRepositoryTag"
-	MultiDeleteRepositoryTagEventKey     = "MultiDeleteRepositoryTag"
-	ToggleForcePushToBranchEventKey      = "ToggleForcePushToBranch"
+	CreateRepositoryEventKey                    = "CreateRepository"
+	ChangeOwnerEventKey                         = "ChangeOwner"
+	RenameRepositoryEventKey                    = "RenameRepository"
+	UpdateRepositoryDescriptionEventKey         = "UpdateRepositoryDescription"
+	UpdateRepositoryCollaboratorEventKey        = "UpdateRepositoryCollaborator"
+	RemoveRepositoryCollaboratorEventKey        = "RemoveRepositoryCollaborator"
+	CreateRepositoryLabelEventKey               = "CreateRepositoryLabel"
+	UpdateRepositoryLabelEventKey               = "UpdateRepositoryLabel"
+	DeleteRepositoryLabelEventKey               = "DeleteRepositoryLabel"
+	CreateRepositoryMilestoneEventKey           = "CreateRepositoryMilestone"
+	UpdateRepositoryMilestoneEventKey           = "UpdateRepositoryMilestone"
+	ToggleRepositoryMilestoneStateEventKey      = "ToggleRepositoryMilestoneState"
+	DeleteRepositoryMilestoneEventKey           = "DeleteRepositoryMilestone"
+	CreateRepositoryIssueTemplateEventKey       = "CreateRepositoryIssueTemplate"
+	UpdateRepositoryIssueTemplateEventKey       = "UpdateRepositoryIssueTemplate"
+	DeleteRepositoryIssueTemplateEventKey       = "DeleteRepositoryIssueTemplate"
+	ToggleRepositoryForkingEventKey             = "ToggleRepositoryForking"
+	ToggleArweaveBackupEventKey                 = "ToggleArweaveBackup"
+	UpdateRepositoryAllowedMergeMethodsEventKey = "UpdateRepositoryAllowedMergeMethods"
+	ArchiveRepositoryEventKey                   = "ArchiveRepository"
+	UnarchiveRepositoryEventKey                 = "UnarchiveRepository"
+	UpdateRepositoryCodeOwnersEventKey          = "UpdateRepositoryCodeOwners"
+	StarRepositoryEventKey                      = "StarRepository"
+	UnstarRepositoryEventKey                    = "UnstarRepository"
+	WatchRepositoryEventKey                     = "WatchRepository"
+	UnwatchRepositoryEventKey                   = "UnwatchRepository"
+	DeleteRepositoryEventKey                    = "DeleteRepository"
+	InvokeForkRepositoryEventKey                = "InvokeForkRepository"
+	ForkRepositoryEventKey                      = "ForkRepository"
+	SetRepositoryBranchEventKey                 = "SetRepositoryBranch"
+	SetRepositoryTagEventKey                    = "SetRepositoryTag"
+	MultiSetRepositoryBranchEventKey            = "MultiSetRepositoryBranch"
+	MultiSetRepositoryTagEventKey               = "MultiSetRepositoryTag"
+	SetRepositoryDefaultBranchEventKey          = "SetRepositoryDefaultBranch"
+	DeleteRepositoryBranchEventKey              = "DeleteRepositoryBranch"
+	MultiDeleteRepositoryBranchEventKey         = "MultiDeleteRepositoryBranch"
+	DeleteRepositoryTagEventKey                 = "DeleteRepositoryTag"
+	MultiDeleteRepositoryTagEventKey            = "MultiDeleteRepositoryTag"
+	ToggleForcePushToBranchEventKey             = "ToggleForcePushToBranch"
+	CreateBranchProtectionRuleEventKey          = "CreateBranchProtectionRule"
+	UpdateBranchProtectionRuleEventKey          = "UpdateBranchProtectionRule"
+	DeleteBranchProtectionRuleEventKey          = "DeleteBranchProtectionRule"
+	SetCommitStatusEventKey                     = "SetCommitStatus"
 )
 
 const (
@@ -136,24 +201,48 @@ const (
 	RemoveIssueAssigneesEventKey   = "RemoveIssueAssignees"
 	AddIssueLabelsEventKey         = "AddIssueLabels"
 	RemoveIssueLabelsEventKey      = "RemoveIssueLabels"
+	SetIssueMilestoneEventKey      = "SetIssueMilestone"
 	DeleteIssueEventKey            = "DeleteIssue"
+	TransferIssueEventKey          = "TransferIssue"
+)
+
+const (
+	CreatePullRequestEventKey               = "CreatePullRequest"
+	UpdatePullRequestTitleEventKey          = "UpdatePullRequestTitle"
+	UpdatePullRequestDescriptionEventKey    = "UpdatePullRequestDescription"
+	InvokeMergePullRequestEventKey          = "InvokeMergePullRequest"
+	SetPullRequestStateEventKey             = "SetPullRequestState"
+	AddPullRequestReviewersEventKey         = "AddPullRequestReviewers"
+	RemovePullRequestReviewersEventKey      = "RemovePullRequestReviewers"
+	AddPullRequestAssigneesEventKey         = "AddPullRequestAssignees"
+	RemovePullRequestAssigneesEventKey      = "RemovePullRequestAssignees"
+	AddPullRequestLabelsEventKey            = "AddPullRequestLabels"
+	RemovePullRequestLabelsEventKey         = "RemovePullRequestLabels"
+	SetPullRequestMilestoneEventKey         = "SetPullRequestMilestone"
+	DeletePullRequestEventKey               = "DeletePullRequest"
+	LinkPullRequestIssueByIidEventKey       = "LinkPullRequestIssueByIid"
+	UnlinkPullRequestIssueByIidEventKey     = "UnlinkPullRequestIssueByIid"
+	SubmitPullRequestReviewEventKey         = "SubmitPullRequestReview"
+	MarkPullRequestReadyForReviewEventKey   = "MarkPullRequestReadyForReview"
+	ConvertPullRequestToDraftEventKey       = "ConvertPullRequestToDraft"
+	EnablePullRequestAutoMergeEventKey      = "EnablePullRequestAutoMerge"
+	DisablePullRequestAutoMergeEventKey     = "DisablePullRequestAutoMerge"
+	AddPullRequestToMergeQueueEventKey      = "AddPullRequestToMergeQueue"
+	RemovePullRequestFromMergeQueueEventKey = "RemovePullRequestFromMergeQueue"
+	SetPullRequestChangedPathsEventKey      = "SetPullRequestChangedPaths"
+	SetPullRequestCommitMessagesEventKey    = "SetPullRequestCommitMessages"
+	TestMergeQueueEntryEventKey             = "TestMergeQueueEntry"
+	EvictMergeQueueEntryEventKey            = "EvictMergeQueueEntry"
 )
 
 const (
-	CreatePullRequestEventKey            = "CreatePullRequest"
-	UpdatePullRequestTitleEventKey       = "UpdatePullRequestTitle"
-	UpdatePullRequestDescriptionEventKey = "UpdatePullRequestDescription"
-	InvokeMergePullRequestEventKey       = "InvokeMergePullRequest"
-	SetPullRequestStateEventKey          = "SetPullRequestState"
-	AddPullRequestReviewersEventKey      = "AddPullRequestReviewers"
-	RemovePullRequestReviewersEventKey   = "RemovePullRequestReviewers"
-	AddPullRequestAssigneesEventKey      = "AddPullRequestAssignees"
-	RemovePullRequestAssigneesEventKey   = "RemovePullRequestAssignees"
-	AddPullRequestLabelsEventKey         = "AddPullRequestLabels"
-	RemovePullRequestLabelsEventKey      = "RemovePullRequestLabels"
-	DeletePullRequestEventKey            = "DeletePullRequest"
-	LinkPullRequestIssueByIidEventKey    = "LinkPullRequestIssueByIid"
-	UnlinkPullRequestIssueByIidEventKey  = "UnlinkPullRequestIssueByIid"
+	ToggleReactionEventKey         = "ToggleReaction"
+	ResolveCommentThreadEventKey   = "ResolveCommentThread"
+	UnresolveCommentThreadEventKey = "UnresolveCommentThread"
+	HideCommentEventKey            = "HideComment"
+	UnhideCommentEventKey          = "UnhideComment"
+	LockConversationEventKey       = "LockConversation"
+	UnlockConversationEventKey     = "UnlockConversation"
 )
 
 const (
@@ -163,10 +252,20 @@ const (
 )
 
 const (
-	CreateBountyEventKey       = "CreateBounty"
-	UpdateBountyExpiryEventKey = "UpdateBountyExpiry"
-	CloseBountyEventKey        = "CloseBounty"
-	DeleteBountyEventKey       = "DeleteBounty"
+	CreateBountyEventKey         = "CreateBounty"
+	UpdateBountyExpiryEventKey   = "UpdateBountyExpiry"
+	CloseBountyEventKey          = "CloseBounty"
+	DeleteBountyEventKey         = "DeleteBounty"
+	RefundBountyEventKey         = "RefundBounty"
+	SetBountySplitsEventKey      = "SetBountySplits"
+	FundBountyEventKey           = "FundBounty"
+	AwardBountyEventKey          = "AwardBounty"
+	DeferBountyPayoutEventKey    = "DeferBountyPayout"
+	ReleaseBountyEventKey        = "ReleaseBounty"
+	RewardBountyFailedEventKey   = "RewardBountyFailed"
+	DisputeBountyEventKey        = "DisputeBounty"
+	ResolveBountyDisputeEventKey = "ResolveBountyDispute"
+	UpdateBountyArbiterEventKey  = "UpdateBountyArbiter"
 )
 
 const (
@@ -190,6 +289,10 @@ const (
 	EventAttributeUserNameKey     = "UserName"
 	EventAttributeUserBio         = "UserBio"
 	EventAttributeAvatarUrl       = "AvatarUrl"
+	EventAttributeFollowingKey    = "Following"
+	EventAttributeFollowersCount  = "FollowersCount"
+
+	EventAttributeNotificationIdsKey = "NotificationIds"
 )
 
 const (
@@ -212,8 +315,17 @@ const (
 	EventAttributeRepoLabelIdKey             = "RepositoryLabelId"
 	EventAttributeRepoLabelNameKey           = "RepositoryLabelName"
 	EventAttributeRepoLabelColorKey          = "RepositoryLabelColor"
+	EventAttributeRepoMilestoneKey           = "RepositoryMilestone"
+	EventAttributeRepoMilestoneIdKey         = "RepositoryMilestoneId"
+	EventAttributeRepoMilestoneStateKey      = "RepositoryMilestoneState"
+	EventAttributeRepoIssueTemplateKey       = "RepositoryIssueTemplate"
+	EventAttributeRepoIssueTemplateIdKey     = "RepositoryIssueTemplateId"
 	EventAttributeRepoAllowForkingKey        = "RepositoryAllowForking"
 	EventAttributeRepoEnableArweaveBackupKey = "RepositoryEnableArweaveBackup"
+	EventAttributeRepoAllowedMergeMethodsKey = "RepositoryAllowedMergeMethods"
+	EventAttributeRepoCodeOwnersKey          = "RepositoryCodeOwners"
+	EventAttributeRepoStarsCountKey          = "RepositoryStarsCount"
+	EventAttributeRepoWatchersCountKey       = "RepositoryWatchersCount"
 	EventAttributeForkRepoNameKey            = "ForkRepositoryName"
 	EventAttributeForkRepoDescriptionKey     = "ForkRepositoryDescription"
 	EventAttributeForkRepoBranchKey          = "ForkRepositoryBranch"
@@ -221,6 +333,8 @@ const (
 	EventAttributeRepoBranchKey              = "RepositoryBranch"
 	EventAttributeRepoTagKey                 = "RepositoryTag"
 	EventAttributeRepoDefaultBranchKey       = "RepositoryDefaultBranch"
+	EventAttributeBranchProtectionRuleKey    = "BranchProtectionRule"
+	EventAttributeCommitStatusKey            = "CommitStatus"
 )
 
 const (
@@ -230,6 +344,10 @@ const (
 	EventAttributeIssueStateKey       = "IssueState"
 	EventAttributeIssueDescriptionKey = "IssueDescription"
 	EventAttributeClosedByKey         = "ClosedBy"
+
+	EventAttributeIssueTransferredToRepoIdKey = "IssueTransferredToRepositoryId"
+	EventAttributeIssueTransferredToIdKey     = "IssueTransferredToId"
+	EventAttributeIssueTransferredToIidKey    = "IssueTransferredToIid"
 )
 
 const (
@@ -242,9 +360,15 @@ const (
 	EventAttributePullRequestHeadKey           = "PullRequestHead"
 	EventAttributePullRequestBaseKey           = "PullRequestBase"
 	EventAttributePullRequestMergeCommitShaKey = "PullRequestMergeCommitSha"
+	EventAttributePullRequestMergeMethodKey    = "PullRequestMergeMethod"
 	EventAttributePullRequestMergedByKey       = "PullRequestMergedBy"
 	EventAttributePullRequestMergedAtKey       = "PullRequestMergedAt"
 	EventAttributePullRequestReviewersKey      = "PullRequestReviewers"
+	EventAttributePullRequestIssuesKey         = "PullRequestIssues"
+	EventAttributePullRequestReviewIdKey       = "PullRequestReviewId"
+	EventAttributePullRequestReviewStateKey    = "PullRequestReviewState"
+	EventAttributePullRequestReviewShaKey      = "PullRequestReviewSha"
+	EventAttributeMergeQueuePositionKey        = "MergeQueuePosition"
 )
 
 const (
@@ -257,12 +381,32 @@ const (
 )
 
 const (
-	EventAttributeBountyIdKey        = "BountyId"
-	EventAttributeBountyAmountKey    = "BountyAmount"
-	EventAttributeBountyStateKey     = "BountyState"
-	EventAttributeBountyParentKey    = "BountyParent"
-	EventAttributeBountyParentIidKey = "BountyParentIid"
-	EventAttributeBountyExpiry       = "BountyExpiry"
+	EventAttributeCommentParentKey    = "CommentParent"
+	EventAttributeCommentParentIidKey = "CommentParentIid"
+	EventAttributeCommentIidKey       = "CommentIid"
+	EventAttributeReactionEmojiKey    = "ReactionEmoji"
+	EventAttributeReactionAddedKey    = "ReactionAdded"
+	EventAttributeReactionCountsKey   = "ReactionCounts"
+	EventAttributeCommentResolvedKey  = "CommentResolved"
+	EventAttributeCommentHiddenKey    = "CommentHidden"
+	EventAttributeHiddenReasonKey     = "HiddenReason"
+	EventAttributeLockedKey           = "Locked"
+)
+
+const (
+	EventAttributeBountyIdKey            = "BountyId"
+	EventAttributeBountyAmountKey        = "BountyAmount"
+	EventAttributeBountyStateKey         = "BountyState"
+	EventAttributeBountyParentKey        = "BountyParent"
+	EventAttributeBountyParentIidKey     = "BountyParentIid"
+	EventAttributeBountyExpiry           = "BountyExpiry"
+	EventAttributeBountySplitsKey        = "BountySplits"
+	EventAttributeBountyRewardedToKey    = "BountyRewardedTo"
+	EventAttributeBountyReleaseAtKey     = "BountyReleaseAt"
+	EventAttributeBountyDisputeReasonKey = "BountyDisputeReason"
+	EventAttributeBountyArbiterKey       = "BountyArbiter"
+	EventAttributeBountyDisputeWindowKey = "BountyDisputeWindow"
+	EventAttributeBountyErrorKey         = "BountyError"
 )
 
 const (
@@ -280,6 +424,11 @@ const (
 	BranchCountKey = "Branch-count-"
 )
 
+const (
+	CommitStatusKey      = "CommitStatus-value-"
+	CommitStatusCountKey = "CommitStatus-count-"
+)
+
 const (
 	TagKey      = "Tag-value-"
 	TagCountKey = "Tag-count-"
@@ -293,6 +442,14 @@ const (
 const (
 	BountyKey      = "Bounty-value-"
 	BountyCountKey = "Bounty-count-"
+	// BountyExpiryKey indexes the open bounties by their expiry time
+	BountyExpiryKey = "Bounty-expiry-"
+	// BountyReleaseKey indexes the awarded bounties by their release time
+	BountyReleaseKey = "Bounty-release-"
+)
+
+const (
+	BountyFunderKey = "BountyFunder-value-"
 )
 
 const (
@@ -310,6 +467,46 @@ func GetBranchKeyForRepositoryId(repositoryId uint64) string {
 	return BranchKey + strconv.FormatUint(repositoryId, 10) + "-"
 }
 
+// GetMergeQueueKeyForRepositoryId returns Key from repository-id
+func GetMergeQueueKeyForRepositoryId(repositoryId uint64) string {
+	return MergeQueueKey + strconv.FormatUint(repositoryId, 10) + "-"
+}
+
+// GetRepositoryStarKeyForRepositoryId returns Key from repository-id
+func GetRepositoryStarKeyForRepositoryId(repositoryId uint64) string {
+	return RepositoryStarKey + strconv.FormatUint(repositoryId, 10) + "-"
+}
+
+// GetUserStarKeyForAddress returns Key from address
+func GetUserStarKeyForAddress(address string) string {
+	return UserStarKey + address + "-"
+}
+
+// GetBountyFunderKeyForBountyId returns Key from bounty-id
+func GetBountyFunderKeyForBountyId(bountyId uint64) string {
+	return BountyFunderKey + strconv.FormatUint(bountyId, 10) + "-"
+}
+
+// GetRepositoryWatchKeyForRepositoryId returns Key from repository-id
+func GetRepositoryWatchKeyForRepositoryId(repositoryId uint64) string {
+	return RepositoryWatchKey + strconv.FormatUint(repositoryId, 10) + "-"
+}
+
+// GetUserWatchKeyForAddress returns Key from address
+func GetUserWatchKeyForAddress(address string) string {
+	return UserWatchKey + address + "-"
+}
+
+// GetCommitStatusKeyForRepositoryId returns Key from repository-id
+func GetCommitStatusKeyForRepositoryId(repositoryId uint64) string {
+	return CommitStatusKey + strconv.FormatUint(repositoryId, 10) + "-"
+}
+
+// GetCommitStatusKeyForCommit returns Key from repository-id and commit sha
+func GetCommitStatusKeyForCommit(repositoryId uint64, sha string) string {
+	return GetCommitStatusKeyForRepositoryId(repositoryId) + sha + "-"
+}
+
 // GetTagKeyForRepositoryId returns Key from repository-id
 func GetTagKeyForRepositoryId(repositoryId uint64) string {
 	return TagKey + strconv.FormatUint(repositoryId, 10) + "-"
@@ -320,6 +517,21 @@ func GetMemberKeyForDaoAddress(daoAddress string) string {
 	return MemberKey + daoAddress + "-"
 }
 
+// GetFollowerKeyForAddress returns Key from address
+func GetFollowerKeyForAddress(address string) string {
+	return FollowerKey + address + "-"
+}
+
+// GetFollowingKeyForAddress returns Key from address
+func GetFollowingKeyForAddress(address string) string {
+	return FollowingKey + address + "-"
+}
+
+// GetNotificationKeyForAddress returns Key from address
+func GetNotificationKeyForAddress(address string) string {
+	return NotificationKey + address + "-"
+}
+
 // GetDaoKeyForUserAddress returns Key from dao-address
 func GetUserDaoKeyForUserAddress(userAddress string) string {
 	return UserDaoKey + userAddress + "-"
@@ -335,6 +547,21 @@ func GetPullRequestKeyForRepositoryId(repositoryId uint64) string {
 	return PullRequestKey + strconv.FormatUint(repositoryId, 10) + "-"
 }
 
+// GetMilestoneIssueKeyForMilestone returns Key for the issues of a repository milestone
+func GetMilestoneIssueKeyForMilestone(repositoryId uint64, milestoneId uint64) string {
+	return MilestoneIssueKey + strconv.FormatUint(repositoryId, 10) + "-" + strconv.FormatUint(milestoneId, 10) + "-"
+}
+
+// GetMilestonePullRequestKeyForMilestone returns Key for the pull requests of a repository milestone
+func GetMilestonePullRequestKeyForMilestone(repositoryId uint64, milestoneId uint64) string {
+	return MilestonePullRequestKey + strconv.FormatUint(repositoryId, 10) + "-" + strconv.FormatUint(milestoneId, 10) + "-"
+}
+
+// GetPullRequestReviewKeyForPullRequest returns Key for repository pull request
+func GetPullRequestReviewKeyForPullRequest(repositoryId uint64, pullRequestIid uint64) string {
+	return PullRequestReviewKey + strconv.FormatUint(repositoryId, 10) + "-" + strconv.FormatUint(pullRequestIid, 10) + "-"
+}
+
 // GetCommentKeyForIssue returns Key for repository issue
 func GetCommentKeyForIssue(repositoryId uint64, issueIid uint64) string {
 	return CommentKey + strconv.FormatUint(repositoryId, 10) + "-issue-" + strconv.FormatUint(issueIid, 10) + "-"
@@ -344,3 +571,16 @@ func GetCommentKeyForIssue(repositoryId uint64, issueIid uint64) string {
 func GetCommentKeyForPullRequest(repositoryId uint64, pullRequestIid uint64) string {
 	return CommentKey + strconv.FormatUint(repositoryId, 10) + "-pr-" + strconv.FormatUint(pullRequestIid, 10) + "-"
 }
+
+// GetCommentRevisionKeyForParent returns Key for the comment revisions of a repository issue or pull request
+func GetCommentRevisionKeyForParent(repositoryId uint64, parent CommentParent, parentIid uint64) string {
+	if parent == CommentParentIssue {
+		return CommentRevisionKey + strconv.FormatUint(repositoryId, 10) + "-issue-" + strconv.FormatUint(parentIid, 10) + "-"
+	}
+	return CommentRevisionKey + strconv.FormatUint(repositoryId, 10) + "-pr-" + strconv.FormatUint(parentIid, 10) + "-"
+}
+
+// GetCommentRevisionKeyForComment returns Key for the revisions of a comment, or of the description when commentIid is 0
+func GetCommentRevisionKeyForComment(repositoryId uint64, parent CommentParent, parentIid uint64, commentIid uint64) string {
+	return GetCommentRevisionKeyForParent(repositoryId, parent, parentIid) + strconv.FormatUint(commentIid, 10) + "-"
+}
@@ -0,0 +1,827 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gitopia/merge_queue.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MergeQueue struct {
+	RepositoryId uint64            `protobuf:"varint,1,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	Branch       string            `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Entries      []MergeQueueEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *MergeQueue) Reset()         { *m = MergeQueue{} }
+func (m *MergeQueue) String() string { return proto.CompactTextString(m) }
+func (*MergeQueue) ProtoMessage()    {}
+func (*MergeQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9adc2d78e1bd88, []int{0}
+}
+func (m *MergeQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeQueue.Merge(m, src)
+}
+func (m *MergeQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeQueue proto.InternalMessageInfo
+
+func (m *MergeQueue) GetRepositoryId() uint64 {
+	if m != nil {
+		return m.RepositoryId
+	}
+	return 0
+}
+
+func (m *MergeQueue) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+func (m *MergeQueue) GetEntries() []MergeQueueEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type MergeQueueEntry struct {
+	PullRequestIid uint64      `protobuf:"varint,1,opt,name=pullRequestIid,proto3" json:"pullRequestIid,omitempty"`
+	Creator        string      `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider       string      `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	MergeMethod    MergeMethod `protobuf:"varint,4,opt,name=mergeMethod,proto3,enum=gitopia.gitopia.gitopia.MergeMethod" json:"mergeMethod,omitempty"`
+	Testing        bool        `protobuf:"varint,5,opt,name=testing,proto3" json:"testing,omitempty"`
+	TaskId         uint64      `protobuf:"varint,6,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	CreatedAt      int64       `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (m *MergeQueueEntry) Reset()         { *m = MergeQueueEntry{} }
+func (m *MergeQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*MergeQueueEntry) ProtoMessage()    {}
+func (*MergeQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9adc2d78e1bd88, []int{1}
+}
+func (m *MergeQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeQueueEntry.Merge(m, src)
+}
+func (m *MergeQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeQueueEntry proto.InternalMessageInfo
+
+func (m *MergeQueueEntry) GetPullRequestIid() uint64 {
+	if m != nil {
+		return m.PullRequestIid
+	}
+	return 0
+}
+
+func (m *MergeQueueEntry) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MergeQueueEntry) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MergeQueueEntry) GetMergeMethod() MergeMethod {
+	if m != nil {
+		return m.MergeMethod
+	}
+	return MergeMethod_MERGE
+}
+
+func (m *MergeQueueEntry) GetTesting() bool {
+	if m != nil {
+		return m.Testing
+	}
+	return false
+}
+
+func (m *MergeQueueEntry) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *MergeQueueEntry) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MergeQueue)(nil), "gitopia.gitopia.gitopia.MergeQueue")
+	proto.RegisterType((*MergeQueueEntry)(nil), "gitopia.gitopia.gitopia.MergeQueueEntry")
+}
+
+func init() { proto.RegisterFile("gitopia/merge_queue.proto", fileDescriptor_7a9adc2d78e1bd88) }
+
+var fileDescriptor_7a9adc2d78e1bd88 = []byte{
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xcd, 0x4e, 0xea, 0x50,
+	0x10, 0xee, 0xa1, 0x5c, 0x7e, 0x0e, 0x37, 0xdc, 0xe4, 0xe4, 0xe6, 0xde, 0x23, 0x31, 0xb5, 0x21,
+	0xc6, 0x34, 0x2e, 0x4a, 0x82, 0x4f, 0x20, 0x51, 0x23, 0x0b, 0x16, 0x76, 0xe9, 0xc6, 0x14, 0x3a,
+	0x29, 0x27, 0x42, 0x4f, 0x39, 0x9d, 0x1a, 0x79, 0x02, 0xb7, 0xc6, 0xa7, 0x62, 0xc9, 0xd2, 0x95,
+	0x31, 0xf0, 0x22, 0xa6, 0xa5, 0x07, 0x94, 0x44, 0x57, 0x33, 0xdf, 0xcc, 0x37, 0xdf, 0x7c, 0x99,
+	0xa1, 0x07, 0xa1, 0x40, 0x19, 0x0b, 0xbf, 0x33, 0x05, 0x15, 0xc2, 0xdd, 0x2c, 0x85, 0x14, 0xdc,
+	0x58, 0x49, 0x94, 0xec, 0x7f, 0xd1, 0x72, 0xf7, 0x62, 0xeb, 0x6f, 0x28, 0x43, 0x99, 0x73, 0x3a,
+	0x59, 0xb6, 0xa1, 0xb7, 0xb8, 0x56, 0x52, 0x10, 0xcb, 0x44, 0xa0, 0x54, 0xf3, 0x4d, 0xa7, 0xfd,
+	0x42, 0x28, 0x1d, 0x64, 0xf2, 0x37, 0x99, 0x3a, 0x6b, 0xd3, 0xdf, 0x3b, 0x4a, 0x3f, 0xe0, 0xc4,
+	0x26, 0x4e, 0xd9, 0xfb, 0x52, 0x63, 0xff, 0x68, 0x65, 0xa8, 0xfc, 0x68, 0x34, 0xe6, 0x25, 0x9b,
+	0x38, 0x75, 0xaf, 0x40, 0xec, 0x9a, 0x56, 0x21, 0x42, 0x25, 0x20, 0xe1, 0xa6, 0x6d, 0x3a, 0x8d,
+	0xae, 0xe3, 0x7e, 0xe3, 0xd2, 0xdd, 0x6d, 0xbc, 0x8c, 0x50, 0xcd, 0x7b, 0xe5, 0xc5, 0xdb, 0x91,
+	0xe1, 0xe9, 0xf1, 0xf6, 0x53, 0x89, 0xfe, 0xd9, 0xa3, 0xb0, 0x13, 0xda, 0x8c, 0xd3, 0xc9, 0xc4,
+	0x83, 0x59, 0x0a, 0x09, 0xf6, 0x85, 0xf6, 0xb6, 0x57, 0x65, 0x9c, 0x56, 0x47, 0x0a, 0x7c, 0x94,
+	0xaa, 0xb0, 0xa7, 0x21, 0x6b, 0xd1, 0x5a, 0xac, 0xe4, 0x83, 0x08, 0x40, 0x71, 0x33, 0x6f, 0x6d,
+	0x31, 0xbb, 0xa2, 0x8d, 0xfc, 0xc8, 0x03, 0xc0, 0xb1, 0x0c, 0x78, 0xd9, 0x26, 0x4e, 0xb3, 0x7b,
+	0xfc, 0xb3, 0xff, 0x0d, 0xd7, 0xfb, 0x3c, 0x98, 0x6d, 0x47, 0x48, 0x50, 0x44, 0x21, 0xff, 0x65,
+	0x13, 0xa7, 0xe6, 0x69, 0x98, 0x5d, 0x0d, 0xfd, 0xe4, 0xbe, 0x1f, 0xf0, 0x4a, 0xee, 0xbb, 0x40,
+	0xec, 0x90, 0xd6, 0x73, 0x83, 0x10, 0x9c, 0x23, 0xaf, 0xda, 0xc4, 0x31, 0xbd, 0x5d, 0xa1, 0x77,
+	0xb1, 0x58, 0x59, 0x64, 0xb9, 0xb2, 0xc8, 0xfb, 0xca, 0x22, 0xcf, 0x6b, 0xcb, 0x58, 0xae, 0x2d,
+	0xe3, 0x75, 0x6d, 0x19, 0xb7, 0xa7, 0xa1, 0xc0, 0x71, 0x3a, 0x74, 0x47, 0x72, 0xda, 0xd1, 0xdf,
+	0xd5, 0xf1, 0x71, 0x9b, 0xe1, 0x3c, 0x86, 0x64, 0x58, 0xc9, 0x7f, 0x7d, 0xf6, 0x31, 0x00, 0xb0,
+	0x82, 0x76, 0x39, 0x51, 0x02, 0x00, 0x00,
+}
+
+func (m *MergeQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMergeQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintMergeQueue(dAtA, i, uint64(len(m.Branch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RepositoryId != 0 {
+		i = encodeVarintMergeQueue(dAtA, i, uint64(m.RepositoryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintMergeQueue(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintMergeQueue(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Testing {
+		i--
+		if m.Testing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MergeMethod != 0 {
+		i = encodeVarintMergeQueue(dAtA, i, uint64(m.MergeMethod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintMergeQueue(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintMergeQueue(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PullRequestIid != 0 {
+		i = encodeVarintMergeQueue(dAtA, i, uint64(m.PullRequestIid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMergeQueue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMergeQueue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MergeQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RepositoryId != 0 {
+		n += 1 + sovMergeQueue(uint64(m.RepositoryId))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovMergeQueue(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovMergeQueue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MergeQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PullRequestIid != 0 {
+		n += 1 + sovMergeQueue(uint64(m.PullRequestIid))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovMergeQueue(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovMergeQueue(uint64(l))
+	}
+	if m.MergeMethod != 0 {
+		n += 1 + sovMergeQueue(uint64(m.MergeMethod))
+	}
+	if m.Testing {
+		n += 2
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovMergeQueue(uint64(m.TaskId))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovMergeQueue(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func sovMergeQueue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMergeQueue(x uint64) (n int) {
+	return sovMergeQueue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MergeQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMergeQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMergeQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMergeQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMergeQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMergeQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMergeQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMergeQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMergeQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, MergeQueueEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMergeQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMergeQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMergeQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestIid", wireType)
+			}
+			m.PullRequestIid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMergeQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PullRequestIid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMergeQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMergeQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMergeQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMergeQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMergeQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMergeQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeMethod", wireType)
+			}
+			m.MergeMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMergeQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MergeMethod |= MergeMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Testing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMergeQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Testing = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMergeQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMergeQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMergeQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMergeQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMergeQueue(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMergeQueue
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMergeQueue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMergeQueue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMergeQueue
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMergeQueue
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMergeQueue
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMergeQueue        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMergeQueue          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMergeQueue = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,17 +1,34 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
-	TypeMsgCreateBounty = "create_bounty"
-	TypeMsgUpdateBounty = "update_bounty"
-	TypeMsgCloseBounty  = "close_bounty"
-	TypeMsgDeleteBounty = "delete_bounty"
+	TypeMsgCreateBounty    = "create_bounty"
+	TypeMsgUpdateBounty    = "update_bounty"
+	TypeMsgCloseBounty     = "close_bounty"
+	TypeMsgDeleteBounty    = "delete_bounty"
+	TypeMsgSetBountySplits = "set_bounty_splits"
+	TypeMsgFundBounty      = "fund_bounty"
+	TypeMsgDisputeBounty   = "dispute_bounty"
+	TypeMsgResolveBounty   = "resolve_bounty_dispute"
+	TypeMsgAwardBounty     = "award_bounty"
+	TypeMsgUpdateArbiter   = "update_bounty_arbiter"
 )
 
+// MaxBountyRefundsPerBlock is the number of expired bounties refunded in a block
+const MaxBountyRefundsPerBlock = 50
+
+// MaxBountyReleasesPerBlock is the number of awarded bounties paid out in a block
+const MaxBountyReleasesPerBlock = 50
+
+// MaxBountyDisputeReasonLength is the maximum length of the reason of a bounty dispute
+const MaxBountyDisputeReasonLength = 1000
+
 var _ sdk.Msg = &MsgCreateBounty{}
 
 func NewMsgCreateBounty(creator string, amount []sdk.Coin, expiry int64, repositoryId uint64, parentIid uint64, parent BountyParent) *MsgCreateBounty {
@@ -60,6 +77,9 @@ func (msg *MsgCreateBounty) ValidateBasic() error {
 	if msg.Expiry < 0 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid expiry time")
 	}
+	if _, ok := BountyParent_name[int32(msg.Parent)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
+	}
 	return nil
 }
 
@@ -180,3 +200,341 @@ func (msg *MsgDeleteBounty) ValidateBasic() error {
 	}
 	return nil
 }
+
+var _ sdk.Msg = &MsgSetBountySplits{}
+
+func NewMsgSetBountySplits(creator string, id uint64, splits []*BountySplit) *MsgSetBountySplits {
+	return &MsgSetBountySplits{
+		Creator: creator,
+		Id:      id,
+		Splits:  splits,
+	}
+}
+
+func (msg *MsgSetBountySplits) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetBountySplits) Type() string {
+	return TypeMsgSetBountySplits
+}
+
+func (msg *MsgSetBountySplits) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetBountySplits) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetBountySplits) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateBountySplits(msg.Splits); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgFundBounty{}
+
+func NewMsgFundBounty(creator string, id uint64, amount sdk.Coins) *MsgFundBounty {
+	return &MsgFundBounty{
+		Creator: creator,
+		Id:      id,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgFundBounty) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFundBounty) Type() string {
+	return TypeMsgFundBounty
+}
+
+func (msg *MsgFundBounty) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgFundBounty) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFundBounty) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Amount) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "empty amount")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgDisputeBounty{}
+
+func NewMsgDisputeBounty(creator string, id uint64, reason string) *MsgDisputeBounty {
+	return &MsgDisputeBounty{
+		Creator: creator,
+		Id:      id,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgDisputeBounty) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDisputeBounty) Type() string {
+	return TypeMsgDisputeBounty
+}
+
+func (msg *MsgDisputeBounty) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDisputeBounty) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDisputeBounty) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Reason) < 1 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "reason can't be empty")
+	} else if len(msg.Reason) > MaxBountyDisputeReasonLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "reason length exceeds limit: %d", MaxBountyDisputeReasonLength)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgResolveBountyDispute{}
+
+func NewMsgResolveBountyDispute(creator string, id uint64, recipient string) *MsgResolveBountyDispute {
+	return &MsgResolveBountyDispute{
+		Creator:   creator,
+		Id:        id,
+		Recipient: recipient,
+	}
+}
+
+func (msg *MsgResolveBountyDispute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResolveBountyDispute) Type() string {
+	return TypeMsgResolveBounty
+}
+
+func (msg *MsgResolveBountyDispute) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResolveBountyDispute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResolveBountyDispute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+		}
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgAwardBounty{}
+
+func NewMsgAwardBounty(creator string, id uint64, recipient string) *MsgAwardBounty {
+	return &MsgAwardBounty{
+		Creator:   creator,
+		Id:        id,
+		Recipient: recipient,
+	}
+}
+
+func (msg *MsgAwardBounty) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAwardBounty) Type() string {
+	return TypeMsgAwardBounty
+}
+
+func (msg *MsgAwardBounty) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAwardBounty) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAwardBounty) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateBountyArbiter{}
+
+func NewMsgUpdateBountyArbiter(authority string, arbiter string, disputeWindow int64) *MsgUpdateBountyArbiter {
+	return &MsgUpdateBountyArbiter{
+		Authority:     authority,
+		Arbiter:       arbiter,
+		DisputeWindow: disputeWindow,
+	}
+}
+
+func (msg *MsgUpdateBountyArbiter) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateBountyArbiter) Type() string {
+	return TypeMsgUpdateArbiter
+}
+
+func (msg *MsgUpdateBountyArbiter) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateBountyArbiter) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateBountyArbiter) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if msg.Arbiter != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Arbiter); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid arbiter address (%s)", err)
+		}
+	}
+	if msg.DisputeWindow < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "dispute window must not be negative")
+	}
+	return nil
+}
+
+// MaxBountySplits is the number of splits a bounty can be paid out to
+const MaxBountySplits = 10
+
+// ValidateBountySplits checks that every split has a valid address and either
+// a percentage or a fixed amount, and that the percentages don't exceed 100
+func ValidateBountySplits(splits []*BountySplit) error {
+	if len(splits) > MaxBountySplits {
+		return fmt.Errorf("can't give more than %d splits", MaxBountySplits)
+	}
+
+	var totalPercentage uint64
+	unique := make(map[string]bool, len(splits))
+	for _, split := range splits {
+		if split == nil {
+			return fmt.Errorf("split can't be empty")
+		}
+		if _, err := sdk.AccAddressFromBech32(split.Address); err != nil {
+			return fmt.Errorf("invalid split address (%v)", split.Address)
+		}
+		if unique[split.Address] {
+			return fmt.Errorf("duplicate split address (%v)", split.Address)
+		}
+		unique[split.Address] = true
+
+		if len(split.Rewarded) > 0 {
+			return fmt.Errorf("split rewarded amount can't be set")
+		}
+		switch {
+		case split.Percentage > 0 && len(split.Amount) > 0:
+			return fmt.Errorf("split can't have both percentage and amount")
+		case split.Percentage > 0:
+			if split.Percentage > 100 {
+				return fmt.Errorf("invalid split percentage (%v)", split.Percentage)
+			}
+			totalPercentage += split.Percentage
+		case len(split.Amount) > 0:
+			if err := split.Amount.Validate(); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("split must have a percentage or an amount")
+		}
+	}
+	if totalPercentage > 100 {
+		return fmt.Errorf("split percentages exceed 100")
+	}
+
+	return nil
+}
+
+// ComputeBountyPayouts returns the amount paid to each split and the remaining amount
+// of the bounty. Percentages are rounded down.
+func ComputeBountyPayouts(amount sdk.Coins, splits []*BountySplit) (payouts []sdk.Coins, remainder sdk.Coins, err error) {
+	total := sdk.NewCoins()
+	for _, split := range splits {
+		payout := split.Amount
+		if split.Percentage > 0 {
+			payout = sdk.NewCoins()
+			for _, coin := range amount {
+				payout = payout.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(split.Percentage)).QuoRaw(100)))
+			}
+		}
+		payouts = append(payouts, payout)
+		total = total.Add(payout...)
+	}
+
+	remainder, isNegative := amount.SafeSub(total...)
+	if isNegative {
+		return nil, nil, fmt.Errorf("splits (%v) exceed bounty amount (%v)", total, amount)
+	}
+
+	return payouts, remainder, nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,6 +40,17 @@ func TestMsgCreateBounty_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidRequest,
 		},
+		{
+			name: "invalid parent",
+			msg: MsgCreateBounty{
+				Creator: sample.AccAddress(),
+				Amount: []sdk.Coin{
+					{Denom: params.BaseCoinUnit, Amount: sdk.NewInt(1000)},
+				},
+				Parent: BountyParent(5),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -113,3 +125,340 @@ func TestMsgDeleteBounty_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetBountySplits_ValidateBasic(t *testing.T) {
+	address := sample.AccAddress()
+	amount := sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, sdk.NewInt(100)))
+	tests := []struct {
+		name string
+		msg  MsgSetBountySplits
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetBountySplits{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid split address",
+			msg: MsgSetBountySplits{
+				Creator: sample.AccAddress(),
+				Splits:  []*BountySplit{{Address: "invalid_address", Percentage: 10}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "duplicate split address",
+			msg: MsgSetBountySplits{
+				Creator: sample.AccAddress(),
+				Splits:  []*BountySplit{{Address: address, Percentage: 10}, {Address: address, Amount: amount}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "percentage and amount",
+			msg: MsgSetBountySplits{
+				Creator: sample.AccAddress(),
+				Splits:  []*BountySplit{{Address: address, Percentage: 10, Amount: amount}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "empty split",
+			msg: MsgSetBountySplits{
+				Creator: sample.AccAddress(),
+				Splits:  []*BountySplit{{Address: address}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "percentages exceed 100",
+			msg: MsgSetBountySplits{
+				Creator: sample.AccAddress(),
+				Splits:  []*BountySplit{{Address: address, Percentage: 60}, {Address: sample.AccAddress(), Percentage: 50}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "clear splits",
+			msg: MsgSetBountySplits{
+				Creator: sample.AccAddress(),
+			},
+		},
+		{
+			name: "valid splits",
+			msg: MsgSetBountySplits{
+				Creator: sample.AccAddress(),
+				Splits:  []*BountySplit{{Address: address, Percentage: 60}, {Address: sample.AccAddress(), Amount: amount}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestComputeBountyPayouts(t *testing.T) {
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, sdk.NewInt(amount)))
+	}
+
+	payouts, remainder, err := ComputeBountyPayouts(coins(999), []*BountySplit{
+		{Address: sample.AccAddress(), Percentage: 50},
+		{Address: sample.AccAddress(), Amount: coins(100)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Coins{coins(499), coins(100)}, payouts)
+	require.Equal(t, coins(400), remainder)
+
+	_, _, err = ComputeBountyPayouts(coins(100), []*BountySplit{
+		{Address: sample.AccAddress(), Percentage: 50},
+		{Address: sample.AccAddress(), Amount: coins(51)},
+	})
+	require.Error(t, err)
+}
+
+func TestMsgFundBounty_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFundBounty
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgFundBounty{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty amount",
+			msg: MsgFundBounty{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid amount",
+			msg: MsgFundBounty{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, sdk.NewInt(100))),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDisputeBounty_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDisputeBounty
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDisputeBounty{
+				Creator: "invalid_address",
+				Reason:  "reason",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty reason",
+			msg: MsgDisputeBounty{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "reason exceeds limit",
+			msg: MsgDisputeBounty{
+				Creator: sample.AccAddress(),
+				Reason:  strings.Repeat("r", MaxBountyDisputeReasonLength+1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid reason",
+			msg: MsgDisputeBounty{
+				Creator: sample.AccAddress(),
+				Reason:  "reason",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgResolveBountyDispute_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgResolveBountyDispute
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgResolveBountyDispute{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid recipient",
+			msg: MsgResolveBountyDispute{
+				Creator:   sample.AccAddress(),
+				Recipient: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "refund",
+			msg: MsgResolveBountyDispute{
+				Creator: sample.AccAddress(),
+			},
+		},
+		{
+			name: "valid recipient",
+			msg: MsgResolveBountyDispute{
+				Creator:   sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAwardBounty_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAwardBounty
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAwardBounty{
+				Creator:   "invalid_address",
+				Recipient: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid recipient",
+			msg: MsgAwardBounty{
+				Creator:   sample.AccAddress(),
+				Recipient: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid recipient",
+			msg: MsgAwardBounty{
+				Creator:   sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateBountyArbiter_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateBountyArbiter
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg: MsgUpdateBountyArbiter{
+				Authority: "invalid_address",
+				Arbiter:   sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid arbiter",
+			msg: MsgUpdateBountyArbiter{
+				Authority: sample.AccAddress(),
+				Arbiter:   "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "negative dispute window",
+			msg: MsgUpdateBountyArbiter{
+				Authority:     sample.AccAddress(),
+				Arbiter:       sample.AccAddress(),
+				DisputeWindow: -1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid arbiter",
+			msg: MsgUpdateBountyArbiter{
+				Authority:     sample.AccAddress(),
+				Arbiter:       sample.AccAddress(),
+				DisputeWindow: 60,
+			},
+		},
+		{
+			name: "disable disputes",
+			msg: MsgUpdateBountyArbiter{
+				Authority: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
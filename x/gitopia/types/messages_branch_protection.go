@@ -0,0 +1,221 @@
+package types
+
+import (
+	"fmt"
+	"path"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateBranchProtectionRule = "create_branch_protection_rule"
+	TypeMsgUpdateBranchProtectionRule = "update_branch_protection_rule"
+	TypeMsgDeleteBranchProtectionRule = "delete_branch_protection_rule"
+)
+
+const (
+	MaxBranchProtectionRequiredApprovals = 10
+	MaxBranchProtectionStatusChecks      = 20
+	MaxBranchProtectionAllowedPushers    = 20
+)
+
+var _ sdk.Msg = &MsgCreateBranchProtectionRule{}
+
+func NewMsgCreateBranchProtectionRule(creator string, repositoryId RepositoryId, pattern string, requiredApprovals uint64, requiredStatusChecks []string, allowedPushers []string, requireLinearHistory bool, preventDeletion bool, requireCodeOwnerReview bool, requireConversationResolution bool) *MsgCreateBranchProtectionRule {
+	return &MsgCreateBranchProtectionRule{
+		Creator:                       creator,
+		RepositoryId:                  repositoryId,
+		Pattern:                       pattern,
+		RequiredApprovals:             requiredApprovals,
+		RequiredStatusChecks:          requiredStatusChecks,
+		AllowedPushers:                allowedPushers,
+		RequireLinearHistory:          requireLinearHistory,
+		PreventDeletion:               preventDeletion,
+		RequireCodeOwnerReview:        requireCodeOwnerReview,
+		RequireConversationResolution: requireConversationResolution,
+	}
+}
+
+func (msg *MsgCreateBranchProtectionRule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateBranchProtectionRule) Type() string {
+	return TypeMsgCreateBranchProtectionRule
+}
+
+func (msg *MsgCreateBranchProtectionRule) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateBranchProtectionRule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateBranchProtectionRule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateBranchProtectionRule(msg.Pattern, msg.RequiredApprovals, msg.RequiredStatusChecks, msg.AllowedPushers); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateBranchProtectionRule{}
+
+func NewMsgUpdateBranchProtectionRule(creator string, repositoryId RepositoryId, ruleId uint64, pattern string, requiredApprovals uint64, requiredStatusChecks []string, allowedPushers []string, requireLinearHistory bool, preventDeletion bool, requireCodeOwnerReview bool, requireConversationResolution bool) *MsgUpdateBranchProtectionRule {
+	return &MsgUpdateBranchProtectionRule{
+		Creator:                       creator,
+		RepositoryId:                  repositoryId,
+		RuleId:                        ruleId,
+		Pattern:                       pattern,
+		RequiredApprovals:             requiredApprovals,
+		RequiredStatusChecks:          requiredStatusChecks,
+		AllowedPushers:                allowedPushers,
+		RequireLinearHistory:          requireLinearHistory,
+		PreventDeletion:               preventDeletion,
+		RequireCodeOwnerReview:        requireCodeOwnerReview,
+		RequireConversationResolution: requireConversationResolution,
+	}
+}
+
+func (msg *MsgUpdateBranchProtectionRule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateBranchProtectionRule) Type() string {
+	return TypeMsgUpdateBranchProtectionRule
+}
+
+func (msg *MsgUpdateBranchProtectionRule) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateBranchProtectionRule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateBranchProtectionRule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateBranchProtectionRule(msg.Pattern, msg.RequiredApprovals, msg.RequiredStatusChecks, msg.AllowedPushers); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgDeleteBranchProtectionRule{}
+
+func NewMsgDeleteBranchProtectionRule(creator string, repositoryId RepositoryId, ruleId uint64) *MsgDeleteBranchProtectionRule {
+	return &MsgDeleteBranchProtectionRule{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		RuleId:       ruleId,
+	}
+}
+
+func (msg *MsgDeleteBranchProtectionRule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeleteBranchProtectionRule) Type() string {
+	return TypeMsgDeleteBranchProtectionRule
+}
+
+func (msg *MsgDeleteBranchProtectionRule) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDeleteBranchProtectionRule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeleteBranchProtectionRule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBranchProtectionRule validates the user supplied fields of a branch protection rule.
+// Patterns use the glob syntax of path.Match, e.g. "main" or "release/*".
+func ValidateBranchProtectionRule(pattern string, requiredApprovals uint64, requiredStatusChecks []string, allowedPushers []string) error {
+	if len(pattern) < 1 {
+		return fmt.Errorf("branch pattern can't be empty")
+	} else if len(pattern) > 255 {
+		return fmt.Errorf("branch pattern exceeds limit: 255")
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid branch pattern (%v)", pattern)
+	}
+
+	if requiredApprovals > MaxBranchProtectionRequiredApprovals {
+		return fmt.Errorf("required approvals exceeds limit: %d", MaxBranchProtectionRequiredApprovals)
+	}
+
+	if len(requiredStatusChecks) > MaxBranchProtectionStatusChecks {
+		return fmt.Errorf("required status checks exceeds limit: %d", MaxBranchProtectionStatusChecks)
+	}
+	for _, context := range requiredStatusChecks {
+		if len(context) < 1 {
+			return fmt.Errorf("status check context can't be empty")
+		} else if len(context) > 255 {
+			return fmt.Errorf("status check context exceeds limit: 255")
+		}
+	}
+	if !allUnique(requiredStatusChecks) {
+		return fmt.Errorf("duplicate status check context")
+	}
+
+	if len(allowedPushers) > MaxBranchProtectionAllowedPushers {
+		return fmt.Errorf("allowed pushers exceeds limit: %d", MaxBranchProtectionAllowedPushers)
+	}
+	for _, pusher := range allowedPushers {
+		if _, err := sdk.AccAddressFromBech32(pusher); err != nil {
+			return fmt.Errorf("invalid pusher address (%v)", pusher)
+		}
+	}
+	if !allUnique(allowedPushers) {
+		return fmt.Errorf("duplicate pusher address")
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateBranchProtectionRule_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+	pusher := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  MsgCreateBranchProtectionRule
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateBranchProtectionRule{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+				Pattern:      "main",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgCreateBranchProtectionRule",
+			msg: MsgCreateBranchProtectionRule{
+				Creator:              sample.AccAddress(),
+				RepositoryId:         repositoryId,
+				Pattern:              "release/*",
+				RequiredApprovals:    2,
+				RequiredStatusChecks: []string{"ci/build", "ci/test"},
+				AllowedPushers:       []string{pusher},
+				RequireLinearHistory: true,
+				PreventDeletion:      true,
+			},
+		}, {
+			name: "empty pattern",
+			msg: MsgCreateBranchProtectionRule{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "pattern exceeds limit",
+			msg: MsgCreateBranchProtectionRule{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Pattern:      strings.Repeat("b", 256),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "malformed pattern",
+			msg: MsgCreateBranchProtectionRule{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Pattern:      "release/[",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "required approvals exceeds limit",
+			msg: MsgCreateBranchProtectionRule{
+				Creator:           sample.AccAddress(),
+				RepositoryId:      repositoryId,
+				Pattern:           "main",
+				RequiredApprovals: MaxBranchProtectionRequiredApprovals + 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty status check context",
+			msg: MsgCreateBranchProtectionRule{
+				Creator:              sample.AccAddress(),
+				RepositoryId:         repositoryId,
+				Pattern:              "main",
+				RequiredStatusChecks: []string{""},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate status check context",
+			msg: MsgCreateBranchProtectionRule{
+				Creator:              sample.AccAddress(),
+				RepositoryId:         repositoryId,
+				Pattern:              "main",
+				RequiredStatusChecks: []string{"ci/build", "ci/build"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid pusher address",
+			msg: MsgCreateBranchProtectionRule{
+				Creator:        sample.AccAddress(),
+				RepositoryId:   repositoryId,
+				Pattern:        "main",
+				AllowedPushers: []string{"invalid_address"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate pusher address",
+			msg: MsgCreateBranchProtectionRule{
+				Creator:        sample.AccAddress(),
+				RepositoryId:   repositoryId,
+				Pattern:        "main",
+				AllowedPushers: []string{pusher, pusher},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateBranchProtectionRule_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgUpdateBranchProtectionRule
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateBranchProtectionRule{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+				RuleId:       1,
+				Pattern:      "main",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgUpdateBranchProtectionRule",
+			msg: MsgUpdateBranchProtectionRule{
+				Creator:           sample.AccAddress(),
+				RepositoryId:      repositoryId,
+				RuleId:            1,
+				Pattern:           "main",
+				RequiredApprovals: 1,
+			},
+		}, {
+			name: "empty pattern",
+			msg: MsgUpdateBranchProtectionRule{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				RuleId:       1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDeleteBranchProtectionRule_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgDeleteBranchProtectionRule
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDeleteBranchProtectionRule{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+				RuleId:       1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgDeleteBranchProtectionRule",
+			msg: MsgDeleteBranchProtectionRule{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				RuleId:       1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
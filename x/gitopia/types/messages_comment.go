@@ -5,9 +5,12 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxCommentRevisions is the number of previous bodies kept in the edit history of a comment or description
+const MaxCommentRevisions = 20
+
 var _ sdk.Msg = &MsgCreateComment{}
 
-func NewMsgCreateComment(creator string, repositoryid uint64, parentIid uint64, parent CommentParent, body string, attachments []*Attachment, diffHunk string, path string, position uint64) *MsgCreateComment {
+func NewMsgCreateComment(creator string, repositoryid uint64, parentIid uint64, parent CommentParent, body string, attachments []*Attachment, diffHunk string, path string, position uint64, inReplyTo uint64) *MsgCreateComment {
 	return &MsgCreateComment{
 		Creator:      creator,
 		RepositoryId: repositoryid,
@@ -18,6 +21,7 @@ func NewMsgCreateComment(creator string, repositoryid uint64, parentIid uint64,
 		DiffHunk:     diffHunk,
 		Path:         path,
 		Position:     position,
+		InReplyTo:    inReplyTo,
 	}
 }
 
@@ -55,7 +59,13 @@ func (msg *MsgCreateComment) ValidateBasic() error {
 		if len(msg.DiffHunk) > 0 {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Cannot provide DiffHunk with comment parent issue")
 		}
+		if msg.InReplyTo != 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Cannot reply to a review comment with comment parent issue")
+		}
 	case CommentParentPullRequest:
+		if msg.InReplyTo != 0 && (len(msg.Path) > 0 || len(msg.DiffHunk) > 0) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Cannot provide Path or DiffHunk with a review comment reply")
+		}
 		if len(msg.Path) > 255 {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Path exceeds limit: 255")
 		}
@@ -227,3 +237,336 @@ func (msg *MsgDeleteComment) ValidateBasic() error {
 	}
 	return nil
 }
+
+var _ sdk.Msg = &MsgToggleReaction{}
+
+func NewMsgToggleReaction(creator string, repositoryId uint64, parentIid uint64, parent CommentParent, commentIid uint64, emoji Emoji) *MsgToggleReaction {
+	return &MsgToggleReaction{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		ParentIid:    parentIid,
+		Parent:       parent,
+		CommentIid:   commentIid,
+		Emoji:        emoji,
+	}
+}
+
+func (msg *MsgToggleReaction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgToggleReaction) Type() string {
+	return "ToggleReaction"
+}
+
+func (msg *MsgToggleReaction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgToggleReaction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgToggleReaction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	switch msg.Parent {
+	case CommentParentIssue:
+	case CommentParentPullRequest:
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parent (%s)", msg.Parent)
+	}
+	if _, exists := Emoji_name[int32(msg.Emoji)]; !exists {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid emoji (%v)", msg.Emoji)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgResolveCommentThread{}
+
+func NewMsgResolveCommentThread(creator string, repositoryId uint64, pullRequestIid uint64, commentIid uint64) *MsgResolveCommentThread {
+	return &MsgResolveCommentThread{
+		Creator:        creator,
+		RepositoryId:   repositoryId,
+		PullRequestIid: pullRequestIid,
+		CommentIid:     commentIid,
+	}
+}
+
+func (msg *MsgResolveCommentThread) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResolveCommentThread) Type() string {
+	return "ResolveCommentThread"
+}
+
+func (msg *MsgResolveCommentThread) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResolveCommentThread) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResolveCommentThread) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.CommentIid == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid comment iid (%d)", msg.CommentIid)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnresolveCommentThread{}
+
+func NewMsgUnresolveCommentThread(creator string, repositoryId uint64, pullRequestIid uint64, commentIid uint64) *MsgUnresolveCommentThread {
+	return &MsgUnresolveCommentThread{
+		Creator:        creator,
+		RepositoryId:   repositoryId,
+		PullRequestIid: pullRequestIid,
+		CommentIid:     commentIid,
+	}
+}
+
+func (msg *MsgUnresolveCommentThread) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnresolveCommentThread) Type() string {
+	return "UnresolveCommentThread"
+}
+
+func (msg *MsgUnresolveCommentThread) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnresolveCommentThread) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnresolveCommentThread) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.CommentIid == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid comment iid (%d)", msg.CommentIid)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgHideComment{}
+
+func NewMsgHideComment(creator string, repositoryId uint64, parentIid uint64, parent CommentParent, commentIid uint64, reason CommentHiddenReason) *MsgHideComment {
+	return &MsgHideComment{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		ParentIid:    parentIid,
+		Parent:       parent,
+		CommentIid:   commentIid,
+		Reason:       reason,
+	}
+}
+
+func (msg *MsgHideComment) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgHideComment) Type() string {
+	return "HideComment"
+}
+
+func (msg *MsgHideComment) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgHideComment) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgHideComment) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	switch msg.Parent {
+	case CommentParentIssue:
+	case CommentParentPullRequest:
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parent (%s)", msg.Parent)
+	}
+	if msg.CommentIid == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid comment iid (%d)", msg.CommentIid)
+	}
+	if _, exists := CommentHiddenReason_name[int32(msg.Reason)]; !exists || msg.Reason == CommentHiddenReasonNone {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid reason (%v)", msg.Reason)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnhideComment{}
+
+func NewMsgUnhideComment(creator string, repositoryId uint64, parentIid uint64, parent CommentParent, commentIid uint64) *MsgUnhideComment {
+	return &MsgUnhideComment{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		ParentIid:    parentIid,
+		Parent:       parent,
+		CommentIid:   commentIid,
+	}
+}
+
+func (msg *MsgUnhideComment) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnhideComment) Type() string {
+	return "UnhideComment"
+}
+
+func (msg *MsgUnhideComment) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnhideComment) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnhideComment) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	switch msg.Parent {
+	case CommentParentIssue:
+	case CommentParentPullRequest:
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parent (%s)", msg.Parent)
+	}
+	if msg.CommentIid == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid comment iid (%d)", msg.CommentIid)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgLockConversation{}
+
+func NewMsgLockConversation(creator string, repositoryId uint64, parentIid uint64, parent CommentParent) *MsgLockConversation {
+	return &MsgLockConversation{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		ParentIid:    parentIid,
+		Parent:       parent,
+	}
+}
+
+func (msg *MsgLockConversation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgLockConversation) Type() string {
+	return "LockConversation"
+}
+
+func (msg *MsgLockConversation) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgLockConversation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgLockConversation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	switch msg.Parent {
+	case CommentParentIssue:
+	case CommentParentPullRequest:
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parent (%s)", msg.Parent)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnlockConversation{}
+
+func NewMsgUnlockConversation(creator string, repositoryId uint64, parentIid uint64, parent CommentParent) *MsgUnlockConversation {
+	return &MsgUnlockConversation{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		ParentIid:    parentIid,
+		Parent:       parent,
+	}
+}
+
+func (msg *MsgUnlockConversation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnlockConversation) Type() string {
+	return "UnlockConversation"
+}
+
+func (msg *MsgUnlockConversation) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnlockConversation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnlockConversation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	switch msg.Parent {
+	case CommentParentIssue:
+	case CommentParentPullRequest:
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parent (%s)", msg.Parent)
+	}
+	return nil
+}
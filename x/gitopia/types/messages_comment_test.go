@@ -35,6 +35,33 @@ func TestMsgCreateComment_ValidateBasic(t *testing.T) {
 				Parent:  9,
 			},
 			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "issue comment reply",
+			msg: MsgCreateComment{
+				Creator:   sample.AccAddress(),
+				Parent:    CommentParentIssue,
+				Body:      "comment",
+				InReplyTo: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "review comment reply with diffhunk",
+			msg: MsgCreateComment{
+				Creator:   sample.AccAddress(),
+				Parent:    CommentParentPullRequest,
+				Body:      "comment",
+				DiffHunk:  "@@ -1 +1 @@",
+				InReplyTo: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "review comment reply",
+			msg: MsgCreateComment{
+				Creator:   sample.AccAddress(),
+				Parent:    CommentParentPullRequest,
+				Body:      "comment",
+				InReplyTo: 1,
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -113,3 +140,313 @@ func TestMsgDeleteComment_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgToggleReaction_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgToggleReaction
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgToggleReaction{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid parent",
+			msg: MsgToggleReaction{
+				Creator: sample.AccAddress(),
+				Parent:  CommentParentNone,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid emoji",
+			msg: MsgToggleReaction{
+				Creator: sample.AccAddress(),
+				Parent:  CommentParentIssue,
+				Emoji:   Emoji(100),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgToggleReaction{
+				Creator: sample.AccAddress(),
+				Parent:  CommentParentPullRequest,
+				Emoji:   EmojiRocket,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgResolveCommentThread_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgResolveCommentThread
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgResolveCommentThread{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid comment iid",
+			msg: MsgResolveCommentThread{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgResolveCommentThread{
+				Creator:    sample.AccAddress(),
+				CommentIid: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnresolveCommentThread_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnresolveCommentThread
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnresolveCommentThread{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid comment iid",
+			msg: MsgUnresolveCommentThread{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgUnresolveCommentThread{
+				Creator:    sample.AccAddress(),
+				CommentIid: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgHideComment_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgHideComment
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgHideComment{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid parent",
+			msg: MsgHideComment{
+				Creator:    sample.AccAddress(),
+				Parent:     CommentParentNone,
+				CommentIid: 1,
+				Reason:     CommentHiddenReasonSpam,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid comment iid",
+			msg: MsgHideComment{
+				Creator: sample.AccAddress(),
+				Parent:  CommentParentIssue,
+				Reason:  CommentHiddenReasonSpam,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing reason",
+			msg: MsgHideComment{
+				Creator:    sample.AccAddress(),
+				Parent:     CommentParentIssue,
+				CommentIid: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid reason",
+			msg: MsgHideComment{
+				Creator:    sample.AccAddress(),
+				Parent:     CommentParentIssue,
+				CommentIid: 1,
+				Reason:     CommentHiddenReason(100),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgHideComment{
+				Creator:    sample.AccAddress(),
+				Parent:     CommentParentPullRequest,
+				CommentIid: 1,
+				Reason:     CommentHiddenReasonOffTopic,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnhideComment_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnhideComment
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnhideComment{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid comment iid",
+			msg: MsgUnhideComment{
+				Creator: sample.AccAddress(),
+				Parent:  CommentParentIssue,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgUnhideComment{
+				Creator:    sample.AccAddress(),
+				Parent:     CommentParentIssue,
+				CommentIid: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgLockConversation_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgLockConversation
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgLockConversation{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid parent",
+			msg: MsgLockConversation{
+				Creator: sample.AccAddress(),
+				Parent:  CommentParentNone,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgLockConversation{
+				Creator: sample.AccAddress(),
+				Parent:  CommentParentPullRequest,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnlockConversation_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnlockConversation
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnlockConversation{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid parent",
+			msg: MsgUnlockConversation{
+				Creator: sample.AccAddress(),
+				Parent:  CommentParentNone,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgUnlockConversation{
+				Creator: sample.AccAddress(),
+				Parent:  CommentParentPullRequest,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"net/url"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSetCommitStatus = "set_commit_status"
+)
+
+const (
+	MaxCommitStatusContextLength     = 255
+	MaxCommitStatusTargetUrlLength   = 2048
+	MaxCommitStatusDescriptionLength = 255
+)
+
+var _ sdk.Msg = &MsgSetCommitStatus{}
+
+func NewMsgSetCommitStatus(creator string, repositoryId RepositoryId, sha string, context string, state CommitStatus_State, targetUrl string, description string) *MsgSetCommitStatus {
+	return &MsgSetCommitStatus{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Sha:          sha,
+		Context:      context,
+		State:        state,
+		TargetUrl:    targetUrl,
+		Description:  description,
+	}
+}
+
+func (msg *MsgSetCommitStatus) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetCommitStatus) Type() string {
+	return TypeMsgSetCommitStatus
+}
+
+func (msg *MsgSetCommitStatus) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetCommitStatus) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetCommitStatus) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	isShaValid, _ := regexp.MatchString("^([0-9a-f]{40}|[0-9a-f]{64})$", msg.Sha)
+	if !isShaValid {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid sha")
+	}
+
+	if len(msg.Context) < 1 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "context can't be empty")
+	} else if len(msg.Context) > MaxCommitStatusContextLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "context length exceeds limit: %d", MaxCommitStatusContextLength)
+	}
+
+	if _, exists := CommitStatus_State_name[int32(msg.State)]; !exists {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid state (%v)", msg.State)
+	}
+
+	if len(msg.TargetUrl) > MaxCommitStatusTargetUrlLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "targetUrl length exceeds limit: %d", MaxCommitStatusTargetUrlLength)
+	} else if msg.TargetUrl != "" {
+		if _, err := url.ParseRequestURI(msg.TargetUrl); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid targetUrl (%v)", msg.TargetUrl)
+		}
+	}
+
+	if len(msg.Description) > MaxCommitStatusDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "description length exceeds limit: %d", MaxCommitStatusDescriptionLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetCommitStatus_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+	sha := strings.Repeat("a", 40)
+
+	tests := []struct {
+		name string
+		msg  MsgSetCommitStatus
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetCommitStatus{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+				Sha:          sha,
+				Context:      "ci/build",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgSetCommitStatus",
+			msg: MsgSetCommitStatus{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Sha:          sha,
+				Context:      "ci/build",
+				State:        CommitStatus_SUCCESS,
+				TargetUrl:    "https://ci.example.com/builds/1",
+				Description:  "build succeeded",
+			},
+		}, {
+			name: "invalid sha",
+			msg: MsgSetCommitStatus{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Sha:          "sha",
+				Context:      "ci/build",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty context",
+			msg: MsgSetCommitStatus{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Sha:          sha,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "context exceeds limit",
+			msg: MsgSetCommitStatus{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Sha:          sha,
+				Context:      strings.Repeat("c", MaxCommitStatusContextLength+1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid state",
+			msg: MsgSetCommitStatus{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Sha:          sha,
+				Context:      "ci/build",
+				State:        CommitStatus_State(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid target url",
+			msg: MsgSetCommitStatus{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Sha:          sha,
+				Context:      "ci/build",
+				TargetUrl:    "ci.example.com",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "description exceeds limit",
+			msg: MsgSetCommitStatus{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Sha:          sha,
+				Context:      "ci/build",
+				Description:  strings.Repeat("d", MaxCommitStatusDescriptionLength+1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgFollow{}
+
+func NewMsgFollow(creator string, id string) *MsgFollow {
+	return &MsgFollow{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgFollow) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFollow) Type() string {
+	return "Follow"
+}
+
+func (msg *MsgFollow) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgFollow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFollow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateId(msg.Id); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnfollow{}
+
+func NewMsgUnfollow(creator string, id string) *MsgUnfollow {
+	return &MsgUnfollow{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgUnfollow) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnfollow) Type() string {
+	return "Unfollow"
+}
+
+func (msg *MsgUnfollow) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnfollow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnfollow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateId(msg.Id); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
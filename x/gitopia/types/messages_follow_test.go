@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFollow_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFollow
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgFollow{
+				Creator: "invalid_address",
+				Id:      sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid id",
+			msg: MsgFollow{
+				Creator: sample.AccAddress(),
+				Id:      "-invalid",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid MsgFollow",
+			msg: MsgFollow{
+				Creator: sample.AccAddress(),
+				Id:      "username",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnfollow_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnfollow
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnfollow{
+				Creator: "invalid_address",
+				Id:      sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid id",
+			msg: MsgUnfollow{
+				Creator: sample.AccAddress(),
+				Id:      "-invalid",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid MsgUnfollow",
+			msg: MsgUnfollow{
+				Creator: sample.AccAddress(),
+				Id:      "username",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
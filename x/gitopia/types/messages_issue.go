@@ -13,7 +13,7 @@ func (r IssueList) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
 
 var _ sdk.Msg = &MsgCreateIssue{}
 
-func NewMsgCreateIssue(creator string, repositoryId RepositoryId, title string, description string, labelIds []uint64, weight uint64, assignees []string, bountyAmount []sdk.Coin, bountyExpiry int64) *MsgCreateIssue {
+func NewMsgCreateIssue(creator string, repositoryId RepositoryId, title string, description string, labelIds []uint64, weight uint64, assignees []string, bountyAmount []sdk.Coin, bountyExpiry int64, templateId uint64) *MsgCreateIssue {
 	return &MsgCreateIssue{
 		Creator:      creator,
 		RepositoryId: repositoryId,
@@ -24,6 +24,7 @@ func NewMsgCreateIssue(creator string, repositoryId RepositoryId, title string,
 		Assignees:    assignees,
 		BountyAmount: bountyAmount,
 		BountyExpiry: bountyExpiry,
+		TemplateId:   templateId,
 	}
 }
 
@@ -499,3 +500,48 @@ func (msg *MsgDeleteIssue) GetSignBytes() []byte {
 func (msg *MsgDeleteIssue) ValidateBasic() error {
 	return sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "tx WIP")
 }
+
+var _ sdk.Msg = &MsgTransferIssue{}
+
+func NewMsgTransferIssue(creator string, repositoryId uint64, iid uint64, targetRepositoryId RepositoryId) *MsgTransferIssue {
+	return &MsgTransferIssue{
+		Creator:            creator,
+		RepositoryId:       repositoryId,
+		Iid:                iid,
+		TargetRepositoryId: targetRepositoryId,
+	}
+}
+
+func (msg *MsgTransferIssue) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferIssue) Type() string {
+	return "TransferIssue"
+}
+
+func (msg *MsgTransferIssue) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferIssue) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferIssue) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.TargetRepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateRepositoryIssueTemplate = "create_repository_issue_template"
+	TypeMsgUpdateRepositoryIssueTemplate = "update_repository_issue_template"
+	TypeMsgDeleteRepositoryIssueTemplate = "delete_repository_issue_template"
+)
+
+var _ sdk.Msg = &MsgCreateRepositoryIssueTemplate{}
+
+func NewMsgCreateRepositoryIssueTemplate(creator string, repositoryId RepositoryId, name string, body string, labelIds []uint64, assignees []string, requiredLabelGroups []*IssueTemplateLabelGroup) *MsgCreateRepositoryIssueTemplate {
+	return &MsgCreateRepositoryIssueTemplate{
+		Creator:             creator,
+		RepositoryId:        repositoryId,
+		Name:                name,
+		Body:                body,
+		LabelIds:            labelIds,
+		Assignees:           assignees,
+		RequiredLabelGroups: requiredLabelGroups,
+	}
+}
+
+func (msg *MsgCreateRepositoryIssueTemplate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateRepositoryIssueTemplate) Type() string {
+	return TypeMsgCreateRepositoryIssueTemplate
+}
+
+func (msg *MsgCreateRepositoryIssueTemplate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateRepositoryIssueTemplate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateRepositoryIssueTemplate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateRepositoryIssueTemplate(msg.Name, msg.Body, msg.LabelIds, msg.Assignees, msg.RequiredLabelGroups); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateRepositoryIssueTemplate{}
+
+func NewMsgUpdateRepositoryIssueTemplate(creator string, repositoryId RepositoryId, templateId uint64, name string, body string, labelIds []uint64, assignees []string, requiredLabelGroups []*IssueTemplateLabelGroup) *MsgUpdateRepositoryIssueTemplate {
+	return &MsgUpdateRepositoryIssueTemplate{
+		Creator:             creator,
+		RepositoryId:        repositoryId,
+		TemplateId:          templateId,
+		Name:                name,
+		Body:                body,
+		LabelIds:            labelIds,
+		Assignees:           assignees,
+		RequiredLabelGroups: requiredLabelGroups,
+	}
+}
+
+func (msg *MsgUpdateRepositoryIssueTemplate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateRepositoryIssueTemplate) Type() string {
+	return TypeMsgUpdateRepositoryIssueTemplate
+}
+
+func (msg *MsgUpdateRepositoryIssueTemplate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateRepositoryIssueTemplate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateRepositoryIssueTemplate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateRepositoryIssueTemplate(msg.Name, msg.Body, msg.LabelIds, msg.Assignees, msg.RequiredLabelGroups); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgDeleteRepositoryIssueTemplate{}
+
+func NewMsgDeleteRepositoryIssueTemplate(creator string, repositoryId RepositoryId, templateId uint64) *MsgDeleteRepositoryIssueTemplate {
+	return &MsgDeleteRepositoryIssueTemplate{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		TemplateId:   templateId,
+	}
+}
+
+func (msg *MsgDeleteRepositoryIssueTemplate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeleteRepositoryIssueTemplate) Type() string {
+	return TypeMsgDeleteRepositoryIssueTemplate
+}
+
+func (msg *MsgDeleteRepositoryIssueTemplate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDeleteRepositoryIssueTemplate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeleteRepositoryIssueTemplate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func validateLabelIds(labelIds []uint64) error {
+	if len(labelIds) > 10 {
+		return fmt.Errorf("can't give more than 10 labels at a time")
+	}
+
+	unique := make(map[uint64]bool, len(labelIds))
+	for _, labelId := range labelIds {
+		if unique[labelId] {
+			return fmt.Errorf("duplicate label (%v)", labelId)
+		}
+		unique[labelId] = true
+	}
+
+	return nil
+}
+
+func ValidateRepositoryIssueTemplate(name string, body string, labelIds []uint64, assignees []string, requiredLabelGroups []*IssueTemplateLabelGroup) error {
+	if len(name) > 255 {
+		return fmt.Errorf("name length exceeds limit: 255")
+	} else if len(name) < 3 {
+		return fmt.Errorf("name too short")
+	}
+	if len(body) > 20000 {
+		return fmt.Errorf("body length exceeds limit: 20000")
+	}
+
+	if err := validateLabelIds(labelIds); err != nil {
+		return err
+	}
+
+	if len(assignees) > 10 {
+		return fmt.Errorf("can't give more than 10 assignees at a time")
+	}
+	uniqueAssignees := make(map[string]bool, len(assignees))
+	for _, assignee := range assignees {
+		if _, err := sdk.AccAddressFromBech32(assignee); err != nil {
+			return fmt.Errorf("invalid assignee (%v)", assignee)
+		}
+		if uniqueAssignees[assignee] {
+			return fmt.Errorf("duplicate assignee (%s)", assignee)
+		}
+		uniqueAssignees[assignee] = true
+	}
+
+	if len(requiredLabelGroups) > 10 {
+		return fmt.Errorf("can't give more than 10 required label groups")
+	}
+	uniqueGroups := make(map[string]bool, len(requiredLabelGroups))
+	for _, group := range requiredLabelGroups {
+		if group == nil || len(group.Labels) == 0 {
+			return fmt.Errorf("required label group can't be empty")
+		}
+		if len(group.Name) > 63 {
+			return fmt.Errorf("required label group name length exceeds limit: 63")
+		}
+		if uniqueGroups[group.Name] {
+			return fmt.Errorf("duplicate required label group (%v)", group.Name)
+		}
+		uniqueGroups[group.Name] = true
+
+		if err := validateLabelIds(group.Labels); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
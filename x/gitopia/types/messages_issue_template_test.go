@@ -0,0 +1,152 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateRepositoryIssueTemplate_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+	assignee := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  MsgCreateRepositoryIssueTemplate
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateRepositoryIssueTemplate{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+				Name:         "bug report",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgCreateRepositoryIssueTemplate",
+			msg: MsgCreateRepositoryIssueTemplate{
+				Creator:             sample.AccAddress(),
+				RepositoryId:        repositoryId,
+				Name:                "bug report",
+				Body:                "steps to reproduce",
+				LabelIds:            []uint64{1},
+				Assignees:           []string{assignee},
+				RequiredLabelGroups: []*IssueTemplateLabelGroup{{Name: "area", Labels: []uint64{2, 3}}},
+			},
+		}, {
+			name: "name too short",
+			msg: MsgCreateRepositoryIssueTemplate{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Name:         "bu",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "body exceeds limit",
+			msg: MsgCreateRepositoryIssueTemplate{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Name:         "bug report",
+				Body:         strings.Repeat("b", 20001),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate label",
+			msg: MsgCreateRepositoryIssueTemplate{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Name:         "bug report",
+				LabelIds:     []uint64{1, 1},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid assignee",
+			msg: MsgCreateRepositoryIssueTemplate{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Name:         "bug report",
+				Assignees:    []string{"invalid_address"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate assignee",
+			msg: MsgCreateRepositoryIssueTemplate{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Name:         "bug report",
+				Assignees:    []string{assignee, assignee},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty required label group",
+			msg: MsgCreateRepositoryIssueTemplate{
+				Creator:             sample.AccAddress(),
+				RepositoryId:        repositoryId,
+				Name:                "bug report",
+				RequiredLabelGroups: []*IssueTemplateLabelGroup{{Name: "area"}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate required label group",
+			msg: MsgCreateRepositoryIssueTemplate{
+				Creator:             sample.AccAddress(),
+				RepositoryId:        repositoryId,
+				Name:                "bug report",
+				RequiredLabelGroups: []*IssueTemplateLabelGroup{{Name: "area", Labels: []uint64{1}}, {Name: "area", Labels: []uint64{2}}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDeleteRepositoryIssueTemplate_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDeleteRepositoryIssueTemplate
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDeleteRepositoryIssueTemplate{
+				Creator:      "invalid_address",
+				RepositoryId: RepositoryId{Id: sample.AccAddress(), Name: "repository"},
+				TemplateId:   1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgDeleteRepositoryIssueTemplate{
+				Creator:      sample.AccAddress(),
+				RepositoryId: RepositoryId{Id: sample.AccAddress(), Name: "repository"},
+				TemplateId:   1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
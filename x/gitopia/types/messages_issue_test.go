@@ -619,3 +619,45 @@ func TestMsgDeleteIssue_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgTransferIssue_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgTransferIssue
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgTransferIssue{
+				Creator:            "invalid_address",
+				TargetRepositoryId: RepositoryId{Id: sample.AccAddress(), Name: "repository"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid target repository",
+			msg: MsgTransferIssue{
+				Creator:            sample.AccAddress(),
+				TargetRepositoryId: RepositoryId{Id: sample.AccAddress()},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgTransferIssue{
+				Creator:            sample.AccAddress(),
+				RepositoryId:       0,
+				Iid:                1,
+				TargetRepositoryId: RepositoryId{Id: sample.AccAddress(), Name: "repository"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
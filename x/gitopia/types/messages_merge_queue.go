@@ -0,0 +1,97 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAddPullRequestToMergeQueue{}
+
+func NewMsgAddPullRequestToMergeQueue(creator string, repositoryId uint64, iid uint64, provider string, mergeMethod MergeMethod) *MsgAddPullRequestToMergeQueue {
+	return &MsgAddPullRequestToMergeQueue{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		Provider:     provider,
+		MergeMethod:  mergeMethod,
+	}
+}
+
+func (msg *MsgAddPullRequestToMergeQueue) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddPullRequestToMergeQueue) Type() string {
+	return "AddPullRequestToMergeQueue"
+}
+
+func (msg *MsgAddPullRequestToMergeQueue) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddPullRequestToMergeQueue) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddPullRequestToMergeQueue) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+
+	if _, exists := MergeMethod_name[int32(msg.MergeMethod)]; !exists {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid merge method (%v)", msg.MergeMethod)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgRemovePullRequestFromMergeQueue{}
+
+func NewMsgRemovePullRequestFromMergeQueue(creator string, repositoryId uint64, iid uint64) *MsgRemovePullRequestFromMergeQueue {
+	return &MsgRemovePullRequestFromMergeQueue{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+	}
+}
+
+func (msg *MsgRemovePullRequestFromMergeQueue) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemovePullRequestFromMergeQueue) Type() string {
+	return "RemovePullRequestFromMergeQueue"
+}
+
+func (msg *MsgRemovePullRequestFromMergeQueue) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemovePullRequestFromMergeQueue) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemovePullRequestFromMergeQueue) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
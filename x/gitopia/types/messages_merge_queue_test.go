@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAddPullRequestToMergeQueue_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAddPullRequestToMergeQueue
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAddPullRequestToMergeQueue{
+				Creator:  "invalid_address",
+				Provider: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid provider",
+			msg: MsgAddPullRequestToMergeQueue{
+				Creator:  sample.AccAddress(),
+				Provider: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid merge method",
+			msg: MsgAddPullRequestToMergeQueue{
+				Creator:     sample.AccAddress(),
+				Provider:    sample.AccAddress(),
+				MergeMethod: MergeMethod(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgAddPullRequestToMergeQueue{
+				Creator:     sample.AccAddress(),
+				Provider:    sample.AccAddress(),
+				MergeMethod: MergeMethod_REBASE,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemovePullRequestFromMergeQueue_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRemovePullRequestFromMergeQueue
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRemovePullRequestFromMergeQueue{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgRemovePullRequestFromMergeQueue{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
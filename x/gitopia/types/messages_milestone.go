@@ -0,0 +1,304 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateRepositoryMilestone      = "create_repository_milestone"
+	TypeMsgUpdateRepositoryMilestone      = "update_repository_milestone"
+	TypeMsgToggleRepositoryMilestoneState = "toggle_repository_milestone_state"
+	TypeMsgDeleteRepositoryMilestone      = "delete_repository_milestone"
+	TypeMsgSetIssueMilestone              = "set_issue_milestone"
+	TypeMsgSetPullRequestMilestone        = "set_pull_request_milestone"
+)
+
+var _ sdk.Msg = &MsgCreateRepositoryMilestone{}
+
+func NewMsgCreateRepositoryMilestone(creator string, repositoryId RepositoryId, title string, description string, dueDate int64) *MsgCreateRepositoryMilestone {
+	return &MsgCreateRepositoryMilestone{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Title:        title,
+		Description:  description,
+		DueDate:      dueDate,
+	}
+}
+
+func (msg *MsgCreateRepositoryMilestone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateRepositoryMilestone) Type() string {
+	return TypeMsgCreateRepositoryMilestone
+}
+
+func (msg *MsgCreateRepositoryMilestone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateRepositoryMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateRepositoryMilestone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateRepositoryMilestone(msg.Title, msg.Description, msg.DueDate); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateRepositoryMilestone{}
+
+func NewMsgUpdateRepositoryMilestone(creator string, repositoryId RepositoryId, milestoneId uint64, title string, description string, dueDate int64) *MsgUpdateRepositoryMilestone {
+	return &MsgUpdateRepositoryMilestone{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		MilestoneId:  milestoneId,
+		Title:        title,
+		Description:  description,
+		DueDate:      dueDate,
+	}
+}
+
+func (msg *MsgUpdateRepositoryMilestone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateRepositoryMilestone) Type() string {
+	return TypeMsgUpdateRepositoryMilestone
+}
+
+func (msg *MsgUpdateRepositoryMilestone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateRepositoryMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateRepositoryMilestone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateRepositoryMilestone(msg.Title, msg.Description, msg.DueDate); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgToggleRepositoryMilestoneState{}
+
+func NewMsgToggleRepositoryMilestoneState(creator string, repositoryId RepositoryId, milestoneId uint64) *MsgToggleRepositoryMilestoneState {
+	return &MsgToggleRepositoryMilestoneState{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		MilestoneId:  milestoneId,
+	}
+}
+
+func (msg *MsgToggleRepositoryMilestoneState) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgToggleRepositoryMilestoneState) Type() string {
+	return TypeMsgToggleRepositoryMilestoneState
+}
+
+func (msg *MsgToggleRepositoryMilestoneState) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgToggleRepositoryMilestoneState) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgToggleRepositoryMilestoneState) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgDeleteRepositoryMilestone{}
+
+func NewMsgDeleteRepositoryMilestone(creator string, repositoryId RepositoryId, milestoneId uint64) *MsgDeleteRepositoryMilestone {
+	return &MsgDeleteRepositoryMilestone{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		MilestoneId:  milestoneId,
+	}
+}
+
+func (msg *MsgDeleteRepositoryMilestone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeleteRepositoryMilestone) Type() string {
+	return TypeMsgDeleteRepositoryMilestone
+}
+
+func (msg *MsgDeleteRepositoryMilestone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDeleteRepositoryMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeleteRepositoryMilestone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetIssueMilestone{}
+
+func NewMsgSetIssueMilestone(creator string, repositoryId uint64, iid uint64, milestoneId uint64) *MsgSetIssueMilestone {
+	return &MsgSetIssueMilestone{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		MilestoneId:  milestoneId,
+	}
+}
+
+func (msg *MsgSetIssueMilestone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetIssueMilestone) Type() string {
+	return TypeMsgSetIssueMilestone
+}
+
+func (msg *MsgSetIssueMilestone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetIssueMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetIssueMilestone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetPullRequestMilestone{}
+
+func NewMsgSetPullRequestMilestone(creator string, repositoryId uint64, iid uint64, milestoneId uint64) *MsgSetPullRequestMilestone {
+	return &MsgSetPullRequestMilestone{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		MilestoneId:  milestoneId,
+	}
+}
+
+func (msg *MsgSetPullRequestMilestone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPullRequestMilestone) Type() string {
+	return TypeMsgSetPullRequestMilestone
+}
+
+func (msg *MsgSetPullRequestMilestone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetPullRequestMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetPullRequestMilestone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+// ValidateRepositoryMilestone validates the user supplied fields of a repository milestone.
+// A zero due date means the milestone has no due date.
+func ValidateRepositoryMilestone(title string, description string, dueDate int64) error {
+	if len(title) > 255 {
+		return fmt.Errorf("title length exceeds limit: 255")
+	} else if len(title) < 3 {
+		return fmt.Errorf("title too short")
+	}
+	if len(description) > 20000 {
+		return fmt.Errorf("description length exceeds limit: 20000")
+	}
+	if dueDate < 0 {
+		return fmt.Errorf("invalid due date (%d)", dueDate)
+	}
+
+	return nil
+}
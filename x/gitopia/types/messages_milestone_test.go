@@ -0,0 +1,177 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateRepositoryMilestone_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgCreateRepositoryMilestone
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateRepositoryMilestone{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+				Title:        "v1.0",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgCreateRepositoryMilestone",
+			msg: MsgCreateRepositoryMilestone{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Title:        "v1.0",
+				Description:  "first stable release",
+				DueDate:      1700000000,
+			},
+		}, {
+			name: "title too short",
+			msg: MsgCreateRepositoryMilestone{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Title:        "v1",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "title exceeds limit",
+			msg: MsgCreateRepositoryMilestone{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Title:        strings.Repeat("t", 256),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "description exceeds limit",
+			msg: MsgCreateRepositoryMilestone{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Title:        "v1.0",
+				Description:  strings.Repeat("d", 20001),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "negative due date",
+			msg: MsgCreateRepositoryMilestone{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Title:        "v1.0",
+				DueDate:      -1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateRepositoryMilestone_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgUpdateRepositoryMilestone
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateRepositoryMilestone{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+				MilestoneId:  1,
+				Title:        "v1.0",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgUpdateRepositoryMilestone",
+			msg: MsgUpdateRepositoryMilestone{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				MilestoneId:  1,
+				Title:        "v1.0",
+			},
+		}, {
+			name: "title too short",
+			msg: MsgUpdateRepositoryMilestone{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				MilestoneId:  1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetIssueMilestone_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetIssueMilestone
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetIssueMilestone{
+				Creator:     "invalid_address",
+				Iid:         1,
+				MilestoneId: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgSetIssueMilestone",
+			msg: MsgSetIssueMilestone{
+				Creator:     sample.AccAddress(),
+				Iid:         1,
+				MilestoneId: 1,
+			},
+		}, {
+			name: "clear milestone",
+			msg: MsgSetIssueMilestone{
+				Creator: sample.AccAddress(),
+				Iid:     1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxNotifications is the number of notifications kept in the inbox of a user
+const MaxNotifications = 1000
+
+// MaxNotifiedWatchers is the number of watchers of a repository notified of an activity.
+// Anyone can watch a repository, so the rest of them are served from the events.
+const MaxNotifiedWatchers = 50
+
+var _ sdk.Msg = &MsgMarkNotificationsRead{}
+
+func NewMsgMarkNotificationsRead(creator string, ids []uint64) *MsgMarkNotificationsRead {
+	return &MsgMarkNotificationsRead{
+		Creator: creator,
+		Ids:     ids,
+	}
+}
+
+func (msg *MsgMarkNotificationsRead) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMarkNotificationsRead) Type() string {
+	return "MarkNotificationsRead"
+}
+
+func (msg *MsgMarkNotificationsRead) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgMarkNotificationsRead) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgMarkNotificationsRead) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Ids) > MaxNotifications {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can't mark more than %d notifications", MaxNotifications)
+	}
+
+	unique := make(map[uint64]bool, len(msg.Ids))
+	for _, id := range msg.Ids {
+		if unique[id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate notification id (%d)", id)
+		}
+		unique[id] = true
+	}
+
+	return nil
+}
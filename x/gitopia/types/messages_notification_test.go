@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgMarkNotificationsRead_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgMarkNotificationsRead
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgMarkNotificationsRead{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicate id",
+			msg: MsgMarkNotificationsRead{
+				Creator: sample.AccAddress(),
+				Ids:     []uint64{1, 1},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "all notifications",
+			msg: MsgMarkNotificationsRead{
+				Creator: sample.AccAddress(),
+			},
+		}, {
+			name: "valid address",
+			msg: MsgMarkNotificationsRead{
+				Creator: sample.AccAddress(),
+				Ids:     []uint64{1, 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
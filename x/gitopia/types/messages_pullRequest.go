@@ -1,6 +1,8 @@
 package types
 
 import (
+	"regexp"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -13,7 +15,7 @@ func (pr PullRequestList) Swap(i, j int)      { pr[i], pr[j] = pr[j], pr[i] }
 
 var _ sdk.Msg = &MsgCreatePullRequest{}
 
-func NewMsgCreatePullRequest(creator string, title string, description string, headBranch string, headRepositoryId RepositoryId, baseBranch string, baseRepositoryId RepositoryId, reviewers []string, assignees []string, labelIds []uint64, issueIids []uint64) *MsgCreatePullRequest {
+func NewMsgCreatePullRequest(creator string, title string, description string, headBranch string, headRepositoryId RepositoryId, baseBranch string, baseRepositoryId RepositoryId, reviewers []string, assignees []string, labelIds []uint64, issueIids []uint64, draft bool) *MsgCreatePullRequest {
 	return &MsgCreatePullRequest{
 		Creator:          creator,
 		Title:            title,
@@ -26,6 +28,7 @@ func NewMsgCreatePullRequest(creator string, title string, description string, h
 		Assignees:        assignees,
 		LabelIds:         labelIds,
 		IssueIids:        issueIids,
+		Draft:            draft,
 	}
 }
 
@@ -225,12 +228,13 @@ func (msg *MsgUpdatePullRequestDescription) ValidateBasic() error {
 
 var _ sdk.Msg = &MsgInvokeMergePullRequest{}
 
-func NewMsgInvokeMergePullRequest(creator string, repositoryId uint64, iid uint64, provider string) *MsgInvokeMergePullRequest {
+func NewMsgInvokeMergePullRequest(creator string, repositoryId uint64, iid uint64, provider string, mergeMethod MergeMethod) *MsgInvokeMergePullRequest {
 	return &MsgInvokeMergePullRequest{
 		Creator:      creator,
 		RepositoryId: repositoryId,
 		Iid:          iid,
 		Provider:     provider,
+		MergeMethod:  mergeMethod,
 	}
 }
 
@@ -266,12 +270,16 @@ func (msg *MsgInvokeMergePullRequest) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
 	}
 
+	if _, exists := MergeMethod_name[int32(msg.MergeMethod)]; !exists {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid merge method (%v)", msg.MergeMethod)
+	}
+
 	return nil
 }
 
 var _ sdk.Msg = &MsgSetPullRequestState{}
 
-func NewMsgSetPullRequestState(creator string, repositoryId uint64, iid uint64, state string, mergeCommitSha string, commentBody string, taskId uint64) *MsgSetPullRequestState {
+func NewMsgSetPullRequestState(creator string, repositoryId uint64, iid uint64, state string, mergeCommitSha string, commentBody string, taskId uint64, mergeMethod MergeMethod) *MsgSetPullRequestState {
 	return &MsgSetPullRequestState{
 		Creator:        creator,
 		RepositoryId:   repositoryId,
@@ -280,6 +288,7 @@ func NewMsgSetPullRequestState(creator string, repositoryId uint64, iid uint64,
 		MergeCommitSha: mergeCommitSha,
 		CommentBody:    commentBody,
 		TaskId:         taskId,
+		MergeMethod:    mergeMethod,
 	}
 }
 
@@ -315,6 +324,10 @@ func (msg *MsgSetPullRequestState) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid state (%s)", msg.State)
 	}
 
+	if _, exists := MergeMethod_name[int32(msg.MergeMethod)]; !exists {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid merge method (%v)", msg.MergeMethod)
+	}
+
 	if err := ValidateOptionalCommentBody(msg.CommentBody); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -783,3 +796,349 @@ func (msg *MsgDeletePullRequest) GetSignBytes() []byte {
 func (msg *MsgDeletePullRequest) ValidateBasic() error {
 	return sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "tx WIP")
 }
+
+var _ sdk.Msg = &MsgSubmitPullRequestReview{}
+
+func NewMsgSubmitPullRequestReview(creator string, repositoryId uint64, iid uint64, state PullRequestReview_State, body string, commitSha string) *MsgSubmitPullRequestReview {
+	return &MsgSubmitPullRequestReview{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		State:        state,
+		Body:         body,
+		CommitSha:    commitSha,
+	}
+}
+
+func (msg *MsgSubmitPullRequestReview) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitPullRequestReview) Type() string {
+	return "SubmitPullRequestReview"
+}
+
+func (msg *MsgSubmitPullRequestReview) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubmitPullRequestReview) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitPullRequestReview) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, exists := PullRequestReview_State_name[int32(msg.State)]; !exists {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid review state (%v)", msg.State)
+	}
+
+	// Approvals may be submitted without a body, other reviews must explain themselves
+	if msg.State == PullRequestReview_APPROVE {
+		if err := ValidateOptionalCommentBody(msg.Body); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	} else if err := ValidateCommentBody(msg.Body); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	isShaValid, _ := regexp.MatchString("^([0-9a-f]{40}|[0-9a-f]{64})$", msg.CommitSha)
+	if !isShaValid {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid commit sha")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgMarkPullRequestReadyForReview{}
+
+func NewMsgMarkPullRequestReadyForReview(creator string, repositoryId uint64, iid uint64) *MsgMarkPullRequestReadyForReview {
+	return &MsgMarkPullRequestReadyForReview{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+	}
+}
+
+func (msg *MsgMarkPullRequestReadyForReview) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMarkPullRequestReadyForReview) Type() string {
+	return "MarkPullRequestReadyForReview"
+}
+
+func (msg *MsgMarkPullRequestReadyForReview) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgMarkPullRequestReadyForReview) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgMarkPullRequestReadyForReview) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgConvertPullRequestToDraft{}
+
+func NewMsgConvertPullRequestToDraft(creator string, repositoryId uint64, iid uint64) *MsgConvertPullRequestToDraft {
+	return &MsgConvertPullRequestToDraft{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+	}
+}
+
+func (msg *MsgConvertPullRequestToDraft) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgConvertPullRequestToDraft) Type() string {
+	return "ConvertPullRequestToDraft"
+}
+
+func (msg *MsgConvertPullRequestToDraft) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgConvertPullRequestToDraft) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgConvertPullRequestToDraft) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+// MaxPullRequestAutoMergesPerBlock is the number of auto-merge requests checked in a block
+const MaxPullRequestAutoMergesPerBlock = 50
+
+// PullRequestAutoMergeExpiry is the duration in seconds after which an auto-merge request
+// whose requirements are still not met is dropped
+const PullRequestAutoMergeExpiry = int64(30 * 24 * 60 * 60) // 30 days
+
+var _ sdk.Msg = &MsgEnablePullRequestAutoMerge{}
+
+func NewMsgEnablePullRequestAutoMerge(creator string, repositoryId uint64, iid uint64, provider string, mergeMethod MergeMethod) *MsgEnablePullRequestAutoMerge {
+	return &MsgEnablePullRequestAutoMerge{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		Provider:     provider,
+		MergeMethod:  mergeMethod,
+	}
+}
+
+func (msg *MsgEnablePullRequestAutoMerge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgEnablePullRequestAutoMerge) Type() string {
+	return "EnablePullRequestAutoMerge"
+}
+
+func (msg *MsgEnablePullRequestAutoMerge) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgEnablePullRequestAutoMerge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgEnablePullRequestAutoMerge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+
+	if _, exists := MergeMethod_name[int32(msg.MergeMethod)]; !exists {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid merge method (%v)", msg.MergeMethod)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgDisablePullRequestAutoMerge{}
+
+func NewMsgDisablePullRequestAutoMerge(creator string, repositoryId uint64, iid uint64) *MsgDisablePullRequestAutoMerge {
+	return &MsgDisablePullRequestAutoMerge{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+	}
+}
+
+func (msg *MsgDisablePullRequestAutoMerge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDisablePullRequestAutoMerge) Type() string {
+	return "DisablePullRequestAutoMerge"
+}
+
+func (msg *MsgDisablePullRequestAutoMerge) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDisablePullRequestAutoMerge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDisablePullRequestAutoMerge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetPullRequestChangedPaths{}
+
+func NewMsgSetPullRequestChangedPaths(creator string, repositoryId uint64, iid uint64, changedPaths []string) *MsgSetPullRequestChangedPaths {
+	return &MsgSetPullRequestChangedPaths{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		ChangedPaths: changedPaths,
+	}
+}
+
+func (msg *MsgSetPullRequestChangedPaths) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPullRequestChangedPaths) Type() string {
+	return "SetPullRequestChangedPaths"
+}
+
+func (msg *MsgSetPullRequestChangedPaths) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetPullRequestChangedPaths) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetPullRequestChangedPaths) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.ChangedPaths) > 1000 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can't set more than 1000 changed paths")
+	}
+
+	unique := make(map[string]bool, len(msg.ChangedPaths))
+	for _, changedPath := range msg.ChangedPaths {
+		if len(changedPath) == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "changed path can't be empty")
+		}
+		if unique[changedPath] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate changed path (%s)", changedPath)
+		}
+		unique[changedPath] = true
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetPullRequestCommitMessages{}
+
+func NewMsgSetPullRequestCommitMessages(creator string, repositoryId uint64, iid uint64, commitMessages []string) *MsgSetPullRequestCommitMessages {
+	return &MsgSetPullRequestCommitMessages{
+		Creator:        creator,
+		RepositoryId:   repositoryId,
+		Iid:            iid,
+		CommitMessages: commitMessages,
+	}
+}
+
+func (msg *MsgSetPullRequestCommitMessages) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPullRequestCommitMessages) Type() string {
+	return "SetPullRequestCommitMessages"
+}
+
+func (msg *MsgSetPullRequestCommitMessages) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetPullRequestCommitMessages) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetPullRequestCommitMessages) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.CommitMessages) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "commit messages can't be empty")
+	}
+
+	if len(msg.CommitMessages) > 250 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can't set more than 250 commit messages")
+	}
+
+	for _, commitMessage := range msg.CommitMessages {
+		if len(commitMessage) > 20000 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "commit message length exceeds limit: 20000")
+		}
+	}
+	return nil
+}
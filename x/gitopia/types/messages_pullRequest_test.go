@@ -453,6 +453,14 @@ func TestMsgSetPullRequestState_ValidateBasic(t *testing.T) {
 				State:   "invalid_state",
 			},
 			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid merge method",
+			msg: MsgSetPullRequestState{
+				Creator:     sample.AccAddress(),
+				State:       "MERGED",
+				MergeMethod: MergeMethod(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -1010,3 +1018,317 @@ func TestMsgDeletePullRequest_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSubmitPullRequestReview_ValidateBasic(t *testing.T) {
+	commitSha := strings.Repeat("a", 40)
+
+	tests := []struct {
+		name string
+		msg  MsgSubmitPullRequestReview
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSubmitPullRequestReview{
+				Creator:   "invalid_address",
+				State:     PullRequestReview_APPROVE,
+				CommitSha: commitSha,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid approval without body",
+			msg: MsgSubmitPullRequestReview{
+				Creator:   sample.AccAddress(),
+				State:     PullRequestReview_APPROVE,
+				CommitSha: commitSha,
+			},
+		}, {
+			name: "request changes without body",
+			msg: MsgSubmitPullRequestReview{
+				Creator:   sample.AccAddress(),
+				State:     PullRequestReview_REQUEST_CHANGES,
+				CommitSha: commitSha,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid comment",
+			msg: MsgSubmitPullRequestReview{
+				Creator:   sample.AccAddress(),
+				State:     PullRequestReview_COMMENT,
+				Body:      "body",
+				CommitSha: commitSha,
+			},
+		}, {
+			name: "invalid state",
+			msg: MsgSubmitPullRequestReview{
+				Creator:   sample.AccAddress(),
+				State:     PullRequestReview_State(10),
+				Body:      "body",
+				CommitSha: commitSha,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "body exceeds limit",
+			msg: MsgSubmitPullRequestReview{
+				Creator:   sample.AccAddress(),
+				State:     PullRequestReview_APPROVE,
+				Body:      strings.Repeat("c", 20001),
+				CommitSha: commitSha,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid commit sha",
+			msg: MsgSubmitPullRequestReview{
+				Creator:   sample.AccAddress(),
+				State:     PullRequestReview_APPROVE,
+				CommitSha: "sha",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgMarkPullRequestReadyForReview_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgMarkPullRequestReadyForReview
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgMarkPullRequestReadyForReview{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgMarkPullRequestReadyForReview{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgConvertPullRequestToDraft_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgConvertPullRequestToDraft
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgConvertPullRequestToDraft{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgConvertPullRequestToDraft{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgEnablePullRequestAutoMerge_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgEnablePullRequestAutoMerge
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgEnablePullRequestAutoMerge{
+				Creator:  "invalid_address",
+				Provider: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid provider",
+			msg: MsgEnablePullRequestAutoMerge{
+				Creator:  sample.AccAddress(),
+				Provider: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid merge method",
+			msg: MsgEnablePullRequestAutoMerge{
+				Creator:     sample.AccAddress(),
+				Provider:    sample.AccAddress(),
+				MergeMethod: MergeMethod(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgEnablePullRequestAutoMerge{
+				Creator:     sample.AccAddress(),
+				Provider:    sample.AccAddress(),
+				MergeMethod: MergeMethod_SQUASH,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDisablePullRequestAutoMerge_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDisablePullRequestAutoMerge
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDisablePullRequestAutoMerge{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgDisablePullRequestAutoMerge{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetPullRequestChangedPaths_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetPullRequestChangedPaths
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetPullRequestChangedPaths{
+				Creator:      "invalid_address",
+				ChangedPaths: []string{"main.go"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty changed path",
+			msg: MsgSetPullRequestChangedPaths{
+				Creator:      sample.AccAddress(),
+				ChangedPaths: []string{"main.go", ""},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate changed path",
+			msg: MsgSetPullRequestChangedPaths{
+				Creator:      sample.AccAddress(),
+				ChangedPaths: []string{"main.go", "main.go"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgSetPullRequestChangedPaths{
+				Creator:      sample.AccAddress(),
+				ChangedPaths: []string{"main.go", "docs/README.md"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetPullRequestCommitMessages_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetPullRequestCommitMessages
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetPullRequestCommitMessages{
+				Creator:        "invalid_address",
+				CommitMessages: []string{"closes #1"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty commit messages",
+			msg: MsgSetPullRequestCommitMessages{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "commit message too long",
+			msg: MsgSetPullRequestCommitMessages{
+				Creator:        sample.AccAddress(),
+				CommitMessages: []string{strings.Repeat("a", 20001)},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgSetPullRequestCommitMessages{
+				Creator:        sample.AccAddress(),
+				CommitMessages: []string{"refactor parser", "closes #1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
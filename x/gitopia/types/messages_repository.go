@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"regexp"
+	"strings"
 	"unicode"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -792,6 +793,227 @@ func (msg *MsgToggleRepositoryForking) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgUpdateRepositoryAllowedMergeMethods{}
+
+func NewMsgUpdateRepositoryAllowedMergeMethods(creator string, repositoryId RepositoryId, allowedMergeMethods []MergeMethod) *MsgUpdateRepositoryAllowedMergeMethods {
+	return &MsgUpdateRepositoryAllowedMergeMethods{
+		Creator:             creator,
+		RepositoryId:        repositoryId,
+		AllowedMergeMethods: allowedMergeMethods,
+	}
+}
+
+func (msg *MsgUpdateRepositoryAllowedMergeMethods) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateRepositoryAllowedMergeMethods) Type() string {
+	return "UpdateRepositoryAllowedMergeMethods"
+}
+
+func (msg *MsgUpdateRepositoryAllowedMergeMethods) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateRepositoryAllowedMergeMethods) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateRepositoryAllowedMergeMethods) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if len(msg.AllowedMergeMethods) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "at least one merge method must be allowed")
+	}
+
+	unique := make(map[MergeMethod]bool)
+	for _, mergeMethod := range msg.AllowedMergeMethods {
+		if _, exists := MergeMethod_name[int32(mergeMethod)]; !exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid merge method (%v)", mergeMethod)
+		}
+		if unique[mergeMethod] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate merge method (%v)", mergeMethod)
+		}
+		unique[mergeMethod] = true
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateRepositoryCodeOwners{}
+
+func NewMsgUpdateRepositoryCodeOwners(creator string, repositoryId RepositoryId, codeOwners []*CodeOwnerRule) *MsgUpdateRepositoryCodeOwners {
+	return &MsgUpdateRepositoryCodeOwners{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		CodeOwners:   codeOwners,
+	}
+}
+
+func (msg *MsgUpdateRepositoryCodeOwners) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateRepositoryCodeOwners) Type() string {
+	return "UpdateRepositoryCodeOwners"
+}
+
+func (msg *MsgUpdateRepositoryCodeOwners) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateRepositoryCodeOwners) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateRepositoryCodeOwners) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if len(msg.CodeOwners) > 50 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can't have more than 50 code owner rules")
+	}
+
+	for _, rule := range msg.CodeOwners {
+		if rule == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "empty code owner rule")
+		}
+		if len(strings.Trim(rule.Pattern, "/")) == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "code owner pattern can't be empty")
+		} else if len(rule.Pattern) > 255 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "code owner pattern exceeds limit: 255")
+		}
+		if len(rule.Owners) < 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "empty owners list for pattern (%s)", rule.Pattern)
+		} else if len(rule.Owners) > 10 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can't give more than 10 owners for a pattern")
+		}
+
+		unique := make(map[string]bool, len(rule.Owners))
+		for _, owner := range rule.Owners {
+			_, err := sdk.AccAddressFromBech32(owner)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+			}
+			if unique[owner] {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate owner (%s)", owner)
+			}
+			unique[owner] = true
+		}
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgArchiveRepository{}
+
+func NewMsgArchiveRepository(creator string, repositoryId RepositoryId) *MsgArchiveRepository {
+	return &MsgArchiveRepository{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+	}
+}
+
+func (msg *MsgArchiveRepository) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgArchiveRepository) Type() string {
+	return "ArchiveRepository"
+}
+
+func (msg *MsgArchiveRepository) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgArchiveRepository) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgArchiveRepository) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnarchiveRepository{}
+
+func NewMsgUnarchiveRepository(creator string, repositoryId RepositoryId) *MsgUnarchiveRepository {
+	return &MsgUnarchiveRepository{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+	}
+}
+
+func (msg *MsgUnarchiveRepository) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnarchiveRepository) Type() string {
+	return "UnarchiveRepository"
+}
+
+func (msg *MsgUnarchiveRepository) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnarchiveRepository) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnarchiveRepository) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
 var _ sdk.Msg = &MsgToggleArweaveBackup{}
 
 func NewMsgToggleArweaveBackup(creator string, repositoryId RepositoryId) *MsgToggleArweaveBackup {
@@ -540,6 +540,224 @@ func TestMsgToggleRepositoryForking_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgArchiveRepository_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgArchiveRepository
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgArchiveRepository{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgArchiveRepository",
+			msg: MsgArchiveRepository{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnarchiveRepository_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgUnarchiveRepository
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnarchiveRepository{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgUnarchiveRepository",
+			msg: MsgUnarchiveRepository{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateRepositoryAllowedMergeMethods_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgUpdateRepositoryAllowedMergeMethods
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateRepositoryAllowedMergeMethods{
+				Creator:             "invalid_address",
+				RepositoryId:        repositoryId,
+				AllowedMergeMethods: []MergeMethod{MergeMethod_SQUASH},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgUpdateRepositoryAllowedMergeMethods",
+			msg: MsgUpdateRepositoryAllowedMergeMethods{
+				Creator:             sample.AccAddress(),
+				RepositoryId:        repositoryId,
+				AllowedMergeMethods: []MergeMethod{MergeMethod_SQUASH, MergeMethod_REBASE},
+			},
+		}, {
+			name: "no merge method",
+			msg: MsgUpdateRepositoryAllowedMergeMethods{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid merge method",
+			msg: MsgUpdateRepositoryAllowedMergeMethods{
+				Creator:             sample.AccAddress(),
+				RepositoryId:        repositoryId,
+				AllowedMergeMethods: []MergeMethod{MergeMethod(10)},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate merge method",
+			msg: MsgUpdateRepositoryAllowedMergeMethods{
+				Creator:             sample.AccAddress(),
+				RepositoryId:        repositoryId,
+				AllowedMergeMethods: []MergeMethod{MergeMethod_SQUASH, MergeMethod_SQUASH},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateRepositoryCodeOwners_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+	owner := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  MsgUpdateRepositoryCodeOwners
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateRepositoryCodeOwners{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+				CodeOwners:   []*CodeOwnerRule{{Pattern: "*", Owners: []string{owner}}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgUpdateRepositoryCodeOwners",
+			msg: MsgUpdateRepositoryCodeOwners{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				CodeOwners:   []*CodeOwnerRule{{Pattern: "*", Owners: []string{owner}}, {Pattern: "docs/", Owners: []string{owner, sample.AccAddress()}}},
+			},
+		}, {
+			name: "no code owners",
+			msg: MsgUpdateRepositoryCodeOwners{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+		}, {
+			name: "empty pattern",
+			msg: MsgUpdateRepositoryCodeOwners{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				CodeOwners:   []*CodeOwnerRule{{Pattern: "/", Owners: []string{owner}}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no owners",
+			msg: MsgUpdateRepositoryCodeOwners{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				CodeOwners:   []*CodeOwnerRule{{Pattern: "*"}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid owner address",
+			msg: MsgUpdateRepositoryCodeOwners{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				CodeOwners:   []*CodeOwnerRule{{Pattern: "*", Owners: []string{"invalid_address"}}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicate owner",
+			msg: MsgUpdateRepositoryCodeOwners{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				CodeOwners:   []*CodeOwnerRule{{Pattern: "*", Owners: []string{owner, owner}}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgRenameRepository_ValidateBasic(t *testing.T) {
 	repositoryId := RepositoryId{
 		Id:   sample.AccAddress(),
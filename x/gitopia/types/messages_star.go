@@ -0,0 +1,92 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgStarRepository{}
+
+func NewMsgStarRepository(creator string, repositoryId RepositoryId) *MsgStarRepository {
+	return &MsgStarRepository{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+	}
+}
+
+func (msg *MsgStarRepository) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgStarRepository) Type() string {
+	return "StarRepository"
+}
+
+func (msg *MsgStarRepository) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgStarRepository) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgStarRepository) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnstarRepository{}
+
+func NewMsgUnstarRepository(creator string, repositoryId RepositoryId) *MsgUnstarRepository {
+	return &MsgUnstarRepository{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+	}
+}
+
+func (msg *MsgUnstarRepository) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnstarRepository) Type() string {
+	return "UnstarRepository"
+}
+
+func (msg *MsgUnstarRepository) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnstarRepository) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnstarRepository) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgStarRepository_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgStarRepository
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgStarRepository{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgStarRepository",
+			msg: MsgStarRepository{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnstarRepository_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgUnstarRepository
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnstarRepository{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgUnstarRepository",
+			msg: MsgUnstarRepository{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -32,23 +32,32 @@ func ValidateRepositoryDescription(description string) error {
 	return nil
 }
 
-func ValidateRepositoryId(repositoryId RepositoryId) error {
-	_, err := sdk.AccAddressFromBech32(repositoryId.Id)
+// ValidateId validates an id which is either an address or a username
+func ValidateId(id string) error {
+	_, err := sdk.AccAddressFromBech32(id)
 	if err != nil {
-		if len(repositoryId.Id) < 3 {
+		if len(id) < 3 {
 			return fmt.Errorf("id must consist minimum 3 chars")
-		} else if len(repositoryId.Id) > 39 {
+		} else if len(id) > 39 {
 			return fmt.Errorf("id limit exceed: 39")
 		}
-		valid, err := regexp.MatchString("^[a-zA-Z0-9]+(?:[-]?[a-zA-Z0-9])*$", repositoryId.Id)
+		valid, err := regexp.MatchString("^[a-zA-Z0-9]+(?:[-]?[a-zA-Z0-9])*$", id)
 		if err != nil {
 			return fmt.Errorf(err.Error())
 		}
 		if !valid {
-			return fmt.Errorf("invalid id (%v)", repositoryId.Id)
+			return fmt.Errorf("invalid id (%v)", id)
 		}
 	}
 
+	return nil
+}
+
+func ValidateRepositoryId(repositoryId RepositoryId) error {
+	if err := ValidateId(repositoryId.Id); err != nil {
+		return err
+	}
+
 	if err := ValidateRepositoryName(repositoryId.Name); err != nil {
 		return err
 	}
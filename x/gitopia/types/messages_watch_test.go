@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgWatchRepository_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgWatchRepository
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgWatchRepository{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgWatchRepository",
+			msg: MsgWatchRepository{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnwatchRepository_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgUnwatchRepository
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnwatchRepository{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgUnwatchRepository",
+			msg: MsgUnwatchRepository{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,700 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gitopia/notification.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Notification_Reason int32
+
+const (
+	Notification_MENTION          Notification_Reason = 0
+	Notification_ASSIGN           Notification_Reason = 1
+	Notification_REVIEW_REQUESTED Notification_Reason = 2
+	Notification_SUBSCRIBED       Notification_Reason = 3
+)
+
+var Notification_Reason_name = map[int32]string{
+	0: "MENTION",
+	1: "ASSIGN",
+	2: "REVIEW_REQUESTED",
+	3: "SUBSCRIBED",
+}
+
+var Notification_Reason_value = map[string]int32{
+	"MENTION":          0,
+	"ASSIGN":           1,
+	"REVIEW_REQUESTED": 2,
+	"SUBSCRIBED":       3,
+}
+
+func (x Notification_Reason) String() string {
+	return proto.EnumName(Notification_Reason_name, int32(x))
+}
+
+func (Notification_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_07470f2d9454036c, []int{0, 0}
+}
+
+// Notification is an entry of the notification inbox of a user. commentIid is 0
+// when the notification is about the issue or pull request itself.
+type Notification struct {
+	Address      string              `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Id           uint64              `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Reason       Notification_Reason `protobuf:"varint,3,opt,name=reason,proto3,enum=gitopia.gitopia.gitopia.Notification_Reason" json:"reason,omitempty"`
+	Actor        string              `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	RepositoryId uint64              `protobuf:"varint,5,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	Parent       CommentParent       `protobuf:"varint,6,opt,name=parent,proto3,enum=gitopia.gitopia.gitopia.CommentParent" json:"parent,omitempty"`
+	ParentIid    uint64              `protobuf:"varint,7,opt,name=parentIid,proto3" json:"parentIid,omitempty"`
+	CommentIid   uint64              `protobuf:"varint,8,opt,name=commentIid,proto3" json:"commentIid,omitempty"`
+	Read         bool                `protobuf:"varint,9,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt    int64               `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (m *Notification) Reset()         { *m = Notification{} }
+func (m *Notification) String() string { return proto.CompactTextString(m) }
+func (*Notification) ProtoMessage()    {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07470f2d9454036c, []int{0}
+}
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
+}
+func (m *Notification) XXX_Size() int {
+	return m.Size()
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Notification) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Notification) GetReason() Notification_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return Notification_MENTION
+}
+
+func (m *Notification) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *Notification) GetRepositoryId() uint64 {
+	if m != nil {
+		return m.RepositoryId
+	}
+	return 0
+}
+
+func (m *Notification) GetParent() CommentParent {
+	if m != nil {
+		return m.Parent
+	}
+	return CommentParentNone
+}
+
+func (m *Notification) GetParentIid() uint64 {
+	if m != nil {
+		return m.ParentIid
+	}
+	return 0
+}
+
+func (m *Notification) GetCommentIid() uint64 {
+	if m != nil {
+		return m.CommentIid
+	}
+	return 0
+}
+
+func (m *Notification) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *Notification) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("gitopia.gitopia.gitopia.Notification_Reason", Notification_Reason_name, Notification_Reason_value)
+	proto.RegisterType((*Notification)(nil), "gitopia.gitopia.gitopia.Notification")
+}
+
+func init() { proto.RegisterFile("gitopia/notification.proto", fileDescriptor_07470f2d9454036c) }
+
+var fileDescriptor_07470f2d9454036c = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0x49, 0x37, 0xdd, 0x3e, 0x97, 0x12, 0x86, 0x15, 0x87, 0x45, 0x42, 0xe8, 0x41,
+	0x82, 0x48, 0x16, 0xf4, 0x2e, 0x6c, 0x37, 0x61, 0xc9, 0xc1, 0xa8, 0x93, 0x5d, 0x05, 0x2f, 0x32,
+	0x9b, 0x19, 0xeb, 0x1c, 0x9a, 0x09, 0x93, 0x11, 0xec, 0xb7, 0xf0, 0xab, 0xf8, 0x2d, 0x3c, 0xf6,
+	0xe8, 0x51, 0xda, 0x2f, 0x22, 0x9d, 0x24, 0xb6, 0x2e, 0xf4, 0xf4, 0xde, 0xfb, 0xbf, 0xf7, 0x7f,
+	0x3f, 0x5e, 0x32, 0x70, 0xb1, 0x90, 0x46, 0x35, 0x92, 0x5d, 0xd6, 0xca, 0xc8, 0x2f, 0xb2, 0x62,
+	0x46, 0xaa, 0x3a, 0x69, 0xb4, 0x32, 0x0a, 0x3f, 0xe9, 0x7b, 0xc9, 0x83, 0x78, 0xf1, 0x78, 0x30,
+	0x55, 0x6a, 0xb9, 0x14, 0xb5, 0xe9, 0xe6, 0x67, 0x3f, 0x3d, 0x38, 0x2b, 0x0e, 0xd6, 0x60, 0x02,
+	0x63, 0xc6, 0xb9, 0x16, 0x6d, 0x4b, 0x50, 0x84, 0xe2, 0x09, 0x1d, 0x4a, 0x3c, 0x05, 0x57, 0x72,
+	0xe2, 0x46, 0x28, 0x1e, 0x51, 0x57, 0x72, 0x9c, 0x82, 0xaf, 0x05, 0x6b, 0x55, 0x4d, 0xbc, 0x08,
+	0xc5, 0xd3, 0x97, 0x2f, 0x92, 0x23, 0xec, 0xe4, 0x10, 0x90, 0x50, 0xeb, 0xa1, 0xbd, 0x17, 0x9f,
+	0xc3, 0x09, 0xab, 0x8c, 0xd2, 0x64, 0x64, 0x69, 0x5d, 0x81, 0x67, 0x70, 0xa6, 0x45, 0xa3, 0x5a,
+	0x69, 0x94, 0x5e, 0xe5, 0x9c, 0x9c, 0x58, 0xea, 0x7f, 0x1a, 0x7e, 0x0d, 0x7e, 0xc3, 0xb4, 0xa8,
+	0x0d, 0xf1, 0x2d, 0xff, 0xd9, 0x51, 0xfe, 0x75, 0x77, 0xf2, 0x3b, 0x3b, 0x4d, 0x7b, 0x17, 0x7e,
+	0x0a, 0x93, 0x2e, 0xcb, 0x25, 0x27, 0x63, 0x0b, 0xd8, 0x0b, 0x38, 0x04, 0xe8, 0xbf, 0xd4, 0xae,
+	0x7d, 0x6a, 0xdb, 0x07, 0x0a, 0xc6, 0x30, 0xd2, 0x82, 0x71, 0x32, 0x89, 0x50, 0x7c, 0x4a, 0x6d,
+	0xbe, 0xdb, 0x58, 0x69, 0xc1, 0x8c, 0xe0, 0x57, 0x86, 0x40, 0x84, 0x62, 0x8f, 0xee, 0x85, 0xd9,
+	0x0d, 0xf8, 0xdd, 0xed, 0xf8, 0x11, 0x8c, 0xdf, 0x64, 0xc5, 0x6d, 0xfe, 0xb6, 0x08, 0x1c, 0x0c,
+	0xe0, 0x5f, 0x95, 0x65, 0x7e, 0x53, 0x04, 0x08, 0x9f, 0x43, 0x40, 0xb3, 0x0f, 0x79, 0xf6, 0xf1,
+	0x33, 0xcd, 0xde, 0xdf, 0x65, 0xe5, 0x6d, 0x96, 0x06, 0x2e, 0x9e, 0x02, 0x94, 0x77, 0xf3, 0xf2,
+	0x9a, 0xe6, 0xf3, 0x2c, 0x0d, 0xbc, 0x79, 0xfa, 0x6b, 0x13, 0xa2, 0xf5, 0x26, 0x44, 0x7f, 0x36,
+	0x21, 0xfa, 0xb1, 0x0d, 0x9d, 0xf5, 0x36, 0x74, 0x7e, 0x6f, 0x43, 0xe7, 0xd3, 0xf3, 0x85, 0x34,
+	0x5f, 0xbf, 0xdd, 0x27, 0x95, 0x5a, 0x5e, 0x0e, 0xff, 0x7b, 0x88, 0xdf, 0xff, 0x65, 0x66, 0xd5,
+	0x88, 0xf6, 0xde, 0xb7, 0x0f, 0xe0, 0xd5, 0xdf, 0x01, 0x00, 0xfd, 0x24, 0x82, 0xe4, 0x4e, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Notification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Notification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Notification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintNotification(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Read {
+		i--
+		if m.Read {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CommentIid != 0 {
+		i = encodeVarintNotification(dAtA, i, uint64(m.CommentIid))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ParentIid != 0 {
+		i = encodeVarintNotification(dAtA, i, uint64(m.ParentIid))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Parent != 0 {
+		i = encodeVarintNotification(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RepositoryId != 0 {
+		i = encodeVarintNotification(dAtA, i, uint64(m.RepositoryId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintNotification(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Reason != 0 {
+		i = encodeVarintNotification(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintNotification(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNotification(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNotification(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNotification(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Notification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovNotification(uint64(m.Id))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovNotification(uint64(m.Reason))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.RepositoryId != 0 {
+		n += 1 + sovNotification(uint64(m.RepositoryId))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovNotification(uint64(m.Parent))
+	}
+	if m.ParentIid != 0 {
+		n += 1 + sovNotification(uint64(m.ParentIid))
+	}
+	if m.CommentIid != 0 {
+		n += 1 + sovNotification(uint64(m.CommentIid))
+	}
+	if m.Read {
+		n += 2
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovNotification(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func sovNotification(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNotification(x uint64) (n int) {
+	return sovNotification(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Notification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Notification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Notification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= Notification_Reason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= CommentParent(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentIid", wireType)
+			}
+			m.ParentIid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentIid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentIid", wireType)
+			}
+			m.CommentIid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommentIid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Read", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Read = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNotification(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNotification
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNotification
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNotification
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNotification
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNotification        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNotification          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNotification = fmt.Errorf("proto: unexpected end of group")
+)
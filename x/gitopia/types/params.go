@@ -15,14 +15,18 @@ func NewParams(nextInflationTime time.Time,
 	teamProportions []DistributionProportion,
 	genesisTime time.Time,
 	gitServer string,
-	storageProvider string) Params {
+	storageProvider string,
+	bountyArbiter string,
+	bountyDisputeWindow int64) Params {
 	return Params{
-		NextInflationTime: nextInflationTime,
-		PoolProportions:   poolProportions,
-		TeamProportions:   teamProportions,
-		GenesisTime:       genesisTime,
-		GitServer:         gitServer,
-		StorageProvider:   storageProvider,
+		NextInflationTime:   nextInflationTime,
+		PoolProportions:     poolProportions,
+		TeamProportions:     teamProportions,
+		GenesisTime:         genesisTime,
+		GitServer:           gitServer,
+		StorageProvider:     storageProvider,
+		BountyArbiter:       bountyArbiter,
+		BountyDisputeWindow: bountyDisputeWindow,
 	}
 }
 
@@ -48,6 +52,8 @@ func DefaultParams() Params {
 		time.Now().Add(time.Duration(-365*24)*time.Hour).UTC(), // one year ago
 		"gitopia1s9qkkznqqv8p838fuyzzfaxu7ckhy3v8cw3pke",
 		"gitopia1xp4e40rd4akt882h2pxl8cw8ygxjxndu23c5wn",
+		"",                // bounty disputes are disabled until an arbiter is set
+		int64(7*24*60*60), // one week
 	)
 }
 
@@ -70,6 +76,12 @@ func (p Params) Validate() error {
 	if err := validateTeamProportions(p.TeamProportions); err != nil {
 		return err
 	}
+	if err := validateBountyArbiter(p.BountyArbiter); err != nil {
+		return err
+	}
+	if err := validateBountyDisputeWindow(p.BountyDisputeWindow); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -120,6 +132,23 @@ func validateTeamProportions(dp []DistributionProportion) error {
 	return nil
 }
 
+func validateBountyArbiter(arbiter string) error {
+	if arbiter == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(arbiter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bounty arbiter address (%s)", err)
+	}
+	return nil
+}
+
+func validateBountyDisputeWindow(window int64) error {
+	if window < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "bounty dispute window must not be negative. got %d", window)
+	}
+	return nil
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -133,6 +133,10 @@ type Params struct {
 	GenesisTime       time.Time                `protobuf:"bytes,4,opt,name=genesis_time,json=genesisTime,proto3,stdtime" json:"genesis_time" yaml:"genesis_time"`
 	GitServer         string                   `protobuf:"bytes,5,opt,name=git_server,json=gitServer,proto3" json:"git_server,omitempty" yaml:"git_server"`
 	StorageProvider   string                   `protobuf:"bytes,6,opt,name=storage_provider,json=storageProvider,proto3" json:"storage_provider,omitempty" yaml:"storage_provider"`
+	// address resolving bounty disputes
+	BountyArbiter string `protobuf:"bytes,7,opt,name=bounty_arbiter,json=bountyArbiter,proto3" json:"bounty_arbiter,omitempty" yaml:"bounty_arbiter"`
+	// duration in seconds for which an awarded bounty can be disputed
+	BountyDisputeWindow int64 `protobuf:"varint,8,opt,name=bounty_dispute_window,json=bountyDisputeWindow,proto3" json:"bounty_dispute_window,omitempty" yaml:"bounty_dispute_window"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -209,6 +213,20 @@ func (m *Params) GetStorageProvider() string {
 	return ""
 }
 
+func (m *Params) GetBountyArbiter() string {
+	if m != nil {
+		return m.BountyArbiter
+	}
+	return ""
+}
+
+func (m *Params) GetBountyDisputeWindow() int64 {
+	if m != nil {
+		return m.BountyDisputeWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DistributionProportion)(nil), "gitopia.gitopia.gitopia.DistributionProportion")
 	proto.RegisterType((*PoolProportions)(nil), "gitopia.gitopia.gitopia.PoolProportions")
@@ -218,42 +236,46 @@ func init() {
 func init() { proto.RegisterFile("gitopia/params.proto", fileDescriptor_cdae11692a018c3a) }
 
 var fileDescriptor_cdae11692a018c3a = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0x8e, 0x69, 0x08, 0xca, 0x05, 0x91, 0xd4, 0x0d, 0x34, 0x0a, 0x92, 0xaf, 0xba, 0xa1, 0xaa,
-	0x10, 0xd8, 0x12, 0x30, 0x75, 0x0c, 0x11, 0x12, 0x5b, 0x64, 0x3a, 0x31, 0x10, 0x39, 0xce, 0xf5,
-	0x38, 0x11, 0xe7, 0xac, 0xbb, 0x4b, 0xd5, 0x88, 0x3f, 0xd1, 0x91, 0x91, 0x9d, 0x3f, 0xd2, 0x81,
-	0xa1, 0x12, 0x0b, 0x62, 0x30, 0x28, 0xf9, 0x07, 0xfe, 0x05, 0xe8, 0x3e, 0x1c, 0x07, 0xab, 0x55,
-	0xa5, 0x4e, 0x77, 0x7e, 0x3f, 0x9e, 0xe7, 0x7d, 0x9f, 0x27, 0x17, 0xd0, 0x25, 0x54, 0xb2, 0x94,
-	0x46, 0x41, 0x1a, 0xf1, 0x28, 0x11, 0x7e, 0xca, 0x99, 0x64, 0xee, 0xbe, 0x8d, 0xfa, 0x95, 0xb3,
-	0xdf, 0x25, 0x8c, 0x30, 0x5d, 0x13, 0xa8, 0x9b, 0x29, 0xef, 0x43, 0xc2, 0x18, 0x99, 0xe1, 0x40,
-	0x7f, 0x4d, 0x16, 0xa7, 0x81, 0xa4, 0x09, 0x16, 0x32, 0x4a, 0x52, 0x53, 0x80, 0xbe, 0x3b, 0xe0,
-	0xc9, 0x90, 0x0a, 0xc9, 0xe9, 0x64, 0x21, 0x29, 0x9b, 0x8f, 0x38, 0x4b, 0x19, 0x57, 0x37, 0x37,
-	0x06, 0x20, 0xdd, 0x7c, 0xf5, 0x9c, 0x03, 0xe7, 0xa8, 0x39, 0x78, 0x73, 0x99, 0xc1, 0xda, 0xef,
-	0x0c, 0x1e, 0x12, 0x2a, 0x3f, 0x2d, 0x26, 0x7e, 0xcc, 0x92, 0x20, 0x66, 0x22, 0x61, 0xc2, 0x1e,
-	0x2f, 0xc4, 0xf4, 0x73, 0x20, 0x97, 0x29, 0x16, 0xfe, 0x10, 0xc7, 0x79, 0x06, 0x77, 0x97, 0x51,
-	0x32, 0x3b, 0x46, 0x25, 0x12, 0x0a, 0xb7, 0x60, 0xdd, 0xe7, 0xe0, 0x41, 0x34, 0x9d, 0x72, 0x2c,
-	0x44, 0xef, 0x9e, 0x66, 0x70, 0xf3, 0x0c, 0x3e, 0x32, 0x3d, 0x36, 0x81, 0xc2, 0xa2, 0x04, 0xfd,
-	0x70, 0x40, 0x7b, 0xc4, 0xd8, 0xac, 0x9c, 0x52, 0xb8, 0x31, 0x68, 0xe2, 0x98, 0x89, 0xa5, 0x90,
-	0x38, 0xd1, 0x53, 0xb6, 0x5e, 0x06, 0xfe, 0x0d, 0x2a, 0xf9, 0xd7, 0xaf, 0x3a, 0xe8, 0xe6, 0x19,
-	0xec, 0x18, 0xd2, 0x0d, 0x16, 0x0a, 0x4b, 0x5c, 0xf7, 0x04, 0xd4, 0x25, 0x8e, 0x12, 0x3d, 0xe3,
-	0x1d, 0xf0, 0xdb, 0x79, 0x06, 0x5b, 0x06, 0x5f, 0xc1, 0xa0, 0x50, 0xa3, 0xa1, 0x9f, 0x75, 0xd0,
-	0x18, 0x69, 0x77, 0x5d, 0x0e, 0xf6, 0xe6, 0xf8, 0x5c, 0x8e, 0xe9, 0xfc, 0x74, 0x16, 0xa9, 0x9e,
-	0xb1, 0x72, 0xca, 0xee, 0xd3, 0xf7, 0x8d, 0x8d, 0x7e, 0x61, 0xa3, 0x7f, 0x52, 0xd8, 0x38, 0x38,
-	0x54, 0x8e, 0xe4, 0x19, 0xec, 0x1b, 0xf8, 0x6b, 0x40, 0xd0, 0xc5, 0x1f, 0xe8, 0x84, 0xbb, 0x2a,
-	0xf3, 0xae, 0x48, 0xa8, 0x7e, 0x57, 0x82, 0x4e, 0xca, 0xd8, 0x6c, 0x5c, 0xda, 0x21, 0xec, 0x82,
-	0x47, 0x37, 0x2e, 0x58, 0x51, 0x7f, 0x00, 0x2d, 0xfd, 0xbe, 0xb5, 0xb9, 0x82, 0x87, 0xc2, 0x76,
-	0x5a, 0xf1, 0xeb, 0x0b, 0xe8, 0xa8, 0xe5, 0xff, 0x63, 0xdd, 0x39, 0xd8, 0xb9, 0x8b, 0xac, 0x15,
-	0xf2, 0x2a, 0x2c, 0x0a, 0xdb, 0x2a, 0xb4, 0x4d, 0xfe, 0x11, 0x3c, 0x24, 0x78, 0x8e, 0x05, 0x15,
-	0x46, 0xdf, 0xfa, 0xad, 0xfa, 0x16, 0x1c, 0x7b, 0x86, 0x63, 0xbb, 0xdb, 0x08, 0xdb, 0xb2, 0x21,
-	0x2d, 0xe9, 0x6b, 0x00, 0x08, 0x95, 0x63, 0x81, 0xf9, 0x19, 0xe6, 0xbd, 0xfb, 0xfa, 0x17, 0xfd,
-	0xb8, 0x7c, 0x05, 0x65, 0x0e, 0x85, 0x4d, 0x42, 0xe5, 0x7b, 0x7d, 0x77, 0xdf, 0x82, 0x8e, 0x90,
-	0x8c, 0x47, 0x04, 0xab, 0xf1, 0xcf, 0xe8, 0x14, 0xf3, 0x5e, 0x43, 0xf7, 0x3e, 0x2d, 0xb7, 0xab,
-	0x56, 0xa0, 0xb0, 0x6d, 0x43, 0x23, 0x1b, 0x39, 0xae, 0x7f, 0xfd, 0x06, 0x6b, 0x83, 0xe1, 0xe5,
-	0xca, 0x73, 0xae, 0x56, 0x9e, 0xf3, 0x77, 0xe5, 0x39, 0x17, 0x6b, 0xaf, 0x76, 0xb5, 0xf6, 0x6a,
-	0xbf, 0xd6, 0x5e, 0xed, 0xc3, 0xb3, 0xad, 0x57, 0x5b, 0xfc, 0xbb, 0x14, 0xe7, 0xf9, 0xe6, 0xa6,
-	0x5f, 0xef, 0xa4, 0xa1, 0xb5, 0x78, 0xf5, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xce, 0x94, 0x19, 0x2c,
-	0x87, 0x04, 0x00, 0x00,
+	// 619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbf, 0x6f, 0xd4, 0x30,
+	0x14, 0xbe, 0xf4, 0x27, 0x75, 0xa1, 0x77, 0x4d, 0x5b, 0x1a, 0x0e, 0x74, 0x3e, 0x79, 0xa8, 0x2a,
+	0x04, 0x89, 0x04, 0x4c, 0x9d, 0x20, 0x9c, 0x90, 0xd8, 0x4e, 0xa1, 0x12, 0x12, 0x03, 0x51, 0x7e,
+	0xb8, 0xc1, 0xe2, 0x12, 0x47, 0xb6, 0xaf, 0xed, 0x89, 0x7f, 0xa2, 0x23, 0x23, 0x3b, 0xff, 0x48,
+	0x07, 0x86, 0x8e, 0x88, 0x21, 0xa0, 0x76, 0x64, 0xcb, 0x5f, 0x80, 0x62, 0x27, 0x97, 0xbb, 0xa8,
+	0x15, 0x52, 0x27, 0xdb, 0xdf, 0x7b, 0xef, 0xfb, 0xfc, 0xde, 0x97, 0x18, 0x6c, 0x47, 0x44, 0xd0,
+	0x94, 0x78, 0x56, 0xea, 0x31, 0x2f, 0xe6, 0x66, 0xca, 0xa8, 0xa0, 0xfa, 0x6e, 0x89, 0x9a, 0x8d,
+	0xb5, 0xbb, 0x1d, 0xd1, 0x88, 0xca, 0x1c, 0xab, 0xd8, 0xa9, 0xf4, 0x2e, 0x8c, 0x28, 0x8d, 0x46,
+	0xd8, 0x92, 0x27, 0x7f, 0x7c, 0x64, 0x09, 0x12, 0x63, 0x2e, 0xbc, 0x38, 0x55, 0x09, 0xe8, 0xbb,
+	0x06, 0xee, 0x0f, 0x08, 0x17, 0x8c, 0xf8, 0x63, 0x41, 0x68, 0x32, 0x64, 0x34, 0xa5, 0xac, 0xd8,
+	0xe9, 0x01, 0x00, 0xe9, 0xf4, 0x64, 0x68, 0x7d, 0x6d, 0x7f, 0xcd, 0x7e, 0x7d, 0x9e, 0xc1, 0xd6,
+	0xaf, 0x0c, 0xee, 0x45, 0x44, 0x7c, 0x1a, 0xfb, 0x66, 0x40, 0x63, 0x2b, 0xa0, 0x3c, 0xa6, 0xbc,
+	0x5c, 0x9e, 0xf2, 0xf0, 0xb3, 0x25, 0x26, 0x29, 0xe6, 0xe6, 0x00, 0x07, 0x79, 0x06, 0x37, 0x27,
+	0x5e, 0x3c, 0x3a, 0x40, 0x35, 0x13, 0x72, 0x66, 0x68, 0xf5, 0x27, 0x60, 0xd5, 0x0b, 0x43, 0x86,
+	0x39, 0x37, 0x16, 0xa4, 0x82, 0x9e, 0x67, 0x70, 0x43, 0xd5, 0x94, 0x01, 0xe4, 0x54, 0x29, 0xe8,
+	0x87, 0x06, 0xda, 0x43, 0x4a, 0x47, 0xf5, 0x2d, 0xb9, 0x1e, 0x80, 0x35, 0x1c, 0x50, 0x3e, 0xe1,
+	0x02, 0xc7, 0xf2, 0x96, 0xeb, 0xcf, 0x2c, 0xf3, 0x86, 0x29, 0x99, 0xd7, 0xb7, 0x6a, 0x6f, 0xe7,
+	0x19, 0xec, 0x28, 0xd1, 0x29, 0x17, 0x72, 0x6a, 0x5e, 0xfd, 0x10, 0x2c, 0x09, 0xec, 0xc5, 0xc6,
+	0xc2, 0xed, 0xf8, 0xdb, 0x79, 0x06, 0xd7, 0x15, 0x7f, 0x41, 0x83, 0x1c, 0xc9, 0x86, 0xfe, 0x2e,
+	0x83, 0x95, 0xa1, 0x74, 0x57, 0x67, 0x60, 0x2b, 0xc1, 0xa7, 0xc2, 0x25, 0xc9, 0xd1, 0xc8, 0x2b,
+	0x6a, 0xdc, 0xc2, 0xa9, 0xb2, 0x9f, 0xae, 0xa9, 0x6c, 0x34, 0x2b, 0x1b, 0xcd, 0xc3, 0xca, 0x46,
+	0x7b, 0xaf, 0x70, 0x24, 0xcf, 0x60, 0x57, 0xd1, 0x5f, 0x43, 0x82, 0xce, 0x7e, 0x43, 0xcd, 0xd9,
+	0x2c, 0x22, 0x6f, 0xab, 0x40, 0x51, 0xaf, 0x0b, 0xd0, 0x49, 0x29, 0x1d, 0xb9, 0xb5, 0x1d, 0xbc,
+	0x6c, 0x70, 0xff, 0xc6, 0x06, 0x1b, 0xd3, 0xb7, 0x61, 0x29, 0xbf, 0x5b, 0xda, 0xdc, 0xe0, 0x43,
+	0x4e, 0x3b, 0x6d, 0xf8, 0xf5, 0x05, 0x74, 0x8a, 0xe6, 0xe7, 0x54, 0x17, 0xfb, 0x8b, 0xb7, 0x19,
+	0x6b, 0x43, 0xbc, 0x49, 0x8b, 0x9c, 0x76, 0x01, 0xcd, 0x8a, 0x7f, 0x04, 0x77, 0x23, 0x9c, 0x60,
+	0x4e, 0xb8, 0x9a, 0xef, 0xd2, 0x7f, 0xe7, 0x5b, 0x69, 0x6c, 0x29, 0x8d, 0xd9, 0x6a, 0x35, 0xd8,
+	0xf5, 0x12, 0x92, 0x23, 0x7d, 0x01, 0x40, 0x44, 0x84, 0xcb, 0x31, 0x3b, 0xc6, 0xcc, 0x58, 0x96,
+	0x5f, 0xf4, 0x4e, 0xfd, 0x17, 0xd4, 0x31, 0xe4, 0xac, 0x45, 0x44, 0xbc, 0x93, 0x7b, 0xfd, 0x0d,
+	0xe8, 0x70, 0x41, 0x99, 0x17, 0xe1, 0xe2, 0xfa, 0xc7, 0x24, 0xc4, 0xcc, 0x58, 0x91, 0xb5, 0x0f,
+	0xeb, 0xee, 0x9a, 0x19, 0xc8, 0x69, 0x97, 0xd0, 0xb0, 0x44, 0xf4, 0x97, 0x60, 0xc3, 0xa7, 0xe3,
+	0x44, 0x4c, 0x5c, 0x8f, 0xf9, 0x44, 0x60, 0x66, 0xac, 0x4a, 0x96, 0x07, 0x79, 0x06, 0x77, 0x14,
+	0xcb, 0x7c, 0x1c, 0x39, 0xf7, 0x14, 0xf0, 0x4a, 0x9d, 0xf5, 0x43, 0xb0, 0x53, 0x66, 0x84, 0x84,
+	0xa7, 0x63, 0x81, 0xdd, 0x13, 0x92, 0x84, 0xf4, 0xc4, 0xb8, 0xd3, 0xd7, 0xf6, 0x17, 0xed, 0x7e,
+	0x9e, 0xc1, 0x47, 0x73, 0x44, 0xf3, 0x69, 0xc8, 0xd9, 0x52, 0xf8, 0x40, 0xc1, 0xef, 0x25, 0x7a,
+	0xb0, 0xf4, 0xf5, 0x1b, 0x6c, 0xd9, 0x83, 0xf3, 0xcb, 0x9e, 0x76, 0x71, 0xd9, 0xd3, 0xfe, 0x5c,
+	0xf6, 0xb4, 0xb3, 0xab, 0x5e, 0xeb, 0xe2, 0xaa, 0xd7, 0xfa, 0x79, 0xd5, 0x6b, 0x7d, 0x78, 0x3c,
+	0xf3, 0x9a, 0x54, 0xaf, 0x5e, 0xb5, 0x9e, 0x4e, 0x77, 0xf2, 0x55, 0xf1, 0x57, 0xa4, 0x47, 0xcf,
+	0xff, 0x0d, 0x00, 0xc1, 0x70, 0x2e, 0xa9, 0x1f, 0x05, 0x00, 0x00,
 }
 
 func (m *DistributionProportion) Marshal() (dAtA []byte, err error) {
@@ -363,6 +385,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BountyDisputeWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BountyDisputeWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BountyArbiter) > 0 {
+		i -= len(m.BountyArbiter)
+		copy(dAtA[i:], m.BountyArbiter)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BountyArbiter)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.StorageProvider) > 0 {
 		i -= len(m.StorageProvider)
 		copy(dAtA[i:], m.StorageProvider)
@@ -489,6 +523,13 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = len(m.BountyArbiter)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.BountyDisputeWindow != 0 {
+		n += 1 + sovParams(uint64(m.BountyDisputeWindow))
+	}
 	return n
 }
 
@@ -962,6 +1003,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.StorageProvider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BountyArbiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BountyArbiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BountyDisputeWindow", wireType)
+			}
+			m.BountyDisputeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BountyDisputeWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
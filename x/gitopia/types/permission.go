@@ -3,19 +3,42 @@ package types
 /* Minimum Allowed Permissions */
 const (
 	AssignPermission                      = RepositoryCollaborator_TRIAGE
+	BountyAwardPermission                 = RepositoryCollaborator_WRITE
+	BountyDisputePermission               = RepositoryCollaborator_MAINTAIN
+	BountySplitPermission                 = RepositoryCollaborator_MAINTAIN
+	BranchProtectionRulePermission        = RepositoryCollaborator_ADMIN
+	CodeOwnersPermission                  = RepositoryCollaborator_ADMIN
+	CommentModerationPermission           = RepositoryCollaborator_TRIAGE
+	CommitStatusPermission                = RepositoryCollaborator_WRITE
 	DefaultBranchPermission               = RepositoryCollaborator_ADMIN
 	DeleteIssuePermission                 = RepositoryCollaborator_ADMIN
 	DeleteRepositoryPermission            = RepositoryCollaborator_ADMIN
+	IssueTransferPermission               = RepositoryCollaborator_TRIAGE
+	IssueTransferAssigneePermission       = RepositoryCollaborator_READ
 	LabelPermission                       = RepositoryCollaborator_TRIAGE
 	LinkPullRequestIssuePermission        = RepositoryCollaborator_TRIAGE
+	LockConversationPermission            = RepositoryCollaborator_TRIAGE
+	LockedConversationCommentPermission   = RepositoryCollaborator_READ
+	MilestonePermission                   = RepositoryCollaborator_TRIAGE
+	PullRequestAutoMergePermission        = RepositoryCollaborator_ADMIN
+	PullRequestChangedPathsPermission     = RepositoryCollaborator_WRITE
+	PullRequestCommitMessagesPermission   = RepositoryCollaborator_WRITE
 	PullRequestCreatePermission           = RepositoryCollaborator_WRITE
+	PullRequestDraftPermission            = RepositoryCollaborator_WRITE
 	PullRequestMergePermission            = RepositoryCollaborator_WRITE
+	PullRequestMergeQueuePermission       = RepositoryCollaborator_ADMIN
+	PullRequestResolveThreadPermission    = RepositoryCollaborator_WRITE
+	PullRequestReviewPermission           = RepositoryCollaborator_WRITE
 	PushBranchPermission                  = RepositoryCollaborator_WRITE
 	PushProtectedBranchPermission         = RepositoryCollaborator_ADMIN
 	PushTagPermission                     = RepositoryCollaborator_WRITE
 	ReleasePermission                     = RepositoryCollaborator_WRITE
+	RepositoryArchivePermission           = RepositoryCollaborator_ADMIN
 	RepositoryCollaboratorPermission      = RepositoryCollaborator_ADMIN
+	RepositoryIssueTemplatePermission     = RepositoryCollaborator_ADMIN
 	RepositoryLabelPermission             = RepositoryCollaborator_WRITE
+	RepositoryMergeMethodsPermission      = RepositoryCollaborator_ADMIN
+	RepositoryMilestonePermission         = RepositoryCollaborator_WRITE
 	RepositoryRenamePermission            = RepositoryCollaborator_ADMIN
 	RepositoryTransferOwnershipPermission = RepositoryCollaborator_ADMIN
 	RepositoryUpdateDescriptionPermission = RepositoryCollaborator_MAINTAIN
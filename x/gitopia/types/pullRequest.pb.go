@@ -51,6 +51,34 @@ func (PullRequest_State) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ee729f91ddeb1e95, []int{0, 0}
 }
 
+type PullRequestReview_State int32
+
+const (
+	PullRequestReview_COMMENT         PullRequestReview_State = 0
+	PullRequestReview_APPROVE         PullRequestReview_State = 1
+	PullRequestReview_REQUEST_CHANGES PullRequestReview_State = 2
+)
+
+var PullRequestReview_State_name = map[int32]string{
+	0: "COMMENT",
+	1: "APPROVE",
+	2: "REQUEST_CHANGES",
+}
+
+var PullRequestReview_State_value = map[string]int32{
+	"COMMENT":         0,
+	"APPROVE":         1,
+	"REQUEST_CHANGES": 2,
+}
+
+func (x PullRequestReview_State) String() string {
+	return proto.EnumName(PullRequestReview_State_name, int32(x))
+}
+
+func (PullRequestReview_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ee729f91ddeb1e95, []int{4, 0}
+}
+
 type PullRequest struct {
 	Creator             string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id                  uint64            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -75,6 +103,15 @@ type PullRequest struct {
 	MaintainerCanModify bool              `protobuf:"varint,21,opt,name=maintainerCanModify,proto3" json:"maintainerCanModify,omitempty"`
 	Head                *PullRequestHead  `protobuf:"bytes,22,opt,name=head,proto3" json:"head,omitempty"`
 	Base                *PullRequestBase  `protobuf:"bytes,23,opt,name=base,proto3" json:"base,omitempty"`
+	MergeMethod         MergeMethod       `protobuf:"varint,24,opt,name=mergeMethod,proto3,enum=gitopia.gitopia.gitopia.MergeMethod" json:"mergeMethod,omitempty"`
+	ChangedPaths        []string          `protobuf:"bytes,25,rep,name=changedPaths,proto3" json:"changedPaths,omitempty"`
+	Reactions           []*Reaction       `protobuf:"bytes,26,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	ReactionCounts      []*ReactionCount  `protobuf:"bytes,27,rep,name=reactionCounts,proto3" json:"reactionCounts,omitempty"`
+	Milestone           uint64            `protobuf:"varint,28,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	CrossReferences     []*IssueIid       `protobuf:"bytes,29,rep,name=crossReferences,proto3" json:"crossReferences,omitempty"`
+	Bounties            []uint64          `protobuf:"varint,30,rep,packed,name=bounties,proto3" json:"bounties,omitempty"`
+	// head commit the changed paths were reported for
+	ChangedPathsSha string `protobuf:"bytes,31,opt,name=changedPathsSha,proto3" json:"changedPathsSha,omitempty"`
 }
 
 func (m *PullRequest) Reset()         { *m = PullRequest{} }
@@ -271,6 +308,146 @@ func (m *PullRequest) GetBase() *PullRequestBase {
 	return nil
 }
 
+func (m *PullRequest) GetMergeMethod() MergeMethod {
+	if m != nil {
+		return m.MergeMethod
+	}
+	return MergeMethod_MERGE
+}
+
+func (m *PullRequest) GetChangedPaths() []string {
+	if m != nil {
+		return m.ChangedPaths
+	}
+	return nil
+}
+
+func (m *PullRequest) GetReactions() []*Reaction {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
+func (m *PullRequest) GetReactionCounts() []*ReactionCount {
+	if m != nil {
+		return m.ReactionCounts
+	}
+	return nil
+}
+
+func (m *PullRequest) GetMilestone() uint64 {
+	if m != nil {
+		return m.Milestone
+	}
+	return 0
+}
+
+func (m *PullRequest) GetCrossReferences() []*IssueIid {
+	if m != nil {
+		return m.CrossReferences
+	}
+	return nil
+}
+
+func (m *PullRequest) GetBounties() []uint64 {
+	if m != nil {
+		return m.Bounties
+	}
+	return nil
+}
+
+func (m *PullRequest) GetChangedPathsSha() string {
+	if m != nil {
+		return m.ChangedPathsSha
+	}
+	return ""
+}
+
+type PullRequestAutoMerge struct {
+	RepositoryId   uint64      `protobuf:"varint,1,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	PullRequestIid uint64      `protobuf:"varint,2,opt,name=pullRequestIid,proto3" json:"pullRequestIid,omitempty"`
+	Creator        string      `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider       string      `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	MergeMethod    MergeMethod `protobuf:"varint,5,opt,name=mergeMethod,proto3,enum=gitopia.gitopia.gitopia.MergeMethod" json:"mergeMethod,omitempty"`
+	CreatedAt      int64       `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (m *PullRequestAutoMerge) Reset()         { *m = PullRequestAutoMerge{} }
+func (m *PullRequestAutoMerge) String() string { return proto.CompactTextString(m) }
+func (*PullRequestAutoMerge) ProtoMessage()    {}
+func (*PullRequestAutoMerge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee729f91ddeb1e95, []int{1}
+}
+func (m *PullRequestAutoMerge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullRequestAutoMerge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullRequestAutoMerge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PullRequestAutoMerge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullRequestAutoMerge.Merge(m, src)
+}
+func (m *PullRequestAutoMerge) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullRequestAutoMerge) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullRequestAutoMerge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullRequestAutoMerge proto.InternalMessageInfo
+
+func (m *PullRequestAutoMerge) GetRepositoryId() uint64 {
+	if m != nil {
+		return m.RepositoryId
+	}
+	return 0
+}
+
+func (m *PullRequestAutoMerge) GetPullRequestIid() uint64 {
+	if m != nil {
+		return m.PullRequestIid
+	}
+	return 0
+}
+
+func (m *PullRequestAutoMerge) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PullRequestAutoMerge) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *PullRequestAutoMerge) GetMergeMethod() MergeMethod {
+	if m != nil {
+		return m.MergeMethod
+	}
+	return MergeMethod_MERGE
+}
+
+func (m *PullRequestAutoMerge) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
 type PullRequestHead struct {
 	RepositoryId uint64 `protobuf:"varint,1,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
 	Branch       string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
@@ -281,7 +458,7 @@ func (m *PullRequestHead) Reset()         { *m = PullRequestHead{} }
 func (m *PullRequestHead) String() string { return proto.CompactTextString(m) }
 func (*PullRequestHead) ProtoMessage()    {}
 func (*PullRequestHead) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee729f91ddeb1e95, []int{1}
+	return fileDescriptor_ee729f91ddeb1e95, []int{2}
 }
 func (m *PullRequestHead) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +518,7 @@ func (m *PullRequestBase) Reset()         { *m = PullRequestBase{} }
 func (m *PullRequestBase) String() string { return proto.CompactTextString(m) }
 func (*PullRequestBase) ProtoMessage()    {}
 func (*PullRequestBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee729f91ddeb1e95, []int{2}
+	return fileDescriptor_ee729f91ddeb1e95, []int{3}
 }
 func (m *PullRequestBase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,55 +568,186 @@ func (m *PullRequestBase) GetCommitSha() string {
 	return ""
 }
 
+type PullRequestReview struct {
+	Id             uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RepositoryId   uint64                  `protobuf:"varint,2,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	PullRequestIid uint64                  `protobuf:"varint,3,opt,name=pullRequestIid,proto3" json:"pullRequestIid,omitempty"`
+	Creator        string                  `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	State          PullRequestReview_State `protobuf:"varint,5,opt,name=state,proto3,enum=gitopia.gitopia.gitopia.PullRequestReview_State" json:"state,omitempty"`
+	Body           string                  `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	CommitSha      string                  `protobuf:"bytes,7,opt,name=commitSha,proto3" json:"commitSha,omitempty"`
+	Stale          bool                    `protobuf:"varint,8,opt,name=stale,proto3" json:"stale,omitempty"`
+	CreatedAt      int64                   `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (m *PullRequestReview) Reset()         { *m = PullRequestReview{} }
+func (m *PullRequestReview) String() string { return proto.CompactTextString(m) }
+func (*PullRequestReview) ProtoMessage()    {}
+func (*PullRequestReview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee729f91ddeb1e95, []int{4}
+}
+func (m *PullRequestReview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullRequestReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullRequestReview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PullRequestReview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullRequestReview.Merge(m, src)
+}
+func (m *PullRequestReview) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullRequestReview) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullRequestReview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullRequestReview proto.InternalMessageInfo
+
+func (m *PullRequestReview) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PullRequestReview) GetRepositoryId() uint64 {
+	if m != nil {
+		return m.RepositoryId
+	}
+	return 0
+}
+
+func (m *PullRequestReview) GetPullRequestIid() uint64 {
+	if m != nil {
+		return m.PullRequestIid
+	}
+	return 0
+}
+
+func (m *PullRequestReview) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PullRequestReview) GetState() PullRequestReview_State {
+	if m != nil {
+		return m.State
+	}
+	return PullRequestReview_COMMENT
+}
+
+func (m *PullRequestReview) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *PullRequestReview) GetCommitSha() string {
+	if m != nil {
+		return m.CommitSha
+	}
+	return ""
+}
+
+func (m *PullRequestReview) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+func (m *PullRequestReview) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("gitopia.gitopia.gitopia.PullRequest_State", PullRequest_State_name, PullRequest_State_value)
+	proto.RegisterEnum("gitopia.gitopia.gitopia.PullRequestReview_State", PullRequestReview_State_name, PullRequestReview_State_value)
 	proto.RegisterType((*PullRequest)(nil), "gitopia.gitopia.gitopia.PullRequest")
+	proto.RegisterType((*PullRequestAutoMerge)(nil), "gitopia.gitopia.gitopia.PullRequestAutoMerge")
 	proto.RegisterType((*PullRequestHead)(nil), "gitopia.gitopia.gitopia.PullRequestHead")
 	proto.RegisterType((*PullRequestBase)(nil), "gitopia.gitopia.gitopia.PullRequestBase")
+	proto.RegisterType((*PullRequestReview)(nil), "gitopia.gitopia.gitopia.PullRequestReview")
 }
 
 func init() { proto.RegisterFile("gitopia/pullRequest.proto", fileDescriptor_ee729f91ddeb1e95) }
 
 var fileDescriptor_ee729f91ddeb1e95 = []byte{
-	// 608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0x13, 0x3d,
-	0x14, 0xcd, 0x64, 0x92, 0x34, 0x71, 0xda, 0x34, 0x9f, 0xdb, 0xaf, 0x35, 0x15, 0x8a, 0x86, 0x08,
-	0xa1, 0xa1, 0x8b, 0x14, 0x95, 0x15, 0x12, 0x0b, 0x48, 0x1a, 0x41, 0x25, 0x4a, 0x2b, 0x77, 0xc7,
-	0xce, 0x19, 0xdf, 0x26, 0x56, 0x33, 0xe3, 0xc1, 0x76, 0x80, 0xbc, 0x05, 0x8f, 0xc5, 0xb2, 0x12,
-	0x1b, 0x96, 0xa8, 0x7d, 0x11, 0x64, 0x4f, 0x32, 0xd3, 0x46, 0x54, 0xea, 0x86, 0x95, 0xef, 0x39,
-	0xe7, 0x1e, 0xfb, 0xfa, 0xe7, 0x1a, 0x3d, 0x1a, 0x0b, 0x23, 0x53, 0xc1, 0x0e, 0xd2, 0xd9, 0x74,
-	0x4a, 0xe1, 0xf3, 0x0c, 0xb4, 0xe9, 0xa5, 0x4a, 0x1a, 0x89, 0x77, 0x17, 0x52, 0x6f, 0x65, 0xdc,
-	0xdb, 0x1e, 0xcb, 0xb1, 0x74, 0x39, 0x07, 0x36, 0xca, 0xd2, 0xf7, 0xc8, 0x72, 0x26, 0x05, 0xa9,
-	0xd4, 0xc2, 0x48, 0x35, 0xcf, 0x94, 0xee, 0xcf, 0x1a, 0x6a, 0x9e, 0x15, 0xd3, 0x63, 0x82, 0xd6,
-	0x22, 0x05, 0xcc, 0x48, 0x45, 0xbc, 0xc0, 0x0b, 0x1b, 0x74, 0x09, 0x71, 0x0b, 0x95, 0x05, 0x27,
-	0xe5, 0xc0, 0x0b, 0x2b, 0xb4, 0x2c, 0x38, 0x6e, 0x23, 0x5f, 0x08, 0x4e, 0x7c, 0x47, 0xd8, 0x10,
-	0x6f, 0xa3, 0xaa, 0x11, 0x66, 0x0a, 0xa4, 0xe2, 0x9c, 0x19, 0xc0, 0x6f, 0x50, 0x55, 0x1b, 0x66,
-	0x80, 0x54, 0x03, 0x2f, 0x6c, 0x1d, 0xee, 0xf7, 0xee, 0x29, 0xbd, 0x77, 0xab, 0x8c, 0xde, 0xb9,
-	0x75, 0xd0, 0xcc, 0x88, 0x03, 0xd4, 0xe4, 0xa0, 0x23, 0x25, 0x52, 0x23, 0x64, 0x42, 0x6a, 0x6e,
-	0xf6, 0xdb, 0x14, 0xde, 0x41, 0xb5, 0xa9, 0x8c, 0x2e, 0x81, 0x93, 0xb5, 0xc0, 0x0b, 0xeb, 0x74,
-	0x81, 0xf0, 0x53, 0xb4, 0x11, 0xc9, 0x38, 0x86, 0xc4, 0xe8, 0x81, 0x9c, 0x25, 0x86, 0xd4, 0x5d,
-	0xb5, 0x77, 0x49, 0xfc, 0x0a, 0xd5, 0x84, 0xd6, 0x33, 0xd0, 0xa4, 0x11, 0xf8, 0x61, 0xf3, 0xf0,
-	0xc9, 0xbd, 0x25, 0x1e, 0xdb, 0xb4, 0x63, 0xc1, 0xe9, 0xc2, 0xe0, 0x16, 0x66, 0x23, 0x98, 0x6a,
-	0x82, 0x02, 0x3f, 0xac, 0xd0, 0x05, 0xc2, 0x8f, 0x51, 0x83, 0x69, 0x2d, 0xc6, 0x09, 0x80, 0x26,
-	0xcd, 0xc0, 0x0f, 0x1b, 0xb4, 0x20, 0xac, 0xaa, 0xe0, 0x8b, 0x80, 0xaf, 0xa0, 0x34, 0x59, 0xcf,
-	0xd4, 0x9c, 0xb0, 0xc7, 0xc8, 0x15, 0xbb, 0x30, 0x64, 0xc3, 0xed, 0x25, 0x03, 0xd6, 0xe3, 0x6e,
-	0x02, 0xf8, 0x5b, 0x43, 0x5a, 0x81, 0x17, 0xfa, 0xb4, 0x20, 0xac, 0x3a, 0x4b, 0xf9, 0x42, 0xdd,
-	0xcc, 0xd4, 0x9c, 0xc0, 0x7b, 0xa8, 0x1e, 0x4d, 0xa5, 0x76, 0x62, 0xdb, 0x89, 0x39, 0x2e, 0xb4,
-	0xfe, 0x9c, 0xfc, 0xe7, 0x4e, 0x36, 0xc7, 0x56, 0x8b, 0x41, 0x8d, 0x9d, 0x0f, 0x67, 0xbe, 0x25,
-	0x2e, 0xb4, 0xfe, 0x9c, 0x6c, 0x65, 0xbe, 0x25, 0xc6, 0xcf, 0x50, 0xcb, 0xc5, 0x03, 0x19, 0xc7,
-	0xc2, 0x9c, 0x4f, 0x18, 0xd9, 0x76, 0x19, 0x2b, 0x2c, 0x7e, 0x81, 0xb6, 0x62, 0x26, 0x12, 0xc3,
-	0x44, 0x02, 0x6a, 0xc0, 0x92, 0x13, 0xc9, 0xc5, 0xc5, 0x9c, 0xfc, 0xef, 0xf6, 0xfd, 0x37, 0x09,
-	0xbf, 0x46, 0x95, 0x09, 0x30, 0x4e, 0x76, 0x02, 0x2f, 0x6c, 0x1e, 0x86, 0x0f, 0x79, 0x4b, 0xef,
-	0x81, 0x71, 0xea, 0x5c, 0xd6, 0x3d, 0x62, 0x1a, 0xc8, 0xee, 0xc3, 0xdd, 0x7d, 0xa6, 0x81, 0x3a,
-	0x57, 0xf7, 0x39, 0xaa, 0xba, 0x67, 0x89, 0xeb, 0xa8, 0x72, 0x7a, 0x36, 0xfc, 0xd8, 0x2e, 0x61,
-	0x84, 0x6a, 0x83, 0x0f, 0xa7, 0xe7, 0xc3, 0xa3, 0xb6, 0x67, 0xe3, 0x93, 0x21, 0x7d, 0x37, 0x3c,
-	0x6a, 0x97, 0xbb, 0x97, 0x68, 0x73, 0xa5, 0x02, 0xdc, 0x45, 0xeb, 0x45, 0xf3, 0x1d, 0x73, 0xd7,
-	0x5d, 0x15, 0x7a, 0x87, 0xb3, 0xaf, 0x69, 0xa4, 0x58, 0x12, 0x4d, 0x5c, 0x9b, 0x35, 0xe8, 0x02,
-	0xb9, 0xbb, 0xcf, 0x8f, 0xd2, 0x77, 0x52, 0x41, 0xac, 0x2c, 0x66, 0x0b, 0xfe, 0x77, 0x8b, 0xf5,
-	0x8f, 0x7e, 0x5c, 0x77, 0xbc, 0xab, 0xeb, 0x8e, 0xf7, 0xfb, 0xba, 0xe3, 0x7d, 0xbf, 0xe9, 0x94,
-	0xae, 0x6e, 0x3a, 0xa5, 0x5f, 0x37, 0x9d, 0xd2, 0xa7, 0xfd, 0xb1, 0x30, 0x93, 0xd9, 0xa8, 0x17,
-	0xc9, 0xf8, 0x60, 0xf9, 0xdd, 0x2c, 0xc7, 0x6f, 0x79, 0x64, 0xe6, 0x29, 0xe8, 0x51, 0xcd, 0x7d,
-	0x3e, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xf7, 0x57, 0xa3, 0xe2, 0x04, 0x00, 0x00,
+	// 927 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xfa, 0x2b, 0xf6, 0xeb, 0xd6, 0x76, 0x27, 0x21, 0x9d, 0x9a, 0x62, 0x16, 0xab, 0xaa,
+	0x96, 0x1e, 0x9c, 0x2a, 0x48, 0x48, 0x48, 0x48, 0x90, 0x38, 0x6e, 0x1b, 0x81, 0x13, 0x33, 0x2e,
+	0x1c, 0xb8, 0xa0, 0xf1, 0xee, 0xc4, 0x1e, 0xd5, 0xde, 0x31, 0x3b, 0xe3, 0x82, 0x8f, 0xfc, 0x03,
+	0x7e, 0x16, 0xc7, 0x1e, 0x39, 0xa2, 0xe4, 0x07, 0xf0, 0x0f, 0x10, 0x9a, 0xd9, 0x2f, 0xef, 0x8a,
+	0x28, 0x16, 0x52, 0x4f, 0x3b, 0xcf, 0xf3, 0xce, 0x33, 0xef, 0x7c, 0xbc, 0x1f, 0x0b, 0x8f, 0x66,
+	0x5c, 0x89, 0x15, 0xa7, 0x47, 0xab, 0xf5, 0x62, 0x41, 0xd8, 0xcf, 0x6b, 0x26, 0x55, 0x7f, 0x15,
+	0x08, 0x25, 0xd0, 0xc3, 0xc8, 0xd4, 0xcf, 0x7d, 0x3b, 0x07, 0x33, 0x31, 0x13, 0x66, 0xce, 0x91,
+	0x1e, 0x85, 0xd3, 0x3b, 0x38, 0x5e, 0x29, 0x60, 0x2b, 0x21, 0xb9, 0x12, 0xc1, 0x26, 0xb2, 0x1c,
+	0xa6, 0x16, 0xea, 0x2a, 0x2e, 0xfc, 0x90, 0xef, 0xfd, 0x06, 0xd0, 0x18, 0xa7, 0x6e, 0x11, 0x86,
+	0x3d, 0x37, 0x60, 0x54, 0x89, 0x00, 0x5b, 0xb6, 0xe5, 0xd4, 0x49, 0x0c, 0x51, 0x13, 0x8a, 0xdc,
+	0xc3, 0x45, 0xdb, 0x72, 0xca, 0xa4, 0xc8, 0x3d, 0xd4, 0x86, 0x12, 0xe7, 0x1e, 0x2e, 0x19, 0x42,
+	0x0f, 0xd1, 0x01, 0x54, 0x14, 0x57, 0x0b, 0x86, 0xcb, 0x46, 0x19, 0x02, 0xf4, 0x35, 0x54, 0xa4,
+	0xa2, 0x8a, 0xe1, 0x8a, 0x6d, 0x39, 0xcd, 0xe3, 0x67, 0xfd, 0x5b, 0x8e, 0xd4, 0xdf, 0xda, 0x46,
+	0x7f, 0xa2, 0x15, 0x24, 0x14, 0x22, 0x1b, 0x1a, 0x1e, 0x93, 0x6e, 0xc0, 0x57, 0x7a, 0xe3, 0xb8,
+	0x6a, 0x56, 0xdf, 0xa6, 0xd0, 0x21, 0x54, 0x17, 0xc2, 0x7d, 0xc3, 0x3c, 0xbc, 0x67, 0x5b, 0x4e,
+	0x8d, 0x44, 0x08, 0x3d, 0x81, 0xfb, 0xae, 0x58, 0x2e, 0x99, 0xaf, 0xe4, 0x40, 0xac, 0x7d, 0x85,
+	0x6b, 0x66, 0xb7, 0x59, 0x12, 0x7d, 0x01, 0x55, 0x2e, 0xe5, 0x9a, 0x49, 0x5c, 0xb7, 0x4b, 0x4e,
+	0xe3, 0xf8, 0x93, 0x5b, 0xb7, 0x78, 0xae, 0xa7, 0x9d, 0x73, 0x8f, 0x44, 0x02, 0xe3, 0x98, 0x4e,
+	0xd9, 0x42, 0x62, 0xb0, 0x4b, 0x4e, 0x99, 0x44, 0x08, 0x3d, 0x86, 0x3a, 0x95, 0x92, 0xcf, 0x7c,
+	0xc6, 0x24, 0x6e, 0xd8, 0x25, 0xa7, 0x4e, 0x52, 0x42, 0x5b, 0x03, 0xf6, 0x96, 0xb3, 0x5f, 0x58,
+	0x20, 0xf1, 0xbd, 0xd0, 0x9a, 0x10, 0xfa, 0x1a, 0xbd, 0x80, 0x5e, 0x29, 0x7c, 0xdf, 0x9c, 0x25,
+	0x04, 0x5a, 0x63, 0x5e, 0x82, 0x79, 0x27, 0x0a, 0x37, 0x6d, 0xcb, 0x29, 0x91, 0x94, 0xd0, 0xd6,
+	0xf5, 0xca, 0x8b, 0xac, 0xad, 0xd0, 0x9a, 0x10, 0xa8, 0x03, 0x35, 0x77, 0x21, 0xa4, 0x31, 0xb6,
+	0x8d, 0x31, 0xc1, 0xa9, 0xed, 0x74, 0x83, 0x1f, 0x98, 0x9b, 0x4d, 0xb0, 0xb6, 0x2d, 0x59, 0x30,
+	0x33, 0x3a, 0x14, 0xea, 0x62, 0x9c, 0xda, 0x4e, 0x37, 0x78, 0x3f, 0xd4, 0xc5, 0x18, 0x3d, 0x85,
+	0xa6, 0x19, 0x0f, 0xc4, 0x72, 0xc9, 0xd5, 0x64, 0x4e, 0xf1, 0x81, 0x99, 0x91, 0x63, 0xd1, 0x73,
+	0xd8, 0x5f, 0x52, 0xee, 0x2b, 0xca, 0x7d, 0x16, 0x0c, 0xa8, 0x3f, 0x12, 0x1e, 0xbf, 0xda, 0xe0,
+	0x0f, 0xcc, 0xb9, 0xff, 0xcb, 0x84, 0xbe, 0x84, 0xf2, 0x9c, 0x51, 0x0f, 0x1f, 0xda, 0x96, 0xd3,
+	0x38, 0x76, 0x76, 0x89, 0xa5, 0x57, 0x8c, 0x7a, 0xc4, 0xa8, 0xb4, 0x7a, 0x4a, 0x25, 0xc3, 0x0f,
+	0x77, 0x57, 0x9f, 0x52, 0xc9, 0x88, 0x51, 0xa1, 0x17, 0xd0, 0x30, 0xfb, 0x1f, 0x31, 0x35, 0x17,
+	0x1e, 0xc6, 0x26, 0x9c, 0x9f, 0xdc, 0xba, 0xc8, 0x28, 0x9d, 0x4b, 0xb6, 0x85, 0xa8, 0x07, 0xf7,
+	0xdc, 0x39, 0xf5, 0x67, 0xcc, 0x1b, 0x53, 0x35, 0x97, 0xf8, 0x91, 0x09, 0x80, 0x0c, 0x87, 0xbe,
+	0x82, 0x7a, 0x9c, 0xa8, 0x12, 0x77, 0xee, 0x88, 0x4a, 0x12, 0xcd, 0x24, 0xa9, 0x06, 0x5d, 0x40,
+	0x33, 0x06, 0x26, 0xc8, 0x25, 0xfe, 0xd0, 0xac, 0xf2, 0xf4, 0xce, 0x55, 0xcc, 0x74, 0x92, 0x53,
+	0xeb, 0x00, 0x5b, 0xf2, 0x05, 0x93, 0x4a, 0xf8, 0x0c, 0x3f, 0x36, 0x59, 0x94, 0x12, 0xe8, 0x1b,
+	0x68, 0xb9, 0x81, 0x90, 0x92, 0xb0, 0x2b, 0x16, 0x30, 0xdf, 0x65, 0x12, 0x7f, 0xb4, 0x6b, 0x2a,
+	0xe5, 0x95, 0x3a, 0xb2, 0xa6, 0xda, 0x29, 0x67, 0x12, 0x77, 0x4d, 0x56, 0x25, 0x18, 0x39, 0xd0,
+	0xda, 0xbe, 0x27, 0x1d, 0x5a, 0x1f, 0x9b, 0xd0, 0xca, 0xd3, 0xbd, 0x4f, 0xa1, 0x62, 0x8a, 0x08,
+	0xaa, 0x41, 0xf9, 0x72, 0x3c, 0xbc, 0x68, 0x17, 0x10, 0x40, 0x75, 0xf0, 0xed, 0xe5, 0x64, 0x78,
+	0xd6, 0xb6, 0xf4, 0x78, 0x34, 0x24, 0x2f, 0x87, 0x67, 0xed, 0x62, 0xef, 0x1f, 0x0b, 0x0e, 0xb6,
+	0x9e, 0xfc, 0x64, 0xad, 0x84, 0x79, 0x3c, 0xfd, 0x52, 0x69, 0x21, 0x3d, 0xf7, 0x4c, 0x45, 0x2c,
+	0x93, 0x0c, 0xa7, 0x63, 0x7d, 0xab, 0x6c, 0x9f, 0x27, 0x25, 0x32, 0xc7, 0x6e, 0x17, 0xd6, 0x52,
+	0xb6, 0xb0, 0x76, 0xa0, 0xb6, 0x0a, 0xc4, 0x5b, 0xee, 0xb1, 0x20, 0xaa, 0x9c, 0x09, 0xce, 0xc7,
+	0x5c, 0xe5, 0xff, 0xc6, 0x5c, 0xa6, 0x7a, 0x54, 0x73, 0xd5, 0xa3, 0xf7, 0x06, 0x5a, 0xb9, 0x84,
+	0xd9, 0xe9, 0xe8, 0x87, 0x50, 0x9d, 0x06, 0xd4, 0x77, 0xe7, 0xe6, 0xc8, 0x75, 0x12, 0x21, 0xe3,
+	0x2c, 0xc9, 0xfc, 0xf0, 0xb0, 0x29, 0x91, 0x73, 0xa6, 0xf3, 0xeb, 0x3d, 0x3a, 0xfb, 0xbb, 0x08,
+	0x0f, 0xb6, 0xbc, 0x11, 0x53, 0x64, 0xa3, 0x56, 0x66, 0x25, 0xad, 0x2c, 0xef, 0xbf, 0xb8, 0xd3,
+	0x3b, 0x97, 0xee, 0x7a, 0xe7, 0x72, 0xf6, 0x9d, 0x5f, 0x64, 0x1b, 0xe1, 0xf3, 0x5d, 0xca, 0x4f,
+	0xb8, 0xe1, 0x6c, 0x3b, 0x44, 0x50, 0x9e, 0x0a, 0x6f, 0x13, 0xf5, 0x41, 0x33, 0xce, 0xde, 0xc2,
+	0x5e, 0xee, 0x16, 0x74, 0x47, 0x91, 0x8a, 0x2e, 0x98, 0x69, 0x7f, 0x35, 0x12, 0x82, 0x6c, 0x4c,
+	0xd4, 0xf3, 0x31, 0xf1, 0x79, 0x9c, 0x3f, 0x0d, 0xd8, 0x1b, 0x5c, 0x8e, 0x46, 0xc3, 0x8b, 0xd7,
+	0xed, 0x82, 0x06, 0x27, 0xe3, 0x31, 0xb9, 0xfc, 0x61, 0xd8, 0xb6, 0xd0, 0x3e, 0xb4, 0xc8, 0xf0,
+	0xbb, 0xef, 0x87, 0x93, 0xd7, 0x3f, 0x0d, 0x5e, 0x9d, 0x5c, 0xbc, 0x1c, 0x4e, 0xda, 0xc5, 0xd3,
+	0xb3, 0x3f, 0xae, 0xbb, 0xd6, 0xbb, 0xeb, 0xae, 0xf5, 0xd7, 0x75, 0xd7, 0xfa, 0xfd, 0xa6, 0x5b,
+	0x78, 0x77, 0xd3, 0x2d, 0xfc, 0x79, 0xd3, 0x2d, 0xfc, 0xf8, 0x6c, 0xc6, 0xd5, 0x7c, 0x3d, 0xed,
+	0xbb, 0x62, 0x79, 0x14, 0xff, 0x8d, 0xc4, 0xdf, 0x5f, 0x93, 0x91, 0xda, 0xac, 0x98, 0x9c, 0x56,
+	0xcd, 0xdf, 0xc9, 0x67, 0xff, 0x0e, 0x00, 0x42, 0x30, 0x03, 0xc7, 0x1b, 0x09, 0x00, 0x00,
 }
 
 func (m *PullRequest) Marshal() (dAtA []byte, err error) {
@@ -462,6 +770,108 @@ func (m *PullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChangedPathsSha) > 0 {
+		i -= len(m.ChangedPathsSha)
+		copy(dAtA[i:], m.ChangedPathsSha)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.ChangedPathsSha)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.Bounties) > 0 {
+		dAtA2 := make([]byte, len(m.Bounties)*10)
+		var j1 int
+		for _, num := range m.Bounties {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPullRequest(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.CrossReferences) > 0 {
+		for iNdEx := len(m.CrossReferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossReferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPullRequest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.Milestone != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.Milestone))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.ReactionCounts) > 0 {
+		for iNdEx := len(m.ReactionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReactionCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPullRequest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.Reactions) > 0 {
+		for iNdEx := len(m.Reactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPullRequest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.ChangedPaths) > 0 {
+		for iNdEx := len(m.ChangedPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedPaths[iNdEx])
+			copy(dAtA[i:], m.ChangedPaths[iNdEx])
+			i = encodeVarintPullRequest(dAtA, i, uint64(len(m.ChangedPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.MergeMethod != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.MergeMethod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
 	if m.Base != nil {
 		{
 			size, err := m.Base.MarshalToSizedBuffer(dAtA[:i])
@@ -582,20 +992,20 @@ func (m *PullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Labels) > 0 {
-		dAtA4 := make([]byte, len(m.Labels)*10)
-		var j3 int
+		dAtA6 := make([]byte, len(m.Labels)*10)
+		var j5 int
 		for _, num := range m.Labels {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j5++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintPullRequest(dAtA, i, uint64(j3))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintPullRequest(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -667,7 +1077,7 @@ func (m *PullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PullRequestHead) Marshal() (dAtA []byte, err error) {
+func (m *PullRequestAutoMerge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -677,34 +1087,91 @@ func (m *PullRequestHead) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PullRequestHead) MarshalTo(dAtA []byte) (int, error) {
+func (m *PullRequestAutoMerge) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PullRequestHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PullRequestAutoMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CommitSha) > 0 {
-		i -= len(m.CommitSha)
-		copy(dAtA[i:], m.CommitSha)
-		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.CommitSha)))
+	if m.CreatedAt != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.CreatedAt))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x30
 	}
-	if len(m.Branch) > 0 {
-		i -= len(m.Branch)
-		copy(dAtA[i:], m.Branch)
-		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.Branch)))
+	if m.MergeMethod != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.MergeMethod))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x28
 	}
-	if m.RepositoryId != 0 {
-		i = encodeVarintPullRequest(dAtA, i, uint64(m.RepositoryId))
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.Provider)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PullRequestIid != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.PullRequestIid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RepositoryId != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.RepositoryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullRequestHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullRequestHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullRequestHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommitSha) > 0 {
+		i -= len(m.CommitSha)
+		copy(dAtA[i:], m.CommitSha)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.CommitSha)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.Branch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RepositoryId != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.RepositoryId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
@@ -751,6 +1218,85 @@ func (m *PullRequestBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PullRequestReview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullRequestReview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullRequestReview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.CommitSha) > 0 {
+		i -= len(m.CommitSha)
+		copy(dAtA[i:], m.CommitSha)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.CommitSha)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.State != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PullRequestIid != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.PullRequestIid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RepositoryId != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.RepositoryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPullRequest(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPullRequest(v)
 	base := offset
@@ -858,6 +1404,76 @@ func (m *PullRequest) Size() (n int) {
 		l = m.Base.Size()
 		n += 2 + l + sovPullRequest(uint64(l))
 	}
+	if m.MergeMethod != 0 {
+		n += 2 + sovPullRequest(uint64(m.MergeMethod))
+	}
+	if len(m.ChangedPaths) > 0 {
+		for _, s := range m.ChangedPaths {
+			l = len(s)
+			n += 2 + l + sovPullRequest(uint64(l))
+		}
+	}
+	if len(m.Reactions) > 0 {
+		for _, e := range m.Reactions {
+			l = e.Size()
+			n += 2 + l + sovPullRequest(uint64(l))
+		}
+	}
+	if len(m.ReactionCounts) > 0 {
+		for _, e := range m.ReactionCounts {
+			l = e.Size()
+			n += 2 + l + sovPullRequest(uint64(l))
+		}
+	}
+	if m.Milestone != 0 {
+		n += 2 + sovPullRequest(uint64(m.Milestone))
+	}
+	if len(m.CrossReferences) > 0 {
+		for _, e := range m.CrossReferences {
+			l = e.Size()
+			n += 2 + l + sovPullRequest(uint64(l))
+		}
+	}
+	if len(m.Bounties) > 0 {
+		l = 0
+		for _, e := range m.Bounties {
+			l += sovPullRequest(uint64(e))
+		}
+		n += 2 + sovPullRequest(uint64(l)) + l
+	}
+	l = len(m.ChangedPathsSha)
+	if l > 0 {
+		n += 2 + l + sovPullRequest(uint64(l))
+	}
+	return n
+}
+
+func (m *PullRequestAutoMerge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RepositoryId != 0 {
+		n += 1 + sovPullRequest(uint64(m.RepositoryId))
+	}
+	if m.PullRequestIid != 0 {
+		n += 1 + sovPullRequest(uint64(m.PullRequestIid))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPullRequest(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovPullRequest(uint64(l))
+	}
+	if m.MergeMethod != 0 {
+		n += 1 + sovPullRequest(uint64(m.MergeMethod))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovPullRequest(uint64(m.CreatedAt))
+	}
 	return n
 }
 
@@ -901,6 +1517,45 @@ func (m *PullRequestBase) Size() (n int) {
 	return n
 }
 
+func (m *PullRequestReview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPullRequest(uint64(m.Id))
+	}
+	if m.RepositoryId != 0 {
+		n += 1 + sovPullRequest(uint64(m.RepositoryId))
+	}
+	if m.PullRequestIid != 0 {
+		n += 1 + sovPullRequest(uint64(m.PullRequestIid))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPullRequest(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPullRequest(uint64(m.State))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovPullRequest(uint64(l))
+	}
+	l = len(m.CommitSha)
+	if l > 0 {
+		n += 1 + l + sovPullRequest(uint64(l))
+	}
+	if m.Stale {
+		n += 2
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovPullRequest(uint64(m.CreatedAt))
+	}
+	return n
+}
+
 func sovPullRequest(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1586,61 +2241,11 @@ func (m *PullRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPullRequest(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPullRequest
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PullRequestHead) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPullRequest
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestHead: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestHead: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeMethod", wireType)
 			}
-			m.RepositoryId = 0
+			m.MergeMethod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPullRequest
@@ -1650,12 +2255,532 @@ func (m *PullRequestHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RepositoryId |= uint64(b&0x7F) << shift
+				m.MergeMethod |= MergeMethod(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedPaths = append(m.ChangedPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reactions = append(m.Reactions, &Reaction{})
+			if err := m.Reactions[len(m.Reactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReactionCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReactionCounts = append(m.ReactionCounts, &ReactionCount{})
+			if err := m.ReactionCounts[len(m.ReactionCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestone", wireType)
+			}
+			m.Milestone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Milestone |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossReferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossReferences = append(m.CrossReferences, &IssueIid{})
+			if err := m.CrossReferences[len(m.CrossReferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPullRequest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Bounties = append(m.Bounties, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPullRequest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPullRequest
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPullRequest
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Bounties) == 0 {
+					m.Bounties = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPullRequest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Bounties = append(m.Bounties, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounties", wireType)
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedPathsSha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedPathsSha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPullRequest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestAutoMerge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPullRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestAutoMerge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestAutoMerge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestIid", wireType)
+			}
+			m.PullRequestIid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PullRequestIid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeMethod", wireType)
+			}
+			m.MergeMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MergeMethod |= MergeMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPullRequest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPullRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
@@ -1873,6 +2998,267 @@ func (m *PullRequestBase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PullRequestReview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPullRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestReview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestReview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestIid", wireType)
+			}
+			m.PullRequestIid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PullRequestIid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= PullRequestReview_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitSha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPullRequest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPullRequest(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,16 +28,34 @@ type Emoji int32
 const (
 	EmojiThumbsUp   Emoji = 0
 	EmojiThumbsDown Emoji = 1
+	EmojiLaugh      Emoji = 2
+	EmojiHooray     Emoji = 3
+	EmojiConfused   Emoji = 4
+	EmojiHeart      Emoji = 5
+	EmojiRocket     Emoji = 6
+	EmojiEyes       Emoji = 7
 )
 
 var Emoji_name = map[int32]string{
 	0: "EMOJI_THUMBS_UP",
 	1: "EMOJI_THUMBS_DOWN",
+	2: "EMOJI_LAUGH",
+	3: "EMOJI_HOORAY",
+	4: "EMOJI_CONFUSED",
+	5: "EMOJI_HEART",
+	6: "EMOJI_ROCKET",
+	7: "EMOJI_EYES",
 }
 
 var Emoji_value = map[string]int32{
 	"EMOJI_THUMBS_UP":   0,
 	"EMOJI_THUMBS_DOWN": 1,
+	"EMOJI_LAUGH":       2,
+	"EMOJI_HOORAY":      3,
+	"EMOJI_CONFUSED":    4,
+	"EMOJI_HEART":       5,
+	"EMOJI_ROCKET":      6,
+	"EMOJI_EYES":        7,
 }
 
 func (x Emoji) String() string {
@@ -100,31 +118,95 @@ func (m *Reaction) GetEmojis() []Emoji {
 	return nil
 }
 
+type ReactionCount struct {
+	Emoji Emoji  `protobuf:"varint,1,opt,name=emoji,proto3,enum=gitopia.gitopia.gitopia.Emoji" json:"emoji,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ReactionCount) Reset()         { *m = ReactionCount{} }
+func (m *ReactionCount) String() string { return proto.CompactTextString(m) }
+func (*ReactionCount) ProtoMessage()    {}
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6751c3791b2fecc0, []int{1}
+}
+func (m *ReactionCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReactionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReactionCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReactionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactionCount.Merge(m, src)
+}
+func (m *ReactionCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReactionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactionCount proto.InternalMessageInfo
+
+func (m *ReactionCount) GetEmoji() Emoji {
+	if m != nil {
+		return m.Emoji
+	}
+	return EmojiThumbsUp
+}
+
+func (m *ReactionCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("gitopia.gitopia.gitopia.Emoji", Emoji_name, Emoji_value)
 	proto.RegisterType((*Reaction)(nil), "gitopia.gitopia.gitopia.Reaction")
+	proto.RegisterType((*ReactionCount)(nil), "gitopia.gitopia.gitopia.ReactionCount")
 }
 
 func init() { proto.RegisterFile("gitopia/reaction.proto", fileDescriptor_6751c3791b2fecc0) }
 
 var fileDescriptor_6751c3791b2fecc0 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xcf, 0x2c, 0xc9,
-	0x2f, 0xc8, 0x4c, 0xd4, 0x2f, 0x4a, 0x4d, 0x4c, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0x87, 0x8a, 0xeb, 0xa1, 0xd1, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60,
-	0x35, 0xfa, 0x20, 0x16, 0x44, 0xb9, 0x52, 0x0c, 0x17, 0x47, 0x10, 0xd4, 0x00, 0x21, 0x09, 0x2e,
-	0xf6, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x18,
-	0x57, 0xc8, 0x8c, 0x8b, 0x2d, 0x35, 0x37, 0x3f, 0x2b, 0xb3, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83,
-	0xcf, 0x48, 0x4e, 0x0f, 0x87, 0x2d, 0x7a, 0xae, 0x20, 0x65, 0x41, 0x50, 0xd5, 0x5a, 0x89, 0x5c,
-	0xac, 0x60, 0x01, 0x21, 0x35, 0x2e, 0x7e, 0x57, 0x5f, 0x7f, 0x2f, 0xcf, 0xf8, 0x10, 0x8f, 0x50,
-	0x5f, 0xa7, 0xe0, 0xf8, 0xd0, 0x00, 0x01, 0x06, 0x29, 0xc1, 0xae, 0xb9, 0x0a, 0xbc, 0x60, 0xf9,
-	0x90, 0x8c, 0xd2, 0xdc, 0xa4, 0xe2, 0xd0, 0x02, 0x21, 0x2d, 0x2e, 0x41, 0x14, 0x75, 0x2e, 0xfe,
-	0xe1, 0x7e, 0x02, 0x8c, 0x52, 0xc2, 0x5d, 0x73, 0x15, 0xf8, 0x91, 0x54, 0xba, 0xe4, 0x97, 0xe7,
-	0x49, 0xb1, 0x74, 0x2c, 0x96, 0x63, 0x70, 0x72, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
-	0x39, 0x86, 0x28, 0xad, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x58,
-	0x60, 0xc1, 0xe8, 0x0a, 0x38, 0xab, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x1a, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0x5c, 0xd6, 0x80, 0x56, 0x01, 0x00, 0x00,
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0xd2, 0xc1, 0x8e, 0xd2, 0x40,
+	0x18, 0x07, 0xf0, 0x96, 0x05, 0xd6, 0xfd, 0x56, 0x60, 0x76, 0xdc, 0x28, 0x69, 0xe2, 0x38, 0x6e,
+	0xa2, 0x21, 0x1c, 0x4a, 0xa2, 0xc6, 0x3b, 0x0b, 0xa3, 0x55, 0x77, 0xb7, 0x66, 0xa0, 0x31, 0xab,
+	0x26, 0x9b, 0x02, 0x63, 0xa9, 0x06, 0x86, 0xb4, 0xd3, 0x28, 0x6f, 0x60, 0x7a, 0xf2, 0x05, 0x7a,
+	0xf2, 0x01, 0x7c, 0x0d, 0x8f, 0x7b, 0xf4, 0x68, 0xe0, 0x45, 0x0c, 0x53, 0x70, 0xd1, 0xc4, 0x78,
+	0x9a, 0xf9, 0xfa, 0xfd, 0xfa, 0xfd, 0xe7, 0xf0, 0xc1, 0xcd, 0x20, 0x54, 0x72, 0x16, 0xfa, 0xad,
+	0x48, 0xf8, 0x43, 0x15, 0xca, 0xa9, 0x3d, 0x8b, 0xa4, 0x92, 0xf8, 0xd6, 0xfa, 0xbb, 0xfd, 0xd7,
+	0x69, 0x1d, 0x06, 0x32, 0x90, 0xda, 0xb4, 0x56, 0xb7, 0x9c, 0x1f, 0xbd, 0x85, 0x6b, 0x7c, 0x3d,
+	0x00, 0xd7, 0x61, 0xd7, 0x1f, 0x8d, 0x22, 0x11, 0xc7, 0x75, 0x93, 0x9a, 0x8d, 0x3d, 0xbe, 0x29,
+	0xf1, 0x63, 0x28, 0x8b, 0x89, 0x7c, 0x1f, 0xc6, 0xf5, 0x02, 0xdd, 0x69, 0x54, 0x1f, 0x10, 0xfb,
+	0x1f, 0x29, 0x36, 0x5b, 0x31, 0xbe, 0xd6, 0x47, 0x6f, 0xa0, 0xb2, 0x99, 0xde, 0x91, 0xc9, 0x54,
+	0xe1, 0x47, 0x50, 0xd2, 0x2d, 0x1d, 0xf0, 0xff, 0x39, 0x39, 0xc6, 0x87, 0x50, 0x1a, 0xae, 0x7e,
+	0xaf, 0x17, 0xa8, 0xd9, 0x28, 0xf2, 0xbc, 0x68, 0x7e, 0x2b, 0x40, 0x49, 0x33, 0x7c, 0x1f, 0x6a,
+	0xec, 0xd4, 0x7d, 0xfe, 0xec, 0xa2, 0xef, 0x78, 0xa7, 0xc7, 0xbd, 0x0b, 0xef, 0x25, 0x32, 0xac,
+	0x83, 0x34, 0xa3, 0x15, 0xdd, 0xef, 0x8f, 0x93, 0xc9, 0x20, 0xf6, 0x66, 0xb8, 0x09, 0x07, 0x7f,
+	0xb8, 0xae, 0xfb, 0xea, 0x0c, 0x99, 0xd6, 0x8d, 0x34, 0xa3, 0xb5, 0x2d, 0xd9, 0x95, 0x1f, 0xa7,
+	0xf8, 0x0e, 0xec, 0xe7, 0xf6, 0xa4, 0xed, 0x3d, 0x75, 0x50, 0xc1, 0xaa, 0xa6, 0x19, 0x05, 0xad,
+	0x4e, 0xfc, 0x24, 0x18, 0xe3, 0xbb, 0x70, 0x3d, 0x07, 0x8e, 0xeb, 0xf2, 0xf6, 0x39, 0xda, 0xb1,
+	0x6a, 0x69, 0x46, 0xf7, 0xb5, 0x70, 0xa4, 0x8c, 0xfc, 0x39, 0xbe, 0x07, 0xd5, 0x9c, 0x74, 0xdc,
+	0xb3, 0x27, 0x5e, 0x8f, 0x75, 0x51, 0x71, 0xeb, 0x59, 0x1d, 0x39, 0x7d, 0x97, 0xc4, 0x62, 0x74,
+	0x15, 0xe5, 0xb0, 0x36, 0xef, 0xa3, 0xd2, 0x56, 0x94, 0x23, 0xfc, 0x48, 0x5d, 0x45, 0x71, 0xb7,
+	0xf3, 0x82, 0xf5, 0x51, 0x79, 0x2b, 0x8a, 0xcb, 0xe1, 0x07, 0xa1, 0xf0, 0x6d, 0x80, 0x9c, 0xb0,
+	0x73, 0xd6, 0x43, 0xbb, 0x56, 0x25, 0xcd, 0xe8, 0x9e, 0x06, 0x6c, 0x2e, 0x62, 0xab, 0xf8, 0xf9,
+	0x2b, 0x31, 0x8e, 0xbb, 0xdf, 0x17, 0xc4, 0xbc, 0x5c, 0x10, 0xf3, 0xe7, 0x82, 0x98, 0x5f, 0x96,
+	0xc4, 0xb8, 0x5c, 0x12, 0xe3, 0xc7, 0x92, 0x18, 0xaf, 0x9b, 0x41, 0xa8, 0xc6, 0xc9, 0xc0, 0x1e,
+	0xca, 0x49, 0x6b, 0xb3, 0x58, 0x9b, 0xf3, 0xd3, 0xef, 0x9b, 0x9a, 0xcf, 0x44, 0x3c, 0x28, 0xeb,
+	0xcd, 0x79, 0xf8, 0x6b, 0x00, 0x8d, 0x3c, 0xb3, 0xbc, 0x82, 0x02, 0x00, 0x00,
 }
 
 func (m *Reaction) Marshal() (dAtA []byte, err error) {
@@ -175,6 +257,39 @@ func (m *Reaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReactionCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReactionCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReactionCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Emoji != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.Emoji))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintReaction(dAtA []byte, offset int, v uint64) int {
 	offset -= sovReaction(v)
 	base := offset
@@ -206,6 +321,21 @@ func (m *Reaction) Size() (n int) {
 	return n
 }
 
+func (m *ReactionCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Emoji != 0 {
+		n += 1 + sovReaction(uint64(m.Emoji))
+	}
+	if m.Count != 0 {
+		n += 1 + sovReaction(uint64(m.Count))
+	}
+	return n
+}
+
 func sovReaction(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -363,6 +493,94 @@ func (m *Reaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReactionCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReactionCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReactionCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emoji", wireType)
+			}
+			m.Emoji = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Emoji |= Emoji(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipReaction(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
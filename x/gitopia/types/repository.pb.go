@@ -23,6 +23,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type MergeMethod int32
+
+const (
+	MergeMethod_MERGE             MergeMethod = 0
+	MergeMethod_SQUASH            MergeMethod = 1
+	MergeMethod_REBASE            MergeMethod = 2
+	MergeMethod_FAST_FORWARD_ONLY MergeMethod = 3
+)
+
+var MergeMethod_name = map[int32]string{
+	0: "MERGE",
+	1: "SQUASH",
+	2: "REBASE",
+	3: "FAST_FORWARD_ONLY",
+}
+
+var MergeMethod_value = map[string]int32{
+	"MERGE":             0,
+	"SQUASH":            1,
+	"REBASE":            2,
+	"FAST_FORWARD_ONLY": 3,
+}
+
+func (x MergeMethod) String() string {
+	return proto.EnumName(MergeMethod_name, int32(x))
+}
+
+func (MergeMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_771033d6361900fa, []int{0}
+}
+
 type RepositoryCollaborator_Permission int32
 
 const (
@@ -57,6 +88,31 @@ func (RepositoryCollaborator_Permission) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_771033d6361900fa, []int{6, 0}
 }
 
+type RepositoryMilestone_State int32
+
+const (
+	RepositoryMilestone_OPEN   RepositoryMilestone_State = 0
+	RepositoryMilestone_CLOSED RepositoryMilestone_State = 1
+)
+
+var RepositoryMilestone_State_name = map[int32]string{
+	0: "OPEN",
+	1: "CLOSED",
+}
+
+var RepositoryMilestone_State_value = map[string]int32{
+	"OPEN":   0,
+	"CLOSED": 1,
+}
+
+func (x RepositoryMilestone_State) String() string {
+	return proto.EnumName(RepositoryMilestone_State_name, int32(x))
+}
+
+func (RepositoryMilestone_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_771033d6361900fa, []int{8, 0}
+}
+
 type RepositoryBackup_Store int32
 
 const (
@@ -79,36 +135,46 @@ func (x RepositoryBackup_Store) String() string {
 }
 
 func (RepositoryBackup_Store) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_771033d6361900fa, []int{9, 0}
+	return fileDescriptor_771033d6361900fa, []int{12, 0}
 }
 
 type Repository struct {
-	Creator             string                    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id                  uint64                    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name                string                    `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Owner               *RepositoryOwner          `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	Description         string                    `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Forks               []uint64                  `protobuf:"varint,6,rep,packed,name=forks,proto3" json:"forks,omitempty"`
-	Subscribers         string                    `protobuf:"bytes,7,opt,name=subscribers,proto3" json:"subscribers,omitempty"`
-	Commits             string                    `protobuf:"bytes,8,opt,name=commits,proto3" json:"commits,omitempty"`
-	IssuesCount         uint64                    `protobuf:"varint,9,opt,name=issuesCount,proto3" json:"issuesCount,omitempty"`
-	PullsCount          uint64                    `protobuf:"varint,10,opt,name=pullsCount,proto3" json:"pullsCount,omitempty"`
-	Labels              []*RepositoryLabel        `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty"`
-	LabelsCount         uint64                    `protobuf:"varint,12,opt,name=labelsCount,proto3" json:"labelsCount,omitempty"`
-	Releases            []*RepositoryRelease      `protobuf:"bytes,13,rep,name=releases,proto3" json:"releases,omitempty"`
-	CreatedAt           int64                     `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt           int64                     `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	PushedAt            int64                     `protobuf:"varint,16,opt,name=pushedAt,proto3" json:"pushedAt,omitempty"`
-	Stargazers          []uint64                  `protobuf:"varint,17,rep,packed,name=stargazers,proto3" json:"stargazers,omitempty"`
-	Archived            bool                      `protobuf:"varint,18,opt,name=archived,proto3" json:"archived,omitempty"`
-	License             string                    `protobuf:"bytes,19,opt,name=license,proto3" json:"license,omitempty"`
-	DefaultBranch       string                    `protobuf:"bytes,20,opt,name=defaultBranch,proto3" json:"defaultBranch,omitempty"`
-	Parent              uint64                    `protobuf:"varint,21,opt,name=parent,proto3" json:"parent,omitempty"`
-	Fork                bool                      `protobuf:"varint,22,opt,name=fork,proto3" json:"fork,omitempty"`
-	Collaborators       []*RepositoryCollaborator `protobuf:"bytes,23,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
-	AllowForking        bool                      `protobuf:"varint,24,opt,name=allowForking,proto3" json:"allowForking,omitempty"`
-	Backups             []*RepositoryBackup       `protobuf:"bytes,25,rep,name=backups,proto3" json:"backups,omitempty"`
-	EnableArweaveBackup bool                      `protobuf:"varint,26,opt,name=enableArweaveBackup,proto3" json:"enableArweaveBackup,omitempty"`
+	Creator                    string                     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id                         uint64                     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name                       string                     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Owner                      *RepositoryOwner           `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Description                string                     `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Forks                      []uint64                   `protobuf:"varint,6,rep,packed,name=forks,proto3" json:"forks,omitempty"`
+	Subscribers                string                     `protobuf:"bytes,7,opt,name=subscribers,proto3" json:"subscribers,omitempty"`
+	Commits                    string                     `protobuf:"bytes,8,opt,name=commits,proto3" json:"commits,omitempty"`
+	IssuesCount                uint64                     `protobuf:"varint,9,opt,name=issuesCount,proto3" json:"issuesCount,omitempty"`
+	PullsCount                 uint64                     `protobuf:"varint,10,opt,name=pullsCount,proto3" json:"pullsCount,omitempty"`
+	Labels                     []*RepositoryLabel         `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty"`
+	LabelsCount                uint64                     `protobuf:"varint,12,opt,name=labelsCount,proto3" json:"labelsCount,omitempty"`
+	Releases                   []*RepositoryRelease       `protobuf:"bytes,13,rep,name=releases,proto3" json:"releases,omitempty"`
+	CreatedAt                  int64                      `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt                  int64                      `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	PushedAt                   int64                      `protobuf:"varint,16,opt,name=pushedAt,proto3" json:"pushedAt,omitempty"`
+	Stargazers                 []uint64                   `protobuf:"varint,17,rep,packed,name=stargazers,proto3" json:"stargazers,omitempty"`
+	Archived                   bool                       `protobuf:"varint,18,opt,name=archived,proto3" json:"archived,omitempty"`
+	License                    string                     `protobuf:"bytes,19,opt,name=license,proto3" json:"license,omitempty"`
+	DefaultBranch              string                     `protobuf:"bytes,20,opt,name=defaultBranch,proto3" json:"defaultBranch,omitempty"`
+	Parent                     uint64                     `protobuf:"varint,21,opt,name=parent,proto3" json:"parent,omitempty"`
+	Fork                       bool                       `protobuf:"varint,22,opt,name=fork,proto3" json:"fork,omitempty"`
+	Collaborators              []*RepositoryCollaborator  `protobuf:"bytes,23,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	AllowForking               bool                       `protobuf:"varint,24,opt,name=allowForking,proto3" json:"allowForking,omitempty"`
+	Backups                    []*RepositoryBackup        `protobuf:"bytes,25,rep,name=backups,proto3" json:"backups,omitempty"`
+	EnableArweaveBackup        bool                       `protobuf:"varint,26,opt,name=enableArweaveBackup,proto3" json:"enableArweaveBackup,omitempty"`
+	BranchProtectionRules      []*BranchProtectionRule    `protobuf:"bytes,27,rep,name=branchProtectionRules,proto3" json:"branchProtectionRules,omitempty"`
+	BranchProtectionRulesCount uint64                     `protobuf:"varint,28,opt,name=branchProtectionRulesCount,proto3" json:"branchProtectionRulesCount,omitempty"`
+	AllowedMergeMethods        []MergeMethod              `protobuf:"varint,29,rep,packed,name=allowedMergeMethods,proto3,enum=gitopia.gitopia.gitopia.MergeMethod" json:"allowedMergeMethods,omitempty"`
+	CodeOwners                 []*CodeOwnerRule           `protobuf:"bytes,30,rep,name=codeOwners,proto3" json:"codeOwners,omitempty"`
+	StarsCount                 uint64                     `protobuf:"varint,31,opt,name=starsCount,proto3" json:"starsCount,omitempty"`
+	WatchersCount              uint64                     `protobuf:"varint,32,opt,name=watchersCount,proto3" json:"watchersCount,omitempty"`
+	Milestones                 []*RepositoryMilestone     `protobuf:"bytes,33,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	MilestonesCount            uint64                     `protobuf:"varint,34,opt,name=milestonesCount,proto3" json:"milestonesCount,omitempty"`
+	IssueTemplates             []*RepositoryIssueTemplate `protobuf:"bytes,35,rep,name=issueTemplates,proto3" json:"issueTemplates,omitempty"`
+	IssueTemplatesCount        uint64                     `protobuf:"varint,36,opt,name=issueTemplatesCount,proto3" json:"issueTemplatesCount,omitempty"`
 }
 
 func (m *Repository) Reset()         { *m = Repository{} }
@@ -326,6 +392,76 @@ func (m *Repository) GetEnableArweaveBackup() bool {
 	return false
 }
 
+func (m *Repository) GetBranchProtectionRules() []*BranchProtectionRule {
+	if m != nil {
+		return m.BranchProtectionRules
+	}
+	return nil
+}
+
+func (m *Repository) GetBranchProtectionRulesCount() uint64 {
+	if m != nil {
+		return m.BranchProtectionRulesCount
+	}
+	return 0
+}
+
+func (m *Repository) GetAllowedMergeMethods() []MergeMethod {
+	if m != nil {
+		return m.AllowedMergeMethods
+	}
+	return nil
+}
+
+func (m *Repository) GetCodeOwners() []*CodeOwnerRule {
+	if m != nil {
+		return m.CodeOwners
+	}
+	return nil
+}
+
+func (m *Repository) GetStarsCount() uint64 {
+	if m != nil {
+		return m.StarsCount
+	}
+	return 0
+}
+
+func (m *Repository) GetWatchersCount() uint64 {
+	if m != nil {
+		return m.WatchersCount
+	}
+	return 0
+}
+
+func (m *Repository) GetMilestones() []*RepositoryMilestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *Repository) GetMilestonesCount() uint64 {
+	if m != nil {
+		return m.MilestonesCount
+	}
+	return 0
+}
+
+func (m *Repository) GetIssueTemplates() []*RepositoryIssueTemplate {
+	if m != nil {
+		return m.IssueTemplates
+	}
+	return nil
+}
+
+func (m *Repository) GetIssueTemplatesCount() uint64 {
+	if m != nil {
+		return m.IssueTemplatesCount
+	}
+	return 0
+}
+
 type RepositoryId struct {
 	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -714,6 +850,282 @@ func (m *RepositoryLabel) GetDescription() string {
 	return ""
 }
 
+type RepositoryMilestone struct {
+	Id          uint64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                    `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                    `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DueDate     int64                     `protobuf:"varint,4,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
+	State       RepositoryMilestone_State `protobuf:"varint,5,opt,name=state,proto3,enum=gitopia.gitopia.gitopia.RepositoryMilestone_State" json:"state,omitempty"`
+	CreatedAt   int64                     `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt   int64                     `protobuf:"varint,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	ClosedAt    int64                     `protobuf:"varint,8,opt,name=closedAt,proto3" json:"closedAt,omitempty"`
+}
+
+func (m *RepositoryMilestone) Reset()         { *m = RepositoryMilestone{} }
+func (m *RepositoryMilestone) String() string { return proto.CompactTextString(m) }
+func (*RepositoryMilestone) ProtoMessage()    {}
+func (*RepositoryMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_771033d6361900fa, []int{8}
+}
+func (m *RepositoryMilestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepositoryMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepositoryMilestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepositoryMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepositoryMilestone.Merge(m, src)
+}
+func (m *RepositoryMilestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepositoryMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepositoryMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepositoryMilestone proto.InternalMessageInfo
+
+func (m *RepositoryMilestone) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RepositoryMilestone) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RepositoryMilestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RepositoryMilestone) GetDueDate() int64 {
+	if m != nil {
+		return m.DueDate
+	}
+	return 0
+}
+
+func (m *RepositoryMilestone) GetState() RepositoryMilestone_State {
+	if m != nil {
+		return m.State
+	}
+	return RepositoryMilestone_OPEN
+}
+
+func (m *RepositoryMilestone) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *RepositoryMilestone) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *RepositoryMilestone) GetClosedAt() int64 {
+	if m != nil {
+		return m.ClosedAt
+	}
+	return 0
+}
+
+type BranchProtectionRule struct {
+	Id                            uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pattern                       string   `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	RequiredApprovals             uint64   `protobuf:"varint,3,opt,name=requiredApprovals,proto3" json:"requiredApprovals,omitempty"`
+	RequiredStatusChecks          []string `protobuf:"bytes,4,rep,name=requiredStatusChecks,proto3" json:"requiredStatusChecks,omitempty"`
+	AllowedPushers                []string `protobuf:"bytes,5,rep,name=allowedPushers,proto3" json:"allowedPushers,omitempty"`
+	RequireLinearHistory          bool     `protobuf:"varint,6,opt,name=requireLinearHistory,proto3" json:"requireLinearHistory,omitempty"`
+	PreventDeletion               bool     `protobuf:"varint,7,opt,name=preventDeletion,proto3" json:"preventDeletion,omitempty"`
+	CreatedAt                     int64    `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt                     int64    `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	RequireCodeOwnerReview        bool     `protobuf:"varint,10,opt,name=requireCodeOwnerReview,proto3" json:"requireCodeOwnerReview,omitempty"`
+	RequireConversationResolution bool     `protobuf:"varint,11,opt,name=requireConversationResolution,proto3" json:"requireConversationResolution,omitempty"`
+}
+
+func (m *BranchProtectionRule) Reset()         { *m = BranchProtectionRule{} }
+func (m *BranchProtectionRule) String() string { return proto.CompactTextString(m) }
+func (*BranchProtectionRule) ProtoMessage()    {}
+func (*BranchProtectionRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_771033d6361900fa, []int{9}
+}
+func (m *BranchProtectionRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BranchProtectionRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BranchProtectionRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BranchProtectionRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BranchProtectionRule.Merge(m, src)
+}
+func (m *BranchProtectionRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *BranchProtectionRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_BranchProtectionRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BranchProtectionRule proto.InternalMessageInfo
+
+func (m *BranchProtectionRule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BranchProtectionRule) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *BranchProtectionRule) GetRequiredApprovals() uint64 {
+	if m != nil {
+		return m.RequiredApprovals
+	}
+	return 0
+}
+
+func (m *BranchProtectionRule) GetRequiredStatusChecks() []string {
+	if m != nil {
+		return m.RequiredStatusChecks
+	}
+	return nil
+}
+
+func (m *BranchProtectionRule) GetAllowedPushers() []string {
+	if m != nil {
+		return m.AllowedPushers
+	}
+	return nil
+}
+
+func (m *BranchProtectionRule) GetRequireLinearHistory() bool {
+	if m != nil {
+		return m.RequireLinearHistory
+	}
+	return false
+}
+
+func (m *BranchProtectionRule) GetPreventDeletion() bool {
+	if m != nil {
+		return m.PreventDeletion
+	}
+	return false
+}
+
+func (m *BranchProtectionRule) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *BranchProtectionRule) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *BranchProtectionRule) GetRequireCodeOwnerReview() bool {
+	if m != nil {
+		return m.RequireCodeOwnerReview
+	}
+	return false
+}
+
+func (m *BranchProtectionRule) GetRequireConversationResolution() bool {
+	if m != nil {
+		return m.RequireConversationResolution
+	}
+	return false
+}
+
+type CodeOwnerRule struct {
+	Pattern string   `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Owners  []string `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners,omitempty"`
+}
+
+func (m *CodeOwnerRule) Reset()         { *m = CodeOwnerRule{} }
+func (m *CodeOwnerRule) String() string { return proto.CompactTextString(m) }
+func (*CodeOwnerRule) ProtoMessage()    {}
+func (*CodeOwnerRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_771033d6361900fa, []int{10}
+}
+func (m *CodeOwnerRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeOwnerRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeOwnerRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeOwnerRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeOwnerRule.Merge(m, src)
+}
+func (m *CodeOwnerRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeOwnerRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeOwnerRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeOwnerRule proto.InternalMessageInfo
+
+func (m *CodeOwnerRule) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *CodeOwnerRule) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
 type RepositoryRelease struct {
 	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	TagName string `protobuf:"bytes,2,opt,name=tagName,proto3" json:"tagName,omitempty"`
@@ -723,7 +1135,7 @@ func (m *RepositoryRelease) Reset()         { *m = RepositoryRelease{} }
 func (m *RepositoryRelease) String() string { return proto.CompactTextString(m) }
 func (*RepositoryRelease) ProtoMessage()    {}
 func (*RepositoryRelease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_771033d6361900fa, []int{8}
+	return fileDescriptor_771033d6361900fa, []int{11}
 }
 func (m *RepositoryRelease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +1187,7 @@ func (m *RepositoryBackup) Reset()         { *m = RepositoryBackup{} }
 func (m *RepositoryBackup) String() string { return proto.CompactTextString(m) }
 func (*RepositoryBackup) ProtoMessage()    {}
 func (*RepositoryBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_771033d6361900fa, []int{9}
+	return fileDescriptor_771033d6361900fa, []int{12}
 }
 func (m *RepositoryBackup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -818,80 +1230,279 @@ func (m *RepositoryBackup) GetRefs() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("gitopia.gitopia.gitopia.RepositoryCollaborator_Permission", RepositoryCollaborator_Permission_name, RepositoryCollaborator_Permission_value)
-	proto.RegisterEnum("gitopia.gitopia.gitopia.RepositoryBackup_Store", RepositoryBackup_Store_name, RepositoryBackup_Store_value)
-	proto.RegisterType((*Repository)(nil), "gitopia.gitopia.gitopia.Repository")
-	proto.RegisterType((*RepositoryId)(nil), "gitopia.gitopia.gitopia.RepositoryId")
+type RepositoryIssueTemplate struct {
+	Id                  uint64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Body                string                     `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Labels              []uint64                   `protobuf:"varint,4,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	Assignees           []string                   `protobuf:"bytes,5,rep,name=assignees,proto3" json:"assignees,omitempty"`
+	RequiredLabelGroups []*IssueTemplateLabelGroup `protobuf:"bytes,6,rep,name=requiredLabelGroups,proto3" json:"requiredLabelGroups,omitempty"`
+	CreatedAt           int64                      `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt           int64                      `protobuf:"varint,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (m *RepositoryIssueTemplate) Reset()         { *m = RepositoryIssueTemplate{} }
+func (m *RepositoryIssueTemplate) String() string { return proto.CompactTextString(m) }
+func (*RepositoryIssueTemplate) ProtoMessage()    {}
+func (*RepositoryIssueTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_771033d6361900fa, []int{13}
+}
+func (m *RepositoryIssueTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepositoryIssueTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepositoryIssueTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepositoryIssueTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepositoryIssueTemplate.Merge(m, src)
+}
+func (m *RepositoryIssueTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepositoryIssueTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepositoryIssueTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepositoryIssueTemplate proto.InternalMessageInfo
+
+func (m *RepositoryIssueTemplate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RepositoryIssueTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RepositoryIssueTemplate) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *RepositoryIssueTemplate) GetLabels() []uint64 {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *RepositoryIssueTemplate) GetAssignees() []string {
+	if m != nil {
+		return m.Assignees
+	}
+	return nil
+}
+
+func (m *RepositoryIssueTemplate) GetRequiredLabelGroups() []*IssueTemplateLabelGroup {
+	if m != nil {
+		return m.RequiredLabelGroups
+	}
+	return nil
+}
+
+func (m *RepositoryIssueTemplate) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *RepositoryIssueTemplate) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+// IssueTemplateLabelGroup is satisfied by an issue having at least one of its labels
+type IssueTemplateLabelGroup struct {
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Labels []uint64 `protobuf:"varint,2,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (m *IssueTemplateLabelGroup) Reset()         { *m = IssueTemplateLabelGroup{} }
+func (m *IssueTemplateLabelGroup) String() string { return proto.CompactTextString(m) }
+func (*IssueTemplateLabelGroup) ProtoMessage()    {}
+func (*IssueTemplateLabelGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_771033d6361900fa, []int{14}
+}
+func (m *IssueTemplateLabelGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssueTemplateLabelGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssueTemplateLabelGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IssueTemplateLabelGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueTemplateLabelGroup.Merge(m, src)
+}
+func (m *IssueTemplateLabelGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssueTemplateLabelGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueTemplateLabelGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueTemplateLabelGroup proto.InternalMessageInfo
+
+func (m *IssueTemplateLabelGroup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IssueTemplateLabelGroup) GetLabels() []uint64 {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("gitopia.gitopia.gitopia.MergeMethod", MergeMethod_name, MergeMethod_value)
+	proto.RegisterEnum("gitopia.gitopia.gitopia.RepositoryCollaborator_Permission", RepositoryCollaborator_Permission_name, RepositoryCollaborator_Permission_value)
+	proto.RegisterEnum("gitopia.gitopia.gitopia.RepositoryMilestone_State", RepositoryMilestone_State_name, RepositoryMilestone_State_value)
+	proto.RegisterEnum("gitopia.gitopia.gitopia.RepositoryBackup_Store", RepositoryBackup_Store_name, RepositoryBackup_Store_value)
+	proto.RegisterType((*Repository)(nil), "gitopia.gitopia.gitopia.Repository")
+	proto.RegisterType((*RepositoryId)(nil), "gitopia.gitopia.gitopia.RepositoryId")
 	proto.RegisterType((*BaseRepositoryKey)(nil), "gitopia.gitopia.gitopia.BaseRepositoryKey")
 	proto.RegisterType((*RepositoryOwner)(nil), "gitopia.gitopia.gitopia.RepositoryOwner")
 	proto.RegisterType((*IssueIid)(nil), "gitopia.gitopia.gitopia.IssueIid")
 	proto.RegisterType((*PullRequestIid)(nil), "gitopia.gitopia.gitopia.PullRequestIid")
 	proto.RegisterType((*RepositoryCollaborator)(nil), "gitopia.gitopia.gitopia.RepositoryCollaborator")
 	proto.RegisterType((*RepositoryLabel)(nil), "gitopia.gitopia.gitopia.RepositoryLabel")
+	proto.RegisterType((*RepositoryMilestone)(nil), "gitopia.gitopia.gitopia.RepositoryMilestone")
+	proto.RegisterType((*BranchProtectionRule)(nil), "gitopia.gitopia.gitopia.BranchProtectionRule")
+	proto.RegisterType((*CodeOwnerRule)(nil), "gitopia.gitopia.gitopia.CodeOwnerRule")
 	proto.RegisterType((*RepositoryRelease)(nil), "gitopia.gitopia.gitopia.RepositoryRelease")
 	proto.RegisterType((*RepositoryBackup)(nil), "gitopia.gitopia.gitopia.RepositoryBackup")
+	proto.RegisterType((*RepositoryIssueTemplate)(nil), "gitopia.gitopia.gitopia.RepositoryIssueTemplate")
+	proto.RegisterType((*IssueTemplateLabelGroup)(nil), "gitopia.gitopia.gitopia.IssueTemplateLabelGroup")
 }
 
 func init() { proto.RegisterFile("gitopia/repository.proto", fileDescriptor_771033d6361900fa) }
 
 var fileDescriptor_771033d6361900fa = []byte{
-	// 877 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0xf3, 0xbf, 0xaf, 0x6d, 0xd6, 0x9d, 0x96, 0xee, 0x50, 0xa1, 0x28, 0xb2, 0x38, 0x84,
-	0x15, 0x4a, 0x51, 0x91, 0x38, 0x20, 0x81, 0x70, 0xdb, 0x14, 0x59, 0xb0, 0xa5, 0xcc, 0x16, 0x56,
-	0xec, 0x6d, 0x62, 0x4f, 0x13, 0xab, 0x8e, 0xc7, 0xcc, 0xd8, 0x5b, 0xc2, 0x77, 0x40, 0xe2, 0x63,
-	0x71, 0xe0, 0xb0, 0x47, 0x4e, 0x08, 0xb5, 0x5f, 0x04, 0xcd, 0x8c, 0xe3, 0xb8, 0x49, 0x2a, 0x65,
-	0x4f, 0x7e, 0xff, 0x7e, 0xef, 0xcd, 0xfb, 0x6b, 0xc0, 0xe3, 0x30, 0xe5, 0x49, 0x48, 0x8f, 0x05,
-	0x4b, 0xb8, 0x0c, 0x53, 0x2e, 0x66, 0x83, 0x44, 0xf0, 0x94, 0xa3, 0xe7, 0xb9, 0x66, 0xb0, 0xf4,
-	0x3d, 0x3a, 0x18, 0xf3, 0x31, 0xd7, 0x36, 0xc7, 0x8a, 0x32, 0xe6, 0x47, 0xfb, 0x73, 0x47, 0x77,
-	0x13, 0x1e, 0x4a, 0x23, 0x74, 0xfe, 0x6d, 0x01, 0x90, 0xc2, 0x31, 0xc2, 0xd0, 0xf2, 0x05, 0xa3,
-	0x29, 0x17, 0xd8, 0xea, 0x59, 0xfd, 0x2d, 0x32, 0x67, 0x51, 0x07, 0xaa, 0x61, 0x80, 0xab, 0x3d,
-	0xab, 0x5f, 0x27, 0xd5, 0x30, 0x40, 0x08, 0xea, 0x31, 0x9d, 0x32, 0x5c, 0xd3, 0x66, 0x9a, 0x46,
-	0x5f, 0x43, 0x83, 0xdf, 0xc5, 0x4c, 0xe0, 0x7a, 0xcf, 0xea, 0x6f, 0x9f, 0xf4, 0x07, 0x4f, 0x3c,
-	0x70, 0xb0, 0x88, 0xf8, 0x83, 0xb2, 0x27, 0x06, 0x86, 0x7a, 0xb0, 0x1d, 0x30, 0xe9, 0x8b, 0x30,
-	0x49, 0x43, 0x1e, 0xe3, 0x86, 0x76, 0x5d, 0x16, 0xa1, 0x03, 0x68, 0xdc, 0x70, 0x71, 0x2b, 0x71,
-	0xb3, 0x57, 0xeb, 0xd7, 0x89, 0x61, 0x14, 0x4e, 0x66, 0x23, 0x65, 0x35, 0x62, 0x42, 0xe2, 0x96,
-	0xc1, 0x95, 0x44, 0x3a, 0x2f, 0x3e, 0x9d, 0x86, 0xa9, 0xc4, 0xed, 0x3c, 0x2f, 0xc3, 0x2a, 0x6c,
-	0x28, 0x65, 0xc6, 0xe4, 0x19, 0xcf, 0xe2, 0x14, 0x6f, 0xe9, 0x04, 0xcb, 0x22, 0xd4, 0x05, 0x48,
-	0xb2, 0x28, 0xca, 0x0d, 0x40, 0x1b, 0x94, 0x24, 0xe8, 0x1b, 0x68, 0x46, 0x74, 0xc4, 0x22, 0x89,
-	0xb7, 0x7b, 0xb5, 0x0d, 0xd3, 0xfe, 0x5e, 0x01, 0x48, 0x8e, 0x53, 0x6f, 0x30, 0x94, 0x09, 0xb1,
-	0x63, 0xde, 0x50, 0x12, 0xa1, 0x0b, 0x68, 0x0b, 0x16, 0x31, 0x2a, 0x99, 0xc4, 0xbb, 0x3a, 0xca,
-	0x8b, 0x0d, 0xa2, 0x10, 0x03, 0x21, 0x05, 0x16, 0x7d, 0x04, 0x5b, 0xba, 0xa1, 0x2c, 0x70, 0x53,
-	0xdc, 0xe9, 0x59, 0xfd, 0x1a, 0x59, 0x08, 0x94, 0x36, 0x4b, 0x82, 0x5c, 0xfb, 0xcc, 0x68, 0x0b,
-	0x01, 0x3a, 0x82, 0x76, 0x92, 0xc9, 0x89, 0x56, 0xda, 0x5a, 0x59, 0xf0, 0xaa, 0x46, 0x32, 0xa5,
-	0x62, 0x4c, 0x7f, 0x57, 0x0d, 0xd8, 0xd3, 0xcd, 0x29, 0x49, 0x14, 0x96, 0x0a, 0x7f, 0x12, 0xbe,
-	0x65, 0x01, 0x46, 0x3d, 0xab, 0xdf, 0x26, 0x05, 0xaf, 0x7a, 0x13, 0x85, 0x3e, 0x8b, 0x25, 0xc3,
-	0xfb, 0xa6, 0x37, 0x39, 0x8b, 0x3e, 0x86, 0xdd, 0x80, 0xdd, 0xd0, 0x2c, 0x4a, 0x4f, 0x05, 0x8d,
-	0xfd, 0x09, 0x3e, 0xd0, 0xfa, 0xc7, 0x42, 0x74, 0x08, 0xcd, 0x84, 0x0a, 0x16, 0xa7, 0xf8, 0x03,
-	0x5d, 0xb8, 0x9c, 0x53, 0x13, 0xaa, 0xc6, 0x03, 0x1f, 0xea, 0x78, 0x9a, 0x46, 0x3f, 0xc1, 0xae,
-	0xcf, 0xa3, 0x88, 0x8e, 0xb8, 0x50, 0x53, 0x2d, 0xf1, 0x73, 0x5d, 0xcc, 0xe3, 0x0d, 0x8a, 0x79,
-	0x56, 0xc2, 0x91, 0xc7, 0x5e, 0x90, 0x03, 0x3b, 0x34, 0x8a, 0xf8, 0xdd, 0x05, 0x17, 0xb7, 0x61,
-	0x3c, 0xc6, 0x58, 0x87, 0x7c, 0x24, 0x43, 0x67, 0xd0, 0x1a, 0x51, 0xff, 0x36, 0x4b, 0x24, 0xfe,
-	0x50, 0x07, 0xfd, 0x64, 0x83, 0xa0, 0xa7, 0x1a, 0x41, 0xe6, 0x48, 0xf4, 0x19, 0xec, 0xb3, 0x98,
-	0x8e, 0x22, 0xe6, 0x8a, 0x3b, 0x46, 0xdf, 0x32, 0xa3, 0xc7, 0x47, 0x3a, 0xde, 0x3a, 0x95, 0x73,
-	0x02, 0x3b, 0x0b, 0x77, 0x5e, 0x90, 0xef, 0xb1, 0x59, 0xee, 0xf2, 0x1e, 0x57, 0x17, 0x7b, 0xec,
-	0xfc, 0x08, 0x7b, 0xa7, 0x6a, 0x6e, 0x0a, 0xdc, 0x77, 0x6c, 0x56, 0x02, 0x9a, 0x03, 0x80, 0xa1,
-	0x45, 0x83, 0x40, 0x30, 0x29, 0x73, 0xec, 0x9c, 0x5d, 0x77, 0x1a, 0x9c, 0x5f, 0xe0, 0xd9, 0xd2,
-	0xd2, 0xaf, 0xbc, 0xe4, 0x0b, 0xa8, 0xa7, 0xb3, 0xc4, 0xbc, 0xa4, 0x73, 0xe2, 0x3c, 0x59, 0x1d,
-	0x8d, 0xbe, 0x9e, 0x25, 0x8c, 0x68, 0x7b, 0xe7, 0x53, 0x68, 0x7b, 0x6a, 0x5d, 0xbd, 0x30, 0x40,
-	0x36, 0xd4, 0xc2, 0xe2, 0x95, 0x8a, 0x5c, 0xbe, 0x5b, 0xce, 0x09, 0x74, 0xae, 0xb2, 0x28, 0x22,
-	0xec, 0xd7, 0x8c, 0xc9, 0x74, 0x33, 0xcc, 0xdf, 0x16, 0x1c, 0xae, 0x1f, 0x84, 0x95, 0x24, 0xde,
-	0x00, 0x24, 0x4c, 0x4c, 0x43, 0x29, 0xd5, 0x05, 0x33, 0xa9, 0x7c, 0xf9, 0x9e, 0xd3, 0x35, 0xb8,
-	0x2a, 0x3c, 0x90, 0x92, 0x37, 0xe7, 0x02, 0x60, 0xa1, 0x41, 0x6d, 0xa8, 0x93, 0xa1, 0x7b, 0x6e,
-	0x57, 0x10, 0x40, 0xf3, 0x9a, 0x78, 0xee, 0xb7, 0x43, 0xdb, 0x42, 0x5b, 0xd0, 0x78, 0x4d, 0xbc,
-	0xeb, 0xa1, 0x5d, 0x45, 0x3b, 0xd0, 0x7e, 0xe9, 0x7a, 0x97, 0xd7, 0xae, 0x77, 0x69, 0xd7, 0x94,
-	0xc2, 0x3d, 0x7f, 0xe9, 0x5d, 0xda, 0x75, 0x67, 0x5a, 0xee, 0x85, 0xbe, 0x44, 0x2b, 0xcd, 0x5d,
-	0x33, 0x15, 0xea, 0xf6, 0xfa, 0x3c, 0xe2, 0x22, 0xef, 0xab, 0x61, 0x96, 0x6f, 0x76, 0x7d, 0xe5,
-	0x66, 0x3b, 0x5f, 0xc1, 0xde, 0xca, 0x49, 0x5a, 0x37, 0x4d, 0x29, 0x1d, 0x5f, 0x2e, 0x62, 0xce,
-	0x59, 0xe7, 0x0f, 0x0b, 0xec, 0xe5, 0x85, 0x40, 0x43, 0x68, 0xc8, 0x94, 0x0b, 0xa6, 0x3d, 0x74,
-	0x36, 0xda, 0x5f, 0x83, 0x1c, 0xbc, 0x52, 0x30, 0x62, 0xd0, 0x2a, 0x4d, 0xc1, 0x6e, 0xd4, 0x00,
-	0xd7, 0x54, 0x9a, 0x8a, 0x76, 0xba, 0xd0, 0xd0, 0x36, 0xaa, 0xc0, 0xde, 0xd5, 0xc5, 0x2b, 0xbb,
-	0x82, 0xb6, 0xa1, 0xe5, 0x92, 0xd7, 0x43, 0xf7, 0xe7, 0xa1, 0x6d, 0x9d, 0x9e, 0xff, 0x75, 0xdf,
-	0xb5, 0xde, 0xdd, 0x77, 0xad, 0xff, 0xee, 0xbb, 0xd6, 0x9f, 0x0f, 0xdd, 0xca, 0xbb, 0x87, 0x6e,
-	0xe5, 0x9f, 0x87, 0x6e, 0xe5, 0xcd, 0x8b, 0x71, 0x98, 0x4e, 0xb2, 0xd1, 0xc0, 0xe7, 0xd3, 0xe3,
-	0xf9, 0xbf, 0x76, 0xfe, 0xfd, 0xad, 0xa0, 0xd4, 0xcc, 0xca, 0x51, 0x53, 0xff, 0x7e, 0x3f, 0xff,
-	0x3f, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xe1, 0x9a, 0xa5, 0xde, 0x07, 0x00, 0x00,
+	// 1491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xdd, 0x6e, 0xdb, 0xc6,
+	0x12, 0x36, 0xf5, 0x63, 0xc9, 0x63, 0x5b, 0x96, 0xd7, 0x8e, 0xbd, 0xc7, 0x27, 0xd1, 0xd1, 0xe1,
+	0x09, 0x02, 0x9d, 0x20, 0x95, 0x03, 0x17, 0xc8, 0x45, 0x81, 0x06, 0x95, 0x2d, 0xd9, 0x11, 0xea,
+	0xbf, 0xac, 0x9c, 0xa4, 0xc9, 0x4d, 0x40, 0x89, 0x1b, 0x89, 0x30, 0xa5, 0x65, 0x76, 0x49, 0xbb,
+	0xee, 0x3b, 0x14, 0xe8, 0x5b, 0xf4, 0x15, 0xfa, 0x08, 0xbd, 0xe8, 0x45, 0x2e, 0x7b, 0x59, 0x38,
+	0xd7, 0x7d, 0x87, 0x62, 0x77, 0x49, 0x89, 0x92, 0x28, 0x43, 0xb9, 0xe2, 0xce, 0xcf, 0x37, 0x33,
+	0x3b, 0x33, 0xbb, 0xb3, 0x04, 0xdc, 0x75, 0x7c, 0xe6, 0x39, 0xd6, 0x2e, 0xa7, 0x1e, 0x13, 0x8e,
+	0xcf, 0xf8, 0x4d, 0xd5, 0xe3, 0xcc, 0x67, 0x68, 0x3b, 0x94, 0x54, 0x27, 0xbe, 0x3b, 0x9b, 0x5d,
+	0xd6, 0x65, 0x4a, 0x67, 0x57, 0xae, 0xb4, 0xfa, 0xce, 0x46, 0x64, 0xe8, 0xba, 0xc7, 0x1c, 0xa1,
+	0x99, 0xe6, 0xdf, 0x2b, 0x00, 0x64, 0x68, 0x18, 0x61, 0xc8, 0x75, 0x38, 0xb5, 0x7c, 0xc6, 0xb1,
+	0x51, 0x36, 0x2a, 0x4b, 0x24, 0x22, 0x51, 0x01, 0x52, 0x8e, 0x8d, 0x53, 0x65, 0xa3, 0x92, 0x21,
+	0x29, 0xc7, 0x46, 0x08, 0x32, 0x03, 0xab, 0x4f, 0x71, 0x5a, 0xa9, 0xa9, 0x35, 0x7a, 0x0e, 0x59,
+	0x76, 0x3d, 0xa0, 0x1c, 0x67, 0xca, 0x46, 0x65, 0x79, 0xaf, 0x52, 0x9d, 0x11, 0x60, 0x75, 0xe4,
+	0xf1, 0x4c, 0xea, 0x13, 0x0d, 0x43, 0x65, 0x58, 0xb6, 0xa9, 0xe8, 0x70, 0xc7, 0xf3, 0x1d, 0x36,
+	0xc0, 0x59, 0x65, 0x3a, 0xce, 0x42, 0x9b, 0x90, 0xfd, 0xc0, 0xf8, 0xa5, 0xc0, 0x8b, 0xe5, 0x74,
+	0x25, 0x43, 0x34, 0x21, 0x71, 0x22, 0x68, 0x4b, 0xad, 0x36, 0xe5, 0x02, 0xe7, 0x34, 0x2e, 0xc6,
+	0x52, 0xfb, 0x62, 0xfd, 0xbe, 0xe3, 0x0b, 0x9c, 0x0f, 0xf7, 0xa5, 0x49, 0x89, 0x75, 0x84, 0x08,
+	0xa8, 0x38, 0x60, 0xc1, 0xc0, 0xc7, 0x4b, 0x6a, 0x83, 0x71, 0x16, 0x2a, 0x01, 0x78, 0x81, 0xeb,
+	0x86, 0x0a, 0xa0, 0x14, 0x62, 0x1c, 0xf4, 0x1d, 0x2c, 0xba, 0x56, 0x9b, 0xba, 0x02, 0x2f, 0x97,
+	0xd3, 0x73, 0x6e, 0xfb, 0x58, 0x02, 0x48, 0x88, 0x93, 0x31, 0xe8, 0x95, 0x76, 0xb1, 0xa2, 0x63,
+	0x88, 0xb1, 0xd0, 0x21, 0xe4, 0x39, 0x75, 0xa9, 0x25, 0xa8, 0xc0, 0xab, 0xca, 0xcb, 0xe3, 0x39,
+	0xbc, 0x10, 0x0d, 0x21, 0x43, 0x2c, 0xba, 0x0f, 0x4b, 0xaa, 0xa0, 0xd4, 0xae, 0xf9, 0xb8, 0x50,
+	0x36, 0x2a, 0x69, 0x32, 0x62, 0x48, 0x69, 0xe0, 0xd9, 0xa1, 0x74, 0x4d, 0x4b, 0x87, 0x0c, 0xb4,
+	0x03, 0x79, 0x2f, 0x10, 0x3d, 0x25, 0x2c, 0x2a, 0xe1, 0x90, 0x96, 0x39, 0x12, 0xbe, 0xc5, 0xbb,
+	0xd6, 0x4f, 0xb2, 0x00, 0xeb, 0xaa, 0x38, 0x31, 0x8e, 0xc4, 0x5a, 0xbc, 0xd3, 0x73, 0xae, 0xa8,
+	0x8d, 0x51, 0xd9, 0xa8, 0xe4, 0xc9, 0x90, 0x96, 0xb5, 0x71, 0x9d, 0x0e, 0x1d, 0x08, 0x8a, 0x37,
+	0x74, 0x6d, 0x42, 0x12, 0x3d, 0x84, 0x55, 0x9b, 0x7e, 0xb0, 0x02, 0xd7, 0xdf, 0xe7, 0xd6, 0xa0,
+	0xd3, 0xc3, 0x9b, 0x4a, 0x3e, 0xce, 0x44, 0x5b, 0xb0, 0xe8, 0x59, 0x9c, 0x0e, 0x7c, 0x7c, 0x4f,
+	0x25, 0x2e, 0xa4, 0x64, 0x87, 0xca, 0xf6, 0xc0, 0x5b, 0xca, 0x9f, 0x5a, 0xa3, 0x57, 0xb0, 0xda,
+	0x61, 0xae, 0x6b, 0xb5, 0x19, 0x97, 0x5d, 0x2d, 0xf0, 0xb6, 0x4a, 0xe6, 0xee, 0x1c, 0xc9, 0x3c,
+	0x88, 0xe1, 0xc8, 0xb8, 0x15, 0x64, 0xc2, 0x8a, 0xe5, 0xba, 0xec, 0xfa, 0x90, 0xf1, 0x4b, 0x67,
+	0xd0, 0xc5, 0x58, 0xb9, 0x1c, 0xe3, 0xa1, 0x03, 0xc8, 0xb5, 0xad, 0xce, 0x65, 0xe0, 0x09, 0xfc,
+	0x2f, 0xe5, 0xf4, 0xff, 0x73, 0x38, 0xdd, 0x57, 0x08, 0x12, 0x21, 0xd1, 0x53, 0xd8, 0xa0, 0x03,
+	0xab, 0xed, 0xd2, 0x1a, 0xbf, 0xa6, 0xd6, 0x15, 0xd5, 0x72, 0xbc, 0xa3, 0xfc, 0x25, 0x89, 0x50,
+	0x07, 0xee, 0xb5, 0x55, 0x9e, 0xce, 0x39, 0xf3, 0x69, 0x47, 0x9e, 0x22, 0x12, 0xb8, 0x54, 0xe0,
+	0x7f, 0xab, 0x20, 0xbe, 0x9a, 0x19, 0xc4, 0x7e, 0x02, 0x8a, 0x24, 0xdb, 0x42, 0xcf, 0x61, 0x27,
+	0x51, 0xa0, 0xfb, 0xf9, 0xbe, 0x2a, 0xcb, 0x1d, 0x1a, 0xe8, 0x35, 0x6c, 0xa8, 0x5c, 0x51, 0xfb,
+	0x84, 0xf2, 0x2e, 0x3d, 0xa1, 0x7e, 0x8f, 0xd9, 0x02, 0x3f, 0x28, 0xa7, 0x2b, 0x85, 0xbd, 0x87,
+	0x33, 0x43, 0x8c, 0x29, 0x93, 0x24, 0x03, 0xe8, 0x10, 0xa0, 0xc3, 0x6c, 0xaa, 0x2e, 0x19, 0x81,
+	0x4b, 0x6a, 0xc7, 0x8f, 0x66, 0x9a, 0x3b, 0x88, 0x54, 0xd5, 0x56, 0x63, 0xc8, 0xa8, 0xbd, 0xc3,
+	0xfd, 0xfc, 0x47, 0x5f, 0x01, 0x23, 0x8e, 0x6c, 0xd4, 0x6b, 0xcb, 0xef, 0xf4, 0x68, 0xa4, 0x52,
+	0x56, 0x2a, 0xe3, 0x4c, 0x74, 0x0c, 0xd0, 0x77, 0x5c, 0x2a, 0x7c, 0x36, 0xa0, 0x02, 0xff, 0x57,
+	0x45, 0xf3, 0x64, 0x8e, 0x26, 0x38, 0x89, 0x40, 0x24, 0x86, 0x47, 0x15, 0x58, 0x1b, 0x51, 0xda,
+	0xab, 0xa9, 0xbc, 0x4e, 0xb2, 0xd1, 0x0f, 0x50, 0x50, 0xf7, 0xd9, 0x05, 0xed, 0x7b, 0xae, 0xe5,
+	0x53, 0x81, 0xff, 0xa7, 0x7c, 0x3f, 0x9d, 0xc3, 0x77, 0x33, 0x0e, 0x24, 0x13, 0x76, 0x64, 0x3b,
+	0x8e, 0x73, 0x74, 0x1c, 0x0f, 0x55, 0x1c, 0x49, 0x22, 0x73, 0x0f, 0x56, 0x62, 0xc6, 0xed, 0x70,
+	0xac, 0xe8, 0x59, 0x13, 0x1f, 0x2b, 0xa9, 0xd1, 0x58, 0x31, 0x5f, 0xc2, 0xfa, 0xbe, 0xbc, 0xc6,
+	0x86, 0xb8, 0xef, 0xe9, 0x4d, 0x0c, 0xa8, 0xe7, 0x11, 0x86, 0x9c, 0x65, 0xdb, 0x9c, 0x0a, 0x11,
+	0x62, 0x23, 0x32, 0x69, 0x52, 0x99, 0x6f, 0x61, 0x6d, 0x62, 0x06, 0x4d, 0x45, 0xf2, 0x0c, 0x32,
+	0xfe, 0x8d, 0xa7, 0x23, 0x29, 0xec, 0x99, 0x33, 0x73, 0xa5, 0xd0, 0x17, 0x37, 0x1e, 0x25, 0x4a,
+	0xdf, 0x7c, 0x02, 0x79, 0x95, 0xb4, 0xa6, 0x63, 0xa3, 0x22, 0xa4, 0x9d, 0x61, 0x94, 0x72, 0x39,
+	0x39, 0x46, 0xcd, 0x3d, 0x28, 0x9c, 0x07, 0xae, 0x4b, 0xe8, 0xc7, 0x80, 0x0a, 0x7f, 0x3e, 0xcc,
+	0x1f, 0x06, 0x6c, 0x25, 0xdf, 0x4b, 0x53, 0x9b, 0x78, 0x07, 0xe0, 0x51, 0xde, 0x77, 0x84, 0x90,
+	0x03, 0x55, 0x6f, 0xe5, 0x9b, 0x2f, 0xbc, 0xec, 0xaa, 0xe7, 0x43, 0x0b, 0x24, 0x66, 0xcd, 0x3c,
+	0x04, 0x18, 0x49, 0x50, 0x1e, 0x32, 0xa4, 0x51, 0xab, 0x17, 0x17, 0x10, 0xc0, 0xe2, 0x05, 0x69,
+	0xd6, 0x8e, 0x1a, 0x45, 0x03, 0x2d, 0x41, 0xf6, 0x0d, 0x69, 0x5e, 0x34, 0x8a, 0x29, 0xb4, 0x02,
+	0xf9, 0x93, 0x5a, 0xf3, 0xf4, 0xa2, 0xd6, 0x3c, 0x2d, 0xa6, 0xa5, 0xa0, 0x56, 0x3f, 0x69, 0x9e,
+	0x16, 0x33, 0x66, 0x1f, 0xd6, 0x26, 0x06, 0xe3, 0x54, 0x71, 0x13, 0xba, 0x42, 0x3e, 0x05, 0x3a,
+	0xcc, 0x65, 0x3c, 0xac, 0xab, 0x26, 0x26, 0x9f, 0x10, 0x99, 0xa9, 0x27, 0x84, 0xf9, 0x5b, 0x0a,
+	0x36, 0x12, 0xce, 0xd6, 0x94, 0xcf, 0x4d, 0xc8, 0xfa, 0x8e, 0xef, 0x46, 0x4e, 0x35, 0x31, 0x69,
+	0x3f, 0x3d, 0x65, 0x5f, 0x36, 0xa2, 0x1d, 0xd0, 0xba, 0xe5, 0x53, 0xe5, 0x3d, 0x4d, 0x22, 0x12,
+	0xbd, 0x80, 0xac, 0xf0, 0x25, 0x3f, 0xab, 0xea, 0xb0, 0xf7, 0x25, 0x47, 0xbf, 0xda, 0x92, 0x48,
+	0xa2, 0x0d, 0x8c, 0x8f, 0xf1, 0xc5, 0x3b, 0xc7, 0x78, 0x2e, 0x61, 0x8c, 0x77, 0x5c, 0x26, 0x94,
+	0x30, 0xaf, 0xc7, 0x78, 0x44, 0x9b, 0x0f, 0x20, 0xab, 0xfc, 0xc8, 0x6a, 0x9e, 0x9d, 0x37, 0x4e,
+	0x75, 0x35, 0x0f, 0x8e, 0xcf, 0x5a, 0x8d, 0x7a, 0xd1, 0x30, 0x6f, 0xd3, 0xb0, 0x99, 0x34, 0x16,
+	0x92, 0x0e, 0xa3, 0x67, 0xf9, 0x3e, 0xe5, 0x83, 0xe8, 0x30, 0x86, 0x24, 0x7a, 0x02, 0xeb, 0x9c,
+	0x7e, 0x0c, 0x1c, 0x4e, 0xed, 0x9a, 0xe7, 0x71, 0x76, 0x65, 0xb9, 0x42, 0x65, 0x31, 0x43, 0xa6,
+	0x05, 0x68, 0x0f, 0x36, 0x23, 0xa6, 0x8c, 0x2b, 0x10, 0x07, 0x3d, 0xda, 0xb9, 0x14, 0x38, 0x53,
+	0x4e, 0x57, 0x96, 0x48, 0xa2, 0x0c, 0x3d, 0x82, 0x42, 0x38, 0x0a, 0xce, 0xe5, 0xeb, 0x84, 0x0b,
+	0x9c, 0x55, 0xda, 0x13, 0xdc, 0x98, 0xed, 0x63, 0x67, 0x40, 0x2d, 0xfe, 0xc2, 0x11, 0x32, 0xe3,
+	0x2a, 0x9d, 0x79, 0x92, 0x28, 0x93, 0x77, 0xae, 0xc7, 0xe9, 0x15, 0x1d, 0xf8, 0x75, 0xea, 0x52,
+	0xd5, 0x01, 0x39, 0xa5, 0x3e, 0xc9, 0x1e, 0xaf, 0x50, 0xfe, 0xce, 0x0a, 0x2d, 0x4d, 0x56, 0xe8,
+	0x19, 0x6c, 0x85, 0xde, 0x47, 0x13, 0x89, 0x5e, 0x39, 0xf4, 0x5a, 0x3d, 0x3e, 0xf3, 0x64, 0x86,
+	0x14, 0xd5, 0xe1, 0xc1, 0x50, 0x32, 0xb8, 0xa2, 0x5c, 0x58, 0xaa, 0x40, 0x54, 0x30, 0x37, 0x50,
+	0xb1, 0x2e, 0x2b, 0xf8, 0xdd, 0x4a, 0x66, 0x0d, 0x56, 0xc7, 0x06, 0x61, 0xbc, 0x98, 0xc6, 0x78,
+	0x31, 0xb7, 0x60, 0x91, 0xe9, 0xd1, 0x9a, 0x52, 0x29, 0x0e, 0x29, 0xf3, 0x5b, 0x58, 0x9f, 0x7a,
+	0x84, 0x26, 0xf5, 0x88, 0x6f, 0x75, 0x4f, 0x47, 0xc7, 0x3a, 0x22, 0xcd, 0x9f, 0x0d, 0x28, 0x4e,
+	0x3e, 0x81, 0x50, 0x43, 0x1e, 0x1e, 0xc6, 0xa9, 0xb2, 0x50, 0x98, 0xeb, 0xc5, 0xa6, 0x91, 0xd5,
+	0x96, 0x84, 0x11, 0x8d, 0x96, 0x37, 0x09, 0xa7, 0x1f, 0xa2, 0x80, 0xd5, 0xda, 0x2c, 0xc9, 0xae,
+	0x97, 0xc2, 0x3c, 0x64, 0x9a, 0xe7, 0x87, 0xad, 0xe2, 0x02, 0x5a, 0x86, 0x5c, 0x8d, 0xbc, 0x69,
+	0xd4, 0x5e, 0x37, 0x8a, 0x86, 0xf9, 0x6b, 0x0a, 0xb6, 0x67, 0x4c, 0xc4, 0xb9, 0x6e, 0x2a, 0x04,
+	0x99, 0x36, 0xb3, 0x6f, 0xa2, 0x01, 0x24, 0xd7, 0x32, 0x75, 0xe1, 0x4f, 0x43, 0x46, 0x3d, 0x96,
+	0x43, 0x4a, 0x76, 0x86, 0x25, 0x84, 0xd3, 0x1d, 0x50, 0x1a, 0x35, 0xee, 0x88, 0x81, 0xda, 0xb0,
+	0x11, 0xf5, 0xbc, 0xba, 0x28, 0x8f, 0x38, 0x0b, 0x3c, 0xfd, 0x33, 0x74, 0xd7, 0x38, 0x1f, 0x0b,
+	0x79, 0x04, 0x24, 0x49, 0xc6, 0xc6, 0x3b, 0x37, 0x77, 0x67, 0xe7, 0xe6, 0x27, 0x3a, 0xd7, 0x6c,
+	0xc0, 0xf6, 0x0c, 0x5f, 0xc3, 0xc4, 0x18, 0xb1, 0xc4, 0x8c, 0x92, 0x90, 0x8a, 0x27, 0xe1, 0xf1,
+	0x11, 0x2c, 0xc7, 0x9e, 0x71, 0x72, 0x56, 0x9c, 0x34, 0xc8, 0x51, 0x43, 0xdf, 0x46, 0xad, 0x97,
+	0xaf, 0x6a, 0xad, 0x17, 0x45, 0x43, 0xae, 0x49, 0x63, 0xbf, 0xd6, 0x92, 0xc3, 0xe5, 0x1e, 0xac,
+	0x1f, 0xd6, 0x5a, 0x17, 0xef, 0x0f, 0xcf, 0xc8, 0x9b, 0x1a, 0xa9, 0xbf, 0x3f, 0x3b, 0x3d, 0x7e,
+	0x5b, 0x4c, 0xef, 0xd7, 0x7f, 0xbf, 0x2d, 0x19, 0x9f, 0x6e, 0x4b, 0xc6, 0x5f, 0xb7, 0x25, 0xe3,
+	0x97, 0xcf, 0xa5, 0x85, 0x4f, 0x9f, 0x4b, 0x0b, 0x7f, 0x7e, 0x2e, 0x2d, 0xbc, 0x7b, 0xdc, 0x75,
+	0xfc, 0x5e, 0xd0, 0xae, 0x76, 0x58, 0x7f, 0x37, 0xfa, 0x2f, 0x8e, 0xbe, 0x3f, 0x0e, 0x57, 0x72,
+	0xa0, 0x8b, 0xf6, 0xa2, 0xfa, 0x55, 0xfe, 0xfa, 0x9f, 0x01, 0x00, 0x36, 0x80, 0x33, 0xb1, 0x8a,
+	0x0f, 0x00, 0x00,
 }
 
 func (m *Repository) Marshal() (dAtA []byte, err error) {
@@ -914,6 +1525,125 @@ func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IssueTemplatesCount != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.IssueTemplatesCount))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.IssueTemplates) > 0 {
+		for iNdEx := len(m.IssueTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssueTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.MilestonesCount != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.MilestonesCount))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.WatchersCount != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.WatchersCount))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.StarsCount != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.StarsCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.CodeOwners) > 0 {
+		for iNdEx := len(m.CodeOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.AllowedMergeMethods) > 0 {
+		dAtA2 := make([]byte, len(m.AllowedMergeMethods)*10)
+		var j1 int
+		for _, num := range m.AllowedMergeMethods {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintRepository(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.BranchProtectionRulesCount != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.BranchProtectionRulesCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.BranchProtectionRules) > 0 {
+		for iNdEx := len(m.BranchProtectionRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BranchProtectionRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
 	if m.EnableArweaveBackup {
 		i--
 		if m.EnableArweaveBackup {
@@ -1020,20 +1750,20 @@ func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x90
 	}
 	if len(m.Stargazers) > 0 {
-		dAtA2 := make([]byte, len(m.Stargazers)*10)
-		var j1 int
+		dAtA4 := make([]byte, len(m.Stargazers)*10)
+		var j3 int
 		for _, num := range m.Stargazers {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j3++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintRepository(dAtA, i, uint64(j1))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintRepository(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -1114,20 +1844,20 @@ func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 	}
 	if len(m.Forks) > 0 {
-		dAtA4 := make([]byte, len(m.Forks)*10)
-		var j3 int
+		dAtA6 := make([]byte, len(m.Forks)*10)
+		var j5 int
 		for _, num := range m.Forks {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j5++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintRepository(dAtA, i, uint64(j3))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintRepository(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -1436,7 +2166,7 @@ func (m *RepositoryLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RepositoryRelease) Marshal() (dAtA []byte, err error) {
+func (m *RepositoryMilestone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1446,20 +2176,52 @@ func (m *RepositoryRelease) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepositoryRelease) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepositoryMilestone) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepositoryRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepositoryMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TagName) > 0 {
-		i -= len(m.TagName)
-		copy(dAtA[i:], m.TagName)
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.TagName)))
+	if m.ClosedAt != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.ClosedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.State != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DueDate != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.DueDate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Title)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1471,7 +2233,7 @@ func (m *RepositoryRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RepositoryBackup) Marshal() (dAtA []byte, err error) {
+func (m *BranchProtectionRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1481,31 +2243,354 @@ func (m *RepositoryBackup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepositoryBackup) MarshalTo(dAtA []byte) (int, error) {
+func (m *BranchProtectionRule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepositoryBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BranchProtectionRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Refs) > 0 {
-		for iNdEx := len(m.Refs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Refs[iNdEx])
-			copy(dAtA[i:], m.Refs[iNdEx])
-			i = encodeVarintRepository(dAtA, i, uint64(len(m.Refs[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if m.RequireConversationResolution {
+		i--
+		if m.RequireConversationResolution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if m.Store != 0 {
-		i = encodeVarintRepository(dAtA, i, uint64(m.Store))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x58
 	}
-	return len(dAtA) - i, nil
+	if m.RequireCodeOwnerReview {
+		i--
+		if m.RequireCodeOwnerReview {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PreventDeletion {
+		i--
+		if m.PreventDeletion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RequireLinearHistory {
+		i--
+		if m.RequireLinearHistory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AllowedPushers) > 0 {
+		for iNdEx := len(m.AllowedPushers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPushers[iNdEx])
+			copy(dAtA[i:], m.AllowedPushers[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.AllowedPushers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RequiredStatusChecks) > 0 {
+		for iNdEx := len(m.RequiredStatusChecks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredStatusChecks[iNdEx])
+			copy(dAtA[i:], m.RequiredStatusChecks[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.RequiredStatusChecks[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.RequiredApprovals != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.RequiredApprovals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeOwnerRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeOwnerRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeOwnerRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepositoryRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepositoryRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepositoryRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TagName) > 0 {
+		i -= len(m.TagName)
+		copy(dAtA[i:], m.TagName)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TagName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepositoryBackup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepositoryBackup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepositoryBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refs) > 0 {
+		for iNdEx := len(m.Refs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Refs[iNdEx])
+			copy(dAtA[i:], m.Refs[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Refs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Store != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Store))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepositoryIssueTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepositoryIssueTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepositoryIssueTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RequiredLabelGroups) > 0 {
+		for iNdEx := len(m.RequiredLabelGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequiredLabelGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Assignees) > 0 {
+		for iNdEx := len(m.Assignees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Assignees[iNdEx])
+			copy(dAtA[i:], m.Assignees[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Assignees[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Labels) > 0 {
+		dAtA9 := make([]byte, len(m.Labels)*10)
+		var j8 int
+		for _, num := range m.Labels {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintRepository(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IssueTemplateLabelGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssueTemplateLabelGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssueTemplateLabelGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		dAtA11 := make([]byte, len(m.Labels)*10)
+		var j10 int
+		for _, num := range m.Labels {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintRepository(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
@@ -1631,14 +2716,60 @@ func (m *Repository) Size() (n int) {
 	if m.EnableArweaveBackup {
 		n += 3
 	}
-	return n
-}
-
-func (m *RepositoryId) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BranchProtectionRules) > 0 {
+		for _, e := range m.BranchProtectionRules {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
 	}
-	var l int
+	if m.BranchProtectionRulesCount != 0 {
+		n += 2 + sovRepository(uint64(m.BranchProtectionRulesCount))
+	}
+	if len(m.AllowedMergeMethods) > 0 {
+		l = 0
+		for _, e := range m.AllowedMergeMethods {
+			l += sovRepository(uint64(e))
+		}
+		n += 2 + sovRepository(uint64(l)) + l
+	}
+	if len(m.CodeOwners) > 0 {
+		for _, e := range m.CodeOwners {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.StarsCount != 0 {
+		n += 2 + sovRepository(uint64(m.StarsCount))
+	}
+	if m.WatchersCount != 0 {
+		n += 2 + sovRepository(uint64(m.WatchersCount))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.MilestonesCount != 0 {
+		n += 2 + sovRepository(uint64(m.MilestonesCount))
+	}
+	if len(m.IssueTemplates) > 0 {
+		for _, e := range m.IssueTemplates {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.IssueTemplatesCount != 0 {
+		n += 2 + sovRepository(uint64(m.IssueTemplatesCount))
+	}
+	return n
+}
+
+func (m *RepositoryId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Id)
 	if l > 0 {
@@ -1757,6 +2888,109 @@ func (m *RepositoryLabel) Size() (n int) {
 	return n
 }
 
+func (m *RepositoryMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRepository(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.DueDate != 0 {
+		n += 1 + sovRepository(uint64(m.DueDate))
+	}
+	if m.State != 0 {
+		n += 1 + sovRepository(uint64(m.State))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovRepository(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovRepository(uint64(m.UpdatedAt))
+	}
+	if m.ClosedAt != 0 {
+		n += 1 + sovRepository(uint64(m.ClosedAt))
+	}
+	return n
+}
+
+func (m *BranchProtectionRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRepository(uint64(m.Id))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.RequiredApprovals != 0 {
+		n += 1 + sovRepository(uint64(m.RequiredApprovals))
+	}
+	if len(m.RequiredStatusChecks) > 0 {
+		for _, s := range m.RequiredStatusChecks {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.AllowedPushers) > 0 {
+		for _, s := range m.AllowedPushers {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.RequireLinearHistory {
+		n += 2
+	}
+	if m.PreventDeletion {
+		n += 2
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovRepository(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovRepository(uint64(m.UpdatedAt))
+	}
+	if m.RequireCodeOwnerReview {
+		n += 2
+	}
+	if m.RequireConversationResolution {
+		n += 2
+	}
+	return n
+}
+
+func (m *CodeOwnerRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *RepositoryRelease) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1791,6 +3025,71 @@ func (m *RepositoryBackup) Size() (n int) {
 	return n
 }
 
+func (m *RepositoryIssueTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRepository(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		l = 0
+		for _, e := range m.Labels {
+			l += sovRepository(uint64(e))
+		}
+		n += 1 + sovRepository(uint64(l)) + l
+	}
+	if len(m.Assignees) > 0 {
+		for _, s := range m.Assignees {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.RequiredLabelGroups) > 0 {
+		for _, e := range m.RequiredLabelGroups {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovRepository(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovRepository(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *IssueTemplateLabelGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		l = 0
+		for _, e := range m.Labels {
+			l += sovRepository(uint64(e))
+		}
+		n += 1 + sovRepository(uint64(l)) + l
+	}
+	return n
+}
+
 func sovRepository(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2191,9 +3490,1401 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelsCount", wireType)
+			}
+			m.LabelsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LabelsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Releases = append(m.Releases, &RepositoryRelease{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PushedAt", wireType)
+			}
+			m.PushedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PushedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Stargazers = append(m.Stargazers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRepository
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRepository
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Stargazers) == 0 {
+					m.Stargazers = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Stargazers = append(m.Stargazers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stargazers", wireType)
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archived = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.License = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fork", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fork = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collaborators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collaborators = append(m.Collaborators, &RepositoryCollaborator{})
+			if err := m.Collaborators[len(m.Collaborators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForking = bool(v != 0)
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backups = append(m.Backups, &RepositoryBackup{})
+			if err := m.Backups[len(m.Backups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableArweaveBackup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableArweaveBackup = bool(v != 0)
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchProtectionRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchProtectionRules = append(m.BranchProtectionRules, &BranchProtectionRule{})
+			if err := m.BranchProtectionRules[len(m.BranchProtectionRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchProtectionRulesCount", wireType)
+			}
+			m.BranchProtectionRulesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BranchProtectionRulesCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType == 0 {
+				var v MergeMethod
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= MergeMethod(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedMergeMethods = append(m.AllowedMergeMethods, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRepository
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRepository
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.AllowedMergeMethods) == 0 {
+					m.AllowedMergeMethods = make([]MergeMethod, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v MergeMethod
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= MergeMethod(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedMergeMethods = append(m.AllowedMergeMethods, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMergeMethods", wireType)
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeOwners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeOwners = append(m.CodeOwners, &CodeOwnerRule{})
+			if err := m.CodeOwners[len(m.CodeOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StarsCount", wireType)
+			}
+			m.StarsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StarsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchersCount", wireType)
+			}
+			m.WatchersCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WatchersCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, &RepositoryMilestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestonesCount", wireType)
+			}
+			m.MilestonesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MilestonesCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueTemplates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssueTemplates = append(m.IssueTemplates, &RepositoryIssueTemplate{})
+			if err := m.IssueTemplates[len(m.IssueTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueTemplatesCount", wireType)
+			}
+			m.IssueTemplatesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssueTemplatesCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseRepositoryKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseRepositoryKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseRepositoryKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OwnerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssueIid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssueIid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssueIid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
+			}
+			m.Iid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestIid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestIid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestIid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
+			}
+			m.Iid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryCollaborator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryCollaborator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryCollaborator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= RepositoryCollaborator_Permission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryLabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryLabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.LabelsCount = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2203,16 +4894,16 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LabelsCount |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2222,31 +4913,29 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Releases = append(m.Releases, &RepositoryRelease{})
-			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
 			}
-			m.CreatedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2256,35 +4945,29 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
 			}
-			m.UpdatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
 			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PushedAt", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PushedAt = 0
+			m.Color = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2294,92 +4977,79 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PushedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRepository
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Stargazers = append(m.Stargazers, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRepository
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRepository
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRepository
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Stargazers) == 0 {
-					m.Stargazers = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Stargazers = append(m.Stargazers, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stargazers", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
 			}
-		case 18:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryMilestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryMilestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2389,15 +5059,14 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Archived = bool(v != 0)
-		case 19:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2425,11 +5094,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.License = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2447,42 +5116,23 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 			}
 			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DefaultBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
-			}
-			m.Parent = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Parent |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
 			}
-		case 22:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fork", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DueDate", wireType)
 			}
-			var v int
+			m.DueDate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2492,17 +5142,16 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DueDate |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Fork = bool(v != 0)
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collaborators", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2512,31 +5161,16 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= RepositoryMilestone_State(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Collaborators = append(m.Collaborators, &RepositoryCollaborator{})
-			if err := m.Collaborators[len(m.Collaborators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 24:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowForking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var v int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2546,17 +5180,16 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowForking = bool(v != 0)
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backups", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var msglen int
+			m.UpdatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2566,31 +5199,16 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UpdatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Backups = append(m.Backups, &RepositoryBackup{})
-			if err := m.Backups[len(m.Backups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 26:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableArweaveBackup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
 			}
-			var v int
+			m.ClosedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2600,12 +5218,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ClosedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableArweaveBackup = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -2627,7 +5244,7 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryId) Unmarshal(dAtA []byte) error {
+func (m *BranchProtectionRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2650,16 +5267,35 @@ func (m *RepositoryId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryId: wiretype end group for non-group")
+			return fmt.Errorf("proto: BranchProtectionRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BranchProtectionRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -2686,11 +5322,30 @@ func (m *RepositoryId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredApprovals", wireType)
+			}
+			m.RequiredApprovals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredApprovals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredStatusChecks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2718,63 +5373,45 @@ func (m *RepositoryId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RequiredStatusChecks = append(m.RequiredStatusChecks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPushers", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BaseRepositoryKey) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BaseRepositoryKey: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseRepositoryKey: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.AllowedPushers = append(m.AllowedPushers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireLinearHistory", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2784,16 +5421,17 @@ func (m *BaseRepositoryKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			m.RequireLinearHistory = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreventDeletion", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2803,29 +5441,55 @@ func (m *BaseRepositoryKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
+			m.PreventDeletion = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireCodeOwnerReview", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2835,24 +5499,32 @@ func (m *BaseRepositoryKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
+			m.RequireCodeOwnerReview = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireConversationResolution", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.RequireConversationResolution = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -2874,7 +5546,7 @@ func (m *BaseRepositoryKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryOwner) Unmarshal(dAtA []byte) error {
+func (m *CodeOwnerRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2897,15 +5569,15 @@ func (m *RepositoryOwner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryOwner: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeOwnerRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeOwnerRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2933,13 +5605,13 @@ func (m *RepositoryOwner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2949,11 +5621,24 @@ func (m *RepositoryOwner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= OwnerType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -2975,7 +5660,7 @@ func (m *RepositoryOwner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IssueIid) Unmarshal(dAtA []byte) error {
+func (m *RepositoryRelease) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2998,17 +5683,17 @@ func (m *IssueIid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IssueIid: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryRelease: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IssueIid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryRelease: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Iid = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3018,16 +5703,16 @@ func (m *IssueIid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Iid |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagName", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3037,11 +5722,24 @@ func (m *IssueIid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -3063,7 +5761,7 @@ func (m *IssueIid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestIid) Unmarshal(dAtA []byte) error {
+func (m *RepositoryBackup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3086,17 +5784,17 @@ func (m *PullRequestIid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestIid: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryBackup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestIid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryBackup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
 			}
-			m.Iid = 0
+			m.Store = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3106,16 +5804,16 @@ func (m *PullRequestIid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Iid |= uint64(b&0x7F) << shift
+				m.Store |= RepositoryBackup_Store(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refs", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3125,11 +5823,24 @@ func (m *PullRequestIid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refs = append(m.Refs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -3151,7 +5862,7 @@ func (m *PullRequestIid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryCollaborator) Unmarshal(dAtA []byte) error {
+func (m *RepositoryIssueTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3174,16 +5885,35 @@ func (m *RepositoryCollaborator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryCollaborator: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryIssueTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryCollaborator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryIssueTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -3210,13 +5940,13 @@ func (m *RepositoryCollaborator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			m.Permission = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3226,115 +5956,103 @@ func (m *RepositoryCollaborator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= RepositoryCollaborator_Permission(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepositoryLabel) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryLabel: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryLabel: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Labels = append(m.Labels, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthRepository
 				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRepository
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Labels) == 0 {
+					m.Labels = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Labels = append(m.Labels, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3362,13 +6080,13 @@ func (m *RepositoryLabel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Color = string(dAtA[iNdEx:postIndex])
+			m.Assignees = append(m.Assignees, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredLabelGroups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3378,79 +6096,31 @@ func (m *RepositoryLabel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
+			m.RequiredLabelGroups = append(m.RequiredLabelGroups, &IssueTemplateLabelGroup{})
+			if err := m.RequiredLabelGroups[len(m.RequiredLabelGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepositoryRelease) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryRelease: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryRelease: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			m.Id = 0
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3460,16 +6130,16 @@ func (m *RepositoryRelease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TagName", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var stringLen uint64
+			m.UpdatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -3479,24 +6149,11 @@ func (m *RepositoryRelease) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpdatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TagName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -3518,7 +6175,7 @@ func (m *RepositoryRelease) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryBackup) Unmarshal(dAtA []byte) error {
+func (m *IssueTemplateLabelGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3541,34 +6198,15 @@ func (m *RepositoryBackup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryBackup: wiretype end group for non-group")
+			return fmt.Errorf("proto: IssueTemplateLabelGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryBackup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IssueTemplateLabelGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
-			}
-			m.Store = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Store |= RepositoryBackup_Store(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Refs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3596,8 +6234,84 @@ func (m *RepositoryBackup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Refs = append(m.Refs, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Labels = append(m.Labels, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRepository
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRepository
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Labels) == 0 {
+					m.Labels = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Labels = append(m.Labels, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
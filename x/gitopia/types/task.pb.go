@@ -28,16 +28,19 @@ type TaskType int32
 const (
 	TypeForkRepository      TaskType = 0
 	TypeSetPullRequestState TaskType = 1
+	TypeTestMergeQueueEntry TaskType = 2
 )
 
 var TaskType_name = map[int32]string{
 	0: "TASK_TYPE_FORK_REPOSITORY",
 	1: "TASK_TYPE_SET_PULL_REQUEST_STATE",
+	2: "TASK_TYPE_TEST_MERGE_QUEUE_ENTRY",
 }
 
 var TaskType_value = map[string]int32{
 	"TASK_TYPE_FORK_REPOSITORY":        0,
 	"TASK_TYPE_SET_PULL_REQUEST_STATE": 1,
+	"TASK_TYPE_TEST_MERGE_QUEUE_ENTRY": 2,
 }
 
 func (x TaskType) String() string {
@@ -169,35 +172,37 @@ func init() {
 func init() { proto.RegisterFile("gitopia/task.proto", fileDescriptor_a6920678987ef43f) }
 
 var fileDescriptor_a6920678987ef43f = []byte{
-	// 438 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe3, 0x92, 0x8d, 0xcd, 0x42, 0x53, 0x64, 0x21, 0x16, 0x82, 0x14, 0x85, 0x9d, 0xaa,
-	0x1e, 0x52, 0x09, 0x34, 0x89, 0x6b, 0xd9, 0x5c, 0x54, 0xad, 0x5a, 0x83, 0xed, 0x1e, 0xc6, 0x25,
-	0xca, 0x5a, 0x2b, 0x58, 0xed, 0xe6, 0x60, 0x3b, 0x88, 0x7e, 0x03, 0xd4, 0x0b, 0x7c, 0x81, 0x9e,
-	0xf8, 0x32, 0x1c, 0x77, 0x83, 0x23, 0x6a, 0xbf, 0x08, 0x8a, 0xdb, 0x94, 0xa9, 0x12, 0x3b, 0xbd,
-	0xf7, 0xfc, 0x7e, 0xff, 0xbf, 0xde, 0x93, 0x1f, 0x44, 0xb9, 0x30, 0xb2, 0x10, 0x59, 0xdb, 0x64,
-	0x7a, 0x12, 0x17, 0x4a, 0x1a, 0x89, 0x8e, 0x37, 0x6f, 0xf1, 0x4e, 0x0c, 0x9e, 0xe6, 0x32, 0x97,
-	0x96, 0x69, 0x57, 0xd9, 0x1a, 0x3f, 0xf9, 0x05, 0xa0, 0xcb, 0x32, 0x3d, 0x41, 0x47, 0xb0, 0x21,
-	0xc6, 0x3e, 0x88, 0x40, 0xd3, 0x25, 0x0d, 0x31, 0x46, 0xa7, 0xd0, 0x35, 0xb3, 0x82, 0xfb, 0x8d,
-	0x08, 0x34, 0x8f, 0x5e, 0xbd, 0x8c, 0xff, 0x63, 0x1b, 0x57, 0x62, 0x36, 0x2b, 0x38, 0xb1, 0x38,
-	0x7a, 0x03, 0xf7, 0xb4, 0xc9, 0x0c, 0xf7, 0x1f, 0x59, 0xdd, 0xc9, 0x83, 0x3a, 0x5a, 0x91, 0x64,
-	0x2d, 0x40, 0x3e, 0x7c, 0x7c, 0xc3, 0xb5, 0xce, 0x72, 0xee, 0xbb, 0x11, 0x68, 0x1e, 0x92, 0xba,
-	0xac, 0x3a, 0x23, 0xc5, 0x33, 0x23, 0x95, 0xbf, 0xb7, 0xee, 0x6c, 0x4a, 0x14, 0xc0, 0x83, 0x42,
-	0xc9, 0xcf, 0x62, 0xcc, 0x95, 0xbf, 0x6f, 0x5b, 0xdb, 0xba, 0x35, 0x07, 0xf0, 0xa0, 0x1e, 0x0e,
-	0x9d, 0xc2, 0xe7, 0xac, 0x43, 0x2f, 0x52, 0x76, 0x95, 0xe0, 0xb4, 0x3b, 0x20, 0x17, 0x29, 0xc1,
-	0xc9, 0x80, 0xf6, 0xd8, 0x80, 0x5c, 0x79, 0x4e, 0xf0, 0x6c, 0xbe, 0x88, 0x50, 0x05, 0x76, 0xa5,
-	0x9a, 0x10, 0x5e, 0x48, 0x2d, 0x8c, 0x54, 0x33, 0xd4, 0x81, 0xd1, 0x3f, 0x19, 0xc5, 0x2c, 0x4d,
-	0x86, 0xfd, 0x7e, 0x4a, 0xf0, 0xfb, 0x21, 0xa6, 0x2c, 0xa5, 0xac, 0xc3, 0xb0, 0x07, 0x82, 0x17,
-	0xf3, 0x45, 0x74, 0x5c, 0xa9, 0x29, 0x37, 0x49, 0x39, 0x9d, 0x12, 0xfe, 0xa9, 0xe4, 0xda, 0xd8,
-	0xed, 0x02, 0xf7, 0xeb, 0x8f, 0xd0, 0x69, 0x7d, 0x03, 0xf0, 0x70, 0xbb, 0x31, 0x6a, 0x42, 0x64,
-	0x6d, 0xad, 0x41, 0x9a, 0xe0, 0xcb, 0xf3, 0xde, 0xe5, 0x3b, 0xcf, 0x09, 0xbc, 0xf9, 0x22, 0x7a,
-	0x62, 0x91, 0x84, 0xdf, 0x8e, 0xc5, 0x6d, 0xbe, 0x43, 0xd2, 0xe1, 0xd9, 0x19, 0xa6, 0xd4, 0x03,
-	0xf7, 0x48, 0x5a, 0x8e, 0x46, 0x5c, 0xeb, 0x1d, 0xb2, 0xdb, 0xe9, 0xf5, 0x87, 0x04, 0x7b, 0x8d,
-	0x7b, 0x64, 0x37, 0x13, 0xd3, 0x52, 0x6d, 0x26, 0x7a, 0x7b, 0xfe, 0x73, 0x19, 0x82, 0xbb, 0x65,
-	0x08, 0xfe, 0x2c, 0x43, 0xf0, 0x7d, 0x15, 0x3a, 0x77, 0xab, 0xd0, 0xf9, 0xbd, 0x0a, 0x9d, 0x0f,
-	0xad, 0x5c, 0x98, 0x8f, 0xe5, 0x75, 0x3c, 0x92, 0x37, 0xed, 0xfa, 0xc0, 0xea, 0xf8, 0x65, 0x9b,
-	0x55, 0xbf, 0xad, 0xaf, 0xf7, 0xed, 0x15, 0xbd, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x69, 0xfa,
-	0x3f, 0x24, 0x8a, 0x02, 0x00, 0x00,
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xe3, 0x90, 0x8d, 0xcd, 0x42, 0x53, 0x64, 0x21, 0x16, 0x82, 0x14, 0x85, 0x9d, 0xaa,
+	0x1e, 0x52, 0x09, 0x34, 0x89, 0x6b, 0xd9, 0xdc, 0xa9, 0x5a, 0xd7, 0xa6, 0xb6, 0x73, 0x28, 0x97,
+	0x28, 0x6b, 0xad, 0x10, 0xb5, 0xab, 0x83, 0xed, 0x20, 0xfa, 0x06, 0xa8, 0x17, 0x78, 0x81, 0x9e,
+	0x78, 0x19, 0x2e, 0x48, 0xbb, 0xc1, 0x11, 0xb5, 0x2f, 0x82, 0x92, 0xae, 0xdd, 0xa8, 0x04, 0x27,
+	0xfb, 0xcb, 0xf7, 0xfb, 0xfd, 0xf5, 0xc5, 0xfa, 0x20, 0x4a, 0x33, 0x2d, 0xf2, 0x2c, 0x69, 0xe8,
+	0x44, 0x8d, 0x83, 0x5c, 0x0a, 0x2d, 0xd0, 0xf1, 0xdd, 0xb7, 0x60, 0xe7, 0x74, 0x9f, 0xa6, 0x22,
+	0x15, 0x15, 0xd3, 0x28, 0x6f, 0x6b, 0xfc, 0xe4, 0x27, 0x80, 0x16, 0x4b, 0xd4, 0x18, 0x1d, 0x41,
+	0x33, 0x1b, 0x39, 0xc0, 0x07, 0x35, 0x8b, 0x98, 0xd9, 0x08, 0x9d, 0x42, 0x4b, 0xcf, 0x72, 0xee,
+	0x98, 0x3e, 0xa8, 0x1d, 0xbd, 0x7a, 0x19, 0xfc, 0x23, 0x36, 0x28, 0x65, 0x36, 0xcb, 0x39, 0xa9,
+	0x70, 0xf4, 0x06, 0xee, 0x29, 0x9d, 0x68, 0xee, 0x3c, 0xaa, 0xbc, 0x93, 0xff, 0x7a, 0xb4, 0x24,
+	0xc9, 0x5a, 0x40, 0x0e, 0x7c, 0x7c, 0xc3, 0x95, 0x4a, 0x52, 0xee, 0x58, 0x3e, 0xa8, 0x1d, 0x92,
+	0x4d, 0x59, 0x76, 0x86, 0x92, 0x27, 0x5a, 0x48, 0x67, 0x6f, 0xdd, 0xb9, 0x2b, 0x91, 0x0b, 0x0f,
+	0x72, 0x29, 0x3e, 0x66, 0x23, 0x2e, 0x9d, 0xfd, 0xaa, 0xb5, 0xad, 0xeb, 0x3f, 0x00, 0x3c, 0xd8,
+	0x0c, 0x87, 0x4e, 0xe1, 0x73, 0xd6, 0xa4, 0x97, 0x31, 0x1b, 0x84, 0x38, 0x6e, 0xf5, 0xc8, 0x65,
+	0x4c, 0x70, 0xd8, 0xa3, 0x6d, 0xd6, 0x23, 0x03, 0xdb, 0x70, 0x9f, 0xcd, 0x17, 0x3e, 0x2a, 0xc1,
+	0x96, 0x90, 0x63, 0xc2, 0x73, 0xa1, 0x32, 0x2d, 0xe4, 0x0c, 0x35, 0xa1, 0x7f, 0xaf, 0x51, 0xcc,
+	0xe2, 0x30, 0xea, 0x74, 0x62, 0x82, 0xfb, 0x11, 0xa6, 0x2c, 0xa6, 0xac, 0xc9, 0xb0, 0x0d, 0xdc,
+	0x17, 0xf3, 0x85, 0x7f, 0x5c, 0xda, 0x94, 0xeb, 0xb0, 0x98, 0x4c, 0x08, 0xff, 0x50, 0x70, 0xa5,
+	0xab, 0xbf, 0xfb, 0x3b, 0x82, 0x95, 0xda, 0x15, 0x26, 0x17, 0x38, 0xee, 0x47, 0x38, 0xc2, 0x31,
+	0xee, 0x32, 0x32, 0xb0, 0xcd, 0xfb, 0x08, 0xc6, 0x95, 0xbe, 0xe2, 0x32, 0xe5, 0xfd, 0x82, 0x17,
+	0x1c, 0x4f, 0xb5, 0x9c, 0xb9, 0xd6, 0xe7, 0x6f, 0x9e, 0x51, 0xff, 0x02, 0xe0, 0xe1, 0xf6, 0xd1,
+	0x50, 0x0d, 0xa2, 0x2a, 0xb6, 0x9a, 0x21, 0x0e, 0x71, 0xf7, 0xbc, 0xdd, 0xbd, 0xb0, 0x0d, 0xd7,
+	0x9e, 0x2f, 0xfc, 0x27, 0x15, 0x12, 0xf2, 0xe9, 0x28, 0x9b, 0xa6, 0x3b, 0x24, 0x8d, 0xce, 0xce,
+	0x30, 0xa5, 0x36, 0x78, 0x40, 0xd2, 0x62, 0x38, 0xe4, 0x4a, 0xed, 0x90, 0xad, 0x66, 0xbb, 0x13,
+	0x11, 0x6c, 0x9b, 0x0f, 0xc8, 0x56, 0x92, 0x4d, 0x0a, 0xc9, 0xd7, 0x13, 0xbd, 0x3d, 0xff, 0xbe,
+	0xf4, 0xc0, 0xed, 0xd2, 0x03, 0xbf, 0x97, 0x1e, 0xf8, 0xba, 0xf2, 0x8c, 0xdb, 0x95, 0x67, 0xfc,
+	0x5a, 0x79, 0xc6, 0xbb, 0x7a, 0x9a, 0xe9, 0xf7, 0xc5, 0x75, 0x30, 0x14, 0x37, 0x8d, 0xcd, 0x8e,
+	0x6e, 0xce, 0x4f, 0xdb, 0x5b, 0xb9, 0x30, 0xea, 0x7a, 0xbf, 0x5a, 0xc4, 0xd7, 0x7f, 0x06, 0x00,
+	0x24, 0xf7, 0x74, 0xe2, 0xcd, 0x02, 0x00, 0x00,
 }
 
 func (m *Task) Marshal() (dAtA []byte, err error) {
@@ -38,6 +38,8 @@ type User struct {
 	CreatedAt      int64    `protobuf:"varint,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt      int64    `protobuf:"varint,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 	Verified       bool     `protobuf:"varint,14,opt,name=verified,proto3" json:"verified,omitempty"`
+	FollowersCount uint64   `protobuf:"varint,15,opt,name=followersCount,proto3" json:"followersCount,omitempty"`
+	FollowingCount uint64   `protobuf:"varint,16,opt,name=followingCount,proto3" json:"followingCount,omitempty"`
 }
 
 func (m *User) Reset()         { *m = User{} }
@@ -171,6 +173,20 @@ func (m *User) GetVerified() bool {
 	return false
 }
 
+func (m *User) GetFollowersCount() uint64 {
+	if m != nil {
+		return m.FollowersCount
+	}
+	return 0
+}
+
+func (m *User) GetFollowingCount() uint64 {
+	if m != nil {
+		return m.FollowingCount
+	}
+	return 0
+}
+
 type UserDao struct {
 	UserAddress string `protobuf:"bytes,1,opt,name=userAddress,proto3" json:"userAddress,omitempty"`
 	DaoAddress  string `protobuf:"bytes,2,opt,name=daoAddress,proto3" json:"daoAddress,omitempty"`
@@ -231,31 +247,33 @@ func init() {
 func init() { proto.RegisterFile("gitopia/user.proto", fileDescriptor_bf7f4b301dc3d162) }
 
 var fileDescriptor_bf7f4b301dc3d162 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xcd, 0x6a, 0xe3, 0x30,
-	0x14, 0x85, 0x23, 0xdb, 0x93, 0x9f, 0x9b, 0x1f, 0x06, 0x31, 0x30, 0x22, 0x0c, 0xc6, 0x64, 0x86,
-	0xc1, 0xcc, 0x22, 0x59, 0xcc, 0x13, 0x64, 0x08, 0xcc, 0xa2, 0x3b, 0x43, 0x36, 0xdd, 0x14, 0x39,
-	0x52, 0x5c, 0x41, 0x62, 0x19, 0x49, 0x4e, 0xdb, 0xb7, 0xe8, 0xae, 0xaf, 0xd4, 0x65, 0x96, 0x5d,
-	0x96, 0xe4, 0x45, 0x8a, 0x14, 0xdb, 0x49, 0xb3, 0xd2, 0xb9, 0xdf, 0x39, 0x20, 0x71, 0x74, 0x01,
-	0x67, 0xc2, 0xc8, 0x42, 0xd0, 0x59, 0xa9, 0xb9, 0x9a, 0x16, 0x4a, 0x1a, 0x89, 0xbf, 0x57, 0x6c,
-	0x7a, 0x75, 0x8e, 0xbf, 0x65, 0x32, 0x93, 0x2e, 0x33, 0xb3, 0xea, 0x14, 0x9f, 0xbc, 0xf8, 0x10,
-	0x2c, 0x35, 0x57, 0x98, 0x40, 0x67, 0xa5, 0x38, 0x35, 0x52, 0x11, 0x14, 0xa1, 0xb8, 0x97, 0xd4,
-	0x23, 0x1e, 0x81, 0x27, 0x18, 0xf1, 0x22, 0x14, 0x07, 0x89, 0x27, 0x18, 0xc6, 0x10, 0xe4, 0x74,
-	0xcb, 0x89, 0xef, 0x62, 0x4e, 0xe3, 0x31, 0x74, 0xed, 0x1b, 0x1c, 0x0f, 0x1c, 0x6f, 0x66, 0xfc,
-	0x1b, 0x46, 0xb5, 0xfe, 0x2f, 0xcc, 0x7d, 0x99, 0x92, 0x2f, 0x2e, 0x71, 0x45, 0xf1, 0x0f, 0xe8,
-	0xd1, 0x1d, 0x35, 0x54, 0x2d, 0xd5, 0x86, 0xb4, 0x5d, 0xe4, 0x0c, 0xac, 0xbb, 0x96, 0x9b, 0x8d,
-	0x7c, 0xe0, 0x4a, 0x93, 0x4e, 0xe4, 0x5b, 0xb7, 0x01, 0x67, 0x57, 0xe4, 0x19, 0xe9, 0x5e, 0xba,
-	0x22, 0xcf, 0xf0, 0x4f, 0x18, 0x6a, 0x43, 0x95, 0xe2, 0xec, 0x4e, 0xf1, 0x42, 0x6a, 0xd2, 0x8b,
-	0xfc, 0x38, 0x48, 0x06, 0x15, 0x4c, 0x2c, 0xc3, 0xbf, 0x60, 0xa8, 0xcb, 0x54, 0xaf, 0x94, 0x28,
-	0x8c, 0x90, 0xb9, 0x26, 0xe0, 0x9e, 0xf0, 0x19, 0xe2, 0xaf, 0xe0, 0xa7, 0x42, 0x92, 0xbe, 0xf3,
-	0xac, 0xb4, 0x57, 0xbb, 0xa6, 0x38, 0x9b, 0x1b, 0x32, 0x88, 0x50, 0xec, 0x27, 0x67, 0x60, 0xdd,
-	0xb2, 0x60, 0x95, 0x3b, 0x3c, 0xb9, 0x0d, 0xb0, 0xb5, 0xed, 0xb8, 0x12, 0x6b, 0xc1, 0x19, 0x19,
-	0x45, 0x28, 0xee, 0x26, 0xcd, 0x3c, 0xb9, 0x81, 0x8e, 0xfd, 0x98, 0x05, 0x95, 0x38, 0x82, 0xbe,
-	0xed, 0x6a, 0xce, 0x98, 0xe2, 0x5a, 0x57, 0xff, 0x73, 0x89, 0x70, 0x08, 0xc0, 0xa8, 0xac, 0x03,
-	0x9e, 0x0b, 0x5c, 0x90, 0x7f, 0x8b, 0xd7, 0x43, 0x88, 0xf6, 0x87, 0x10, 0xbd, 0x1f, 0x42, 0xf4,
-	0x7c, 0x0c, 0x5b, 0xfb, 0x63, 0xd8, 0x7a, 0x3b, 0x86, 0xad, 0xdb, 0x3f, 0x99, 0x6b, 0x7f, 0xba,
-	0x92, 0xdb, 0x59, 0xbd, 0x4e, 0xf5, 0xf9, 0xd8, 0x28, 0xf3, 0x54, 0x70, 0x9d, 0xb6, 0xdd, 0xce,
-	0xfc, 0xfd, 0x08, 0x00, 0x00, 0xff, 0xff, 0x48, 0x29, 0x3c, 0x4b, 0x78, 0x02, 0x00, 0x00,
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xbb, 0xee, 0xd3, 0x30,
+	0x14, 0x87, 0xeb, 0x24, 0xf4, 0x72, 0x7a, 0xa1, 0xb2, 0x90, 0xb0, 0x2a, 0x14, 0x45, 0x05, 0xa1,
+	0x88, 0xa1, 0x1d, 0x78, 0x82, 0x42, 0x25, 0x06, 0xb6, 0x48, 0x5d, 0x58, 0x90, 0x53, 0xbb, 0xc1,
+	0x52, 0x1b, 0x47, 0xb6, 0x53, 0xe0, 0x2d, 0x78, 0x2c, 0xc6, 0x8e, 0x8c, 0xa8, 0x1d, 0x78, 0x0d,
+	0x64, 0x37, 0xb7, 0x7f, 0x27, 0x9f, 0xf3, 0xfd, 0x3e, 0x29, 0xce, 0x39, 0x06, 0x9c, 0x09, 0x23,
+	0x0b, 0x41, 0xd7, 0xa5, 0xe6, 0x6a, 0x55, 0x28, 0x69, 0x24, 0x7e, 0x59, 0xb1, 0xd5, 0xc3, 0xb9,
+	0x78, 0x91, 0xc9, 0x4c, 0x3a, 0x67, 0x6d, 0xab, 0xbb, 0xbe, 0xfc, 0xe7, 0x43, 0xb0, 0xd3, 0x5c,
+	0x61, 0x02, 0x83, 0xbd, 0xe2, 0xd4, 0x48, 0x45, 0x50, 0x84, 0xe2, 0x51, 0x52, 0xb7, 0x78, 0x06,
+	0x9e, 0x60, 0xc4, 0x8b, 0x50, 0x1c, 0x24, 0x9e, 0x60, 0x18, 0x43, 0x90, 0xd3, 0x13, 0x27, 0xbe,
+	0xd3, 0x5c, 0x8d, 0x17, 0x30, 0xb4, 0x77, 0x70, 0x3c, 0x70, 0xbc, 0xe9, 0xf1, 0x5b, 0x98, 0xd5,
+	0xf5, 0x27, 0x61, 0xbe, 0x95, 0x29, 0x79, 0xe6, 0x8c, 0x07, 0x8a, 0x5f, 0xc1, 0x88, 0x9e, 0xa9,
+	0xa1, 0x6a, 0xa7, 0x8e, 0xa4, 0xef, 0x94, 0x16, 0xd8, 0xf4, 0x20, 0x8f, 0x47, 0xf9, 0x9d, 0x2b,
+	0x4d, 0x06, 0x91, 0x6f, 0xd3, 0x06, 0xb4, 0xa9, 0xc8, 0x33, 0x32, 0xec, 0xa6, 0x22, 0xcf, 0xf0,
+	0x6b, 0x98, 0x6a, 0x43, 0x95, 0xe2, 0xec, 0xab, 0xe2, 0x85, 0xd4, 0x64, 0x14, 0xf9, 0x71, 0x90,
+	0x4c, 0x2a, 0x98, 0x58, 0x86, 0xdf, 0xc0, 0x54, 0x97, 0xa9, 0xde, 0x2b, 0x51, 0x18, 0x21, 0x73,
+	0x4d, 0xc0, 0x5d, 0xe1, 0x29, 0xc4, 0x73, 0xf0, 0x53, 0x21, 0xc9, 0xd8, 0x65, 0xb6, 0xb4, 0x9f,
+	0x76, 0x93, 0xe2, 0x6c, 0x63, 0xc8, 0x24, 0x42, 0xb1, 0x9f, 0xb4, 0xc0, 0xa6, 0x65, 0xc1, 0xaa,
+	0x74, 0x7a, 0x4f, 0x1b, 0x60, 0xc7, 0x76, 0xe6, 0x4a, 0x1c, 0x04, 0x67, 0x64, 0x16, 0xa1, 0x78,
+	0x98, 0x34, 0xbd, 0x1d, 0x5b, 0xf3, 0x7f, 0x1f, 0x65, 0x99, 0x1b, 0xf2, 0xdc, 0xad, 0xe0, 0x81,
+	0xb6, 0x9e, 0xc8, 0xb3, 0xbb, 0x37, 0xef, 0x7a, 0x35, 0x5d, 0x7e, 0x86, 0x81, 0x5d, 0xf4, 0x96,
+	0x4a, 0x1c, 0xc1, 0xd8, 0xce, 0x7e, 0xc3, 0x98, 0xe2, 0x5a, 0x57, 0xfb, 0xee, 0x22, 0x1c, 0x02,
+	0x30, 0x2a, 0x6b, 0xc1, 0x73, 0x42, 0x87, 0x7c, 0xd8, 0xfe, 0xbe, 0x86, 0xe8, 0x72, 0x0d, 0xd1,
+	0xdf, 0x6b, 0x88, 0x7e, 0xdd, 0xc2, 0xde, 0xe5, 0x16, 0xf6, 0xfe, 0xdc, 0xc2, 0xde, 0x97, 0x77,
+	0x99, 0xdb, 0xe6, 0x6a, 0x2f, 0x4f, 0xeb, 0xfa, 0x79, 0xd6, 0xe7, 0x8f, 0xa6, 0x32, 0x3f, 0x0b,
+	0xae, 0xd3, 0xbe, 0x7b, 0x83, 0xef, 0xff, 0x0f, 0x00, 0x17, 0x0e, 0x2a, 0xf8, 0xc8, 0x02, 0x00,
+	0x00,
 }
 
 func (m *User) Marshal() (dAtA []byte, err error) {
@@ -278,6 +296,18 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FollowingCount != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.FollowingCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.FollowersCount != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.FollowersCount))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.Verified {
 		i--
 		if m.Verified {
@@ -504,6 +534,12 @@ func (m *User) Size() (n int) {
 	if m.Verified {
 		n += 2
 	}
+	if m.FollowersCount != 0 {
+		n += 1 + sovUser(uint64(m.FollowersCount))
+	}
+	if m.FollowingCount != 0 {
+		n += 2 + sovUser(uint64(m.FollowingCount))
+	}
 	return n
 }
 
@@ -1000,6 +1036,44 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Verified = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowersCount", wireType)
+			}
+			m.FollowersCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FollowersCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowingCount", wireType)
+			}
+			m.FollowingCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FollowingCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
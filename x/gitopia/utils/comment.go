@@ -23,6 +23,14 @@ func RemoveLabelsCommentBody(creator string, labels []string) string {
 	return fmt.Sprintf("@%v removed"+JoinLabels(labels)+" label", creator)
 }
 
+func AddMilestoneCommentBody(creator string, milestone string) string {
+	return fmt.Sprintf("@%v added this to the **%v** milestone", creator, milestone)
+}
+
+func RemoveMilestoneCommentBody(creator string, milestone string) string {
+	return fmt.Sprintf("@%v removed this from the **%v** milestone", creator, milestone)
+}
+
 func UpdateTitleCommentBody(creator string, oldTitle string, newTitle string) string {
 	return fmt.Sprintf("@%v changed title from **~~%v~~** to **%v**", creator, oldTitle, newTitle)
 }
@@ -50,6 +58,22 @@ func PullRequestToggleStateCommentBody(creator string, state types.PullRequest_S
 	return "undefined"
 }
 
+func PullRequestReadyForReviewCommentBody(creator string) string {
+	return fmt.Sprintf("@%v marked this pull request as ready for review", creator)
+}
+
+func PullRequestConvertedToDraftCommentBody(creator string) string {
+	return fmt.Sprintf("@%v marked this pull request as draft", creator)
+}
+
+func ConversationLockedCommentBody(creator string) string {
+	return fmt.Sprintf("@%v locked this conversation", creator)
+}
+
+func ConversationUnlockedCommentBody(creator string) string {
+	return fmt.Sprintf("@%v unlocked this conversation", creator)
+}
+
 func AddReviewersCommentBody(creator string, reviewers []string) string {
 	return fmt.Sprintf("@%v requested review from"+JoinReviewers(reviewers), creator)
 }
@@ -58,6 +82,15 @@ func RemoveReviewersCommentBody(creator string, reviewers []string) string {
 	return fmt.Sprintf("@%v removed review request for"+JoinReviewers(reviewers), creator)
 }
 
+func SubmitPullRequestReviewCommentBody(creator string, state types.PullRequestReview_State) string {
+	if state == types.PullRequestReview_APPROVE {
+		return fmt.Sprintf("@%v approved these changes", creator)
+	} else if state == types.PullRequestReview_REQUEST_CHANGES {
+		return fmt.Sprintf("@%v requested changes", creator)
+	}
+	return fmt.Sprintf("@%v reviewed", creator)
+}
+
 func CreateBountyCommentBody(creator string, amount sdk.Coins) string {
 	return fmt.Sprintf("@%v created bounty of %v", creator, amount.String())
 }
@@ -70,6 +103,33 @@ func CloseBountyCommentBody(creator string) string {
 	return fmt.Sprintf("@%v closed bounty", creator)
 }
 
+func ExpireBountyCommentBody(amount sdk.Coins) string {
+	return fmt.Sprintf("bounty of %v expired and was refunded to its funders", amount.String())
+}
+
+func FundBountyCommentBody(creator string, amount sdk.Coins) string {
+	return fmt.Sprintf("@%v added %v to bounty", creator, amount.String())
+}
+
+func SetBountySplitsCommentBody(creator string) string {
+	return fmt.Sprintf("@%v changed bounty payout splits", creator)
+}
+
+func AwardBountyCommentBody(creator string, recipient string) string {
+	return fmt.Sprintf("@%v awarded bounty to @%v", creator, recipient)
+}
+
+func DisputeBountyCommentBody(creator string, reason string) string {
+	return fmt.Sprintf("@%v disputed bounty: %v", creator, reason)
+}
+
+func ResolveBountyDisputeCommentBody(creator string, recipient string) string {
+	if recipient == "" {
+		return fmt.Sprintf("@%v resolved bounty dispute and refunded bounty to its funders", creator)
+	}
+	return fmt.Sprintf("@%v resolved bounty dispute and awarded bounty to @%v", creator, recipient)
+}
+
 func DeleteBountyCommentBody(creator string) string {
 	return fmt.Sprintf("@%v deleted bounty", creator)
 }
@@ -89,3 +149,15 @@ func LinkPullRequestCommentBody(creator string, iid uint64) string {
 func UnlinkPullRequestCommentBody(creator string, iid uint64) string {
 	return fmt.Sprintf("@%v unlinked pull request #%v", creator, iid)
 }
+
+func CrossReferenceCommentBody(creator string, pullRequest string) string {
+	return fmt.Sprintf("@%v referenced this issue in pull request %v", creator, pullRequest)
+}
+
+func IssueTransferredToCommentBody(creator string, issue string) string {
+	return fmt.Sprintf("@%v transferred this issue to %v", creator, issue)
+}
+
+func IssueTransferredFromCommentBody(creator string, issue string) string {
+	return fmt.Sprintf("@%v transferred this issue from %v", creator, issue)
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// IssueReference is a reference to an issue found in a pull request description or commit message
+type IssueReference struct {
+	// Owner and Repository are empty when the issue belongs to the same repository
+	Owner      string
+	Repository string
+	Iid        uint64
+	// Closing is set when the reference follows a closing keyword, e.g. "closes #12"
+	Closing bool
+}
+
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\w@/.-])@([a-zA-Z0-9]+(?:[_.-]?[a-zA-Z0-9])*)`)
+
+var issueReferenceRegexp = regexp.MustCompile(`(?i)(?:^|[^\w/#&.-])(?:(close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+)?(?:([a-z0-9][a-z0-9-]*)/([\w.-]+))?#([0-9]+)\b`)
+
+// ParseIssueReferences returns the issues referenced in text as "#N" or "owner/repository#N".
+// An issue referenced more than once is returned once, as closing if any of its references is.
+func ParseIssueReferences(text string) []IssueReference {
+	var references []IssueReference
+	index := make(map[string]int)
+
+	for _, match := range issueReferenceRegexp.FindAllStringSubmatch(text, -1) {
+		iid, err := strconv.ParseUint(match[4], 10, 64)
+		if err != nil || iid == 0 {
+			continue
+		}
+
+		reference := IssueReference{
+			Owner:      match[2],
+			Repository: match[3],
+			Iid:        iid,
+			Closing:    match[1] != "",
+		}
+
+		key := strings.ToLower(reference.Owner+"/"+reference.Repository) + "#" + match[4]
+		if i, exists := index[key]; exists {
+			references[i].Closing = references[i].Closing || reference.Closing
+			continue
+		}
+
+		index[key] = len(references)
+		references = append(references, reference)
+	}
+
+	return references
+}
+
+// ParseMentions returns the usernames or addresses @mentioned in text, each one once
+func ParseMentions(text string) []string {
+	var mentions []string
+	unique := make(map[string]bool)
+
+	for _, match := range mentionRegexp.FindAllStringSubmatch(text, -1) {
+		mention := strings.ToLower(match[1])
+		if unique[mention] {
+			continue
+		}
+		unique[mention] = true
+		mentions = append(mentions, mention)
+	}
+
+	return mentions
+}
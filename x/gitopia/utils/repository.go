@@ -22,6 +22,15 @@ func IssueIidExists(r []*types.IssueIid, val uint64) (int, bool) {
 	return 0, false
 }
 
+func IssueIdExists(r []*types.IssueIid, val uint64) (int, bool) {
+	for i, v := range r {
+		if v.Id == val {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
 func PullRequestIidExists(r []*types.PullRequestIid, val uint64) (int, bool) {
 	for i, v := range r {
 		if v.Iid == val {
@@ -49,6 +58,24 @@ func RepositoryLabelIdExists(l []*types.RepositoryLabel, val uint64) (int, bool)
 	return 0, false
 }
 
+func RepositoryMilestoneIdExists(m []*types.RepositoryMilestone, val uint64) (int, bool) {
+	for i, v := range m {
+		if v.Id == val {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func RepositoryIssueTemplateIdExists(t []*types.RepositoryIssueTemplate, val uint64) (int, bool) {
+	for i, v := range t {
+		if v.Id == val {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
 func RepositoryReleaseTagExists(r []*types.RepositoryRelease, val string) (int, bool) {
 	for i, v := range r {
 		if v.TagName == val {
@@ -75,3 +102,30 @@ func RepositoryBackupExists(r []*types.RepositoryBackup, val types.RepositoryBac
 	}
 	return 0, false
 }
+
+func BranchProtectionRuleIdExists(r []*types.BranchProtectionRule, val uint64) (int, bool) {
+	for i, v := range r {
+		if v.Id == val {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func BranchProtectionRulePatternExists(r []*types.BranchProtectionRule, val string) (int, bool) {
+	for i, v := range r {
+		if v.Pattern == val {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func AllowedPusherExists(a []string, val string) (int, bool) {
+	for i, v := range a {
+		if v == val {
+			return i, true
+		}
+	}
+	return 0, false
+}